@@ -3,6 +3,6 @@ module github.com/idichekop/gods
 go 1.24.5
 
 require (
-	github.com/duke-git/lancet/v2 v2.3.7 // indirect
-	golang.org/x/exp v0.0.0-20250718183923-645b1fa84792 // indirect
+	github.com/duke-git/lancet/v2 v2.3.7
+	golang.org/x/exp v0.0.0-20250718183923-645b1fa84792
 )
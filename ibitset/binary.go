@@ -0,0 +1,49 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package ibitset
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// binaryVersion is the first byte of the binary encoding of a BitSet.
+const binaryVersion = 1
+
+// MarshalBinary encodes the set compactly: a version byte, followed by its words of 64
+// bits in little-endian order, without the trailing empty words.  The encoding takes
+// one bit per integer up to the greatest one in the set.  It makes a BitSet usable with
+// gob and net/rpc.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	data := make([]byte, 1, 1+8*n)
+	data[0] = binaryVersion
+	for _, w := range b.words[:n] {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the contents of the set with the one encoded by
+// MarshalBinary.
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return errors.New("BitSet.UnmarshalBinary: unknown encoding version")
+	}
+	data = data[1:]
+	if len(data)%8 != 0 {
+		return fmt.Errorf("BitSet.UnmarshalBinary: invalid length %d", len(data))
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	b.words = words
+	return nil
+}
@@ -0,0 +1,41 @@
+package ibitset
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestBitSetBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBitSetBinary")
+
+	b := New(0, 63, 64, 200)
+	b.Clear(200)
+	data, err := b.MarshalBinary()
+	assert.IsNil(err)
+	// The version byte and two words: the cleared bit leaves empty words, which are
+	// trimmed.
+	assert.Equal(17, len(data))
+
+	var decoded BitSet
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.ShouldBeTrue(decoded.Equal(New(0, 63, 64)))
+
+	var buf bytes.Buffer
+	assert.IsNil(gob.NewEncoder(&buf).Encode(b))
+	var viaGob BitSet
+	assert.IsNil(gob.NewDecoder(&buf).Decode(&viaGob))
+	assert.ShouldBeTrue(viaGob.Equal(b))
+
+	var empty BitSet
+	data, _ = empty.MarshalBinary()
+	assert.Equal([]byte{binaryVersion}, data)
+
+	assert.IsNotNil(decoded.UnmarshalBinary(nil))
+	assert.IsNotNil(decoded.UnmarshalBinary([]byte{9}))
+	assert.IsNotNil(decoded.UnmarshalBinary([]byte{binaryVersion, 1, 2}))
+}
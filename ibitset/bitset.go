@@ -0,0 +1,221 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package ibitset implements a growable set of non-negative integers stored as bits.
+package ibitset
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+const wordSize = 64
+
+// BitSet is a set of non-negative integers, stored as one bit per integer up to the
+// greatest one ever set.  For dense integer membership it takes a small fraction of the
+// memory of a map[int]struct{}, and its set operations work a word of 64 bits at a time.
+// The zero BitSet is empty and ready to use.  A BitSet is not safe for concurrent use.
+type BitSet struct {
+	words []uint64
+}
+
+// New creates a BitSet holding the given indices.  It panics if an index is negative.
+func New(indices ...int) *BitSet {
+	b := &BitSet{}
+	for _, i := range indices {
+		b.Set(i)
+	}
+	return b
+}
+
+// Set adds i to the set, growing it if needed.  It panics if i is negative.
+func (b *BitSet) Set(i int) {
+	checkIndex(i)
+	w := i / wordSize
+	if w >= len(b.words) {
+		b.words = append(b.words, make([]uint64, w+1-len(b.words))...)
+	}
+	b.words[w] |= 1 << (i % wordSize)
+}
+
+// Clear removes i from the set.  It panics if i is negative.
+func (b *BitSet) Clear(i int) {
+	checkIndex(i)
+	if w := i / wordSize; w < len(b.words) {
+		b.words[w] &^= 1 << (i % wordSize)
+	}
+}
+
+// Flip adds i to the set if it is absent, and removes it otherwise.  It panics if i is
+// negative.
+func (b *BitSet) Flip(i int) {
+	if b.Test(i) {
+		b.Clear(i)
+	} else {
+		b.Set(i)
+	}
+}
+
+// Test reports whether i is in the set.  It panics if i is negative.
+func (b *BitSet) Test(i int) bool {
+	checkIndex(i)
+	w := i / wordSize
+	return w < len(b.words) && b.words[w]&(1<<(i%wordSize)) != 0
+}
+
+// Count returns the number of integers in the set.
+func (b *BitSet) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// IsEmpty reports whether the set has no integers.
+func (b *BitSet) IsEmpty() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// NextSetBit returns the smallest integer of the set greater than or equal to from, or
+// false if there is none.
+func (b *BitSet) NextSetBit(from int) (int, bool) {
+	if from < 0 {
+		from = 0
+	}
+	w := from / wordSize
+	if w >= len(b.words) {
+		return 0, false
+	}
+	// Mask off the bits below from in its word.
+	word := b.words[w] &^ (1<<(from%wordSize) - 1)
+	for {
+		if word != 0 {
+			return w*wordSize + bits.TrailingZeros64(word), true
+		}
+		w++
+		if w == len(b.words) {
+			return 0, false
+		}
+		word = b.words[w]
+	}
+}
+
+// All returns an iterator over the integers of the set, in ascending order.
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, ok := b.NextSetBit(0); ok; i, ok = b.NextSetBit(i + 1) {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the integers of the set, which are its keys, in ascending
+// order.
+func (b *BitSet) Keys() iter.Seq[int] {
+	return b.All()
+}
+
+// Values returns an iterator over the integers of the set, in ascending order.
+func (b *BitSet) Values() iter.Seq[int] {
+	return b.All()
+}
+
+// ToSlice returns the integers of the set, in ascending order.
+func (b *BitSet) ToSlice() []int {
+	result := make([]int, 0, b.Count())
+	for i := range b.All() {
+		result = append(result, i)
+	}
+	return result
+}
+
+// Clone returns a copy of the set.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// Equal reports whether both sets hold the same integers.
+func (b *BitSet) Equal(other *BitSet) bool {
+	short, long := b.words, other.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i, w := range short {
+		if w != long[i] {
+			return false
+		}
+	}
+	for _, w := range long[len(short):] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// And returns a new set of the integers in both sets.
+func (b *BitSet) And(other *BitSet) *BitSet {
+	result := &BitSet{words: make([]uint64, min(len(b.words), len(other.words)))}
+	for i := range result.words {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+// Or returns a new set of the integers in either set.
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return combine(b, other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new set of the integers in exactly one of the sets.
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return combine(b, other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// AndNot returns a new set of the integers in b but not in other.
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	result := b.Clone()
+	for i := range min(len(b.words), len(other.words)) {
+		result.words[i] &^= other.words[i]
+	}
+	return result
+}
+
+// String returns the integers of the set in braces, e.g. "{1 5 64}".
+func (b *BitSet) String() string {
+	s := fmt.Sprint(b.ToSlice())
+	return "{" + s[1:len(s)-1] + "}"
+}
+
+// combine applies op word by word over the longer of both sets, missing words counting
+// as zero.
+func combine(a, b *BitSet, op func(x, y uint64) uint64) *BitSet {
+	if len(a.words) < len(b.words) {
+		a, b = b, a
+	}
+	result := &BitSet{words: make([]uint64, len(a.words))}
+	for i, w := range a.words {
+		var other uint64
+		if i < len(b.words) {
+			other = b.words[i]
+		}
+		result.words[i] = op(w, other)
+	}
+	return result
+}
+
+func checkIndex(i int) {
+	if i < 0 {
+		panic(fmt.Sprintf("BitSet: negative index %d", i))
+	}
+}
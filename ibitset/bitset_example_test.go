@@ -0,0 +1,19 @@
+package ibitset
+
+import "fmt"
+
+func ExampleBitSet() {
+	weekdays := New(1, 2, 3, 4, 5)
+	openDays := New(0, 2, 4, 6)
+
+	fmt.Println(weekdays.And(openDays))
+	fmt.Println(openDays.AndNot(weekdays).Count())
+
+	next, _ := openDays.NextSetBit(5)
+	fmt.Println(next)
+
+	// Output:
+	// {2 4}
+	// 2
+	// 6
+}
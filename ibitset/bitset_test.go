@@ -0,0 +1,122 @@
+package ibitset
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestBitSet(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBitSet")
+
+	var b BitSet
+	assert.ShouldBeTrue(b.IsEmpty())
+	assert.ShouldBeFalse(b.Test(1000))
+	_, ok := b.NextSetBit(0)
+	assert.ShouldBeFalse(ok)
+	b.Clear(5)
+
+	b.Set(3)
+	b.Set(64)
+	b.Set(200)
+	b.Set(3)
+	assert.Equal(3, b.Count())
+	assert.ShouldBeTrue(b.Test(64))
+	assert.ShouldBeFalse(b.Test(65))
+
+	b.Flip(65)
+	b.Flip(3)
+	assert.Equal([]int{64, 65, 200}, b.ToSlice())
+	b.Clear(65)
+	assert.Equal("{64 200}", b.String())
+
+	i, ok := b.NextSetBit(65)
+	assert.Equal(200, i)
+	assert.ShouldBeTrue(ok)
+	i, _ = b.NextSetBit(-4)
+	assert.Equal(64, i)
+	_, ok = b.NextSetBit(201)
+	assert.ShouldBeFalse(ok)
+
+	var seen []int
+	for i := range New(1, 2, 3, 4).All() {
+		if i == 3 {
+			break
+		}
+		seen = append(seen, i)
+	}
+	assert.Equal([]int{1, 2}, seen)
+
+	assert.Equal([]int{}, New().ToSlice())
+	assert.Equal("{}", New().String())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	b.Set(-1)
+}
+
+func TestBitSetOperations(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBitSetOperations")
+
+	a := New(1, 2, 3, 100)
+	b := New(2, 3, 4)
+
+	assert.Equal([]int{2, 3}, a.And(b).ToSlice())
+	assert.Equal([]int{1, 2, 3, 4, 100}, a.Or(b).ToSlice())
+	assert.Equal([]int{1, 4, 100}, a.Xor(b).ToSlice())
+	assert.Equal([]int{1, 100}, a.AndNot(b).ToSlice())
+	assert.Equal([]int{4}, b.AndNot(a).ToSlice())
+	assert.Equal([]int{1, 2, 3, 100}, a.ToSlice())
+
+	assert.ShouldBeTrue(a.Xor(a).IsEmpty())
+	assert.ShouldBeTrue(New(2).Equal(a.And(b).AndNot(New(3))))
+	assert.ShouldBeFalse(a.Equal(b))
+
+	c := a.Clone()
+	c.Clear(100)
+	assert.ShouldBeTrue(c.Equal(New(1, 2, 3)))
+	assert.ShouldBeTrue(a.Test(100))
+}
+
+func TestBitSetRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBitSetRandomized")
+
+	rng := rand.New(rand.NewSource(12))
+	var b BitSet
+	reference := map[int]bool{}
+	for range 3000 {
+		i := rng.Intn(700)
+		switch rng.Intn(3) {
+		case 0:
+			b.Set(i)
+			reference[i] = true
+		case 1:
+			b.Clear(i)
+			delete(reference, i)
+		default:
+			b.Flip(i)
+			if reference[i] {
+				delete(reference, i)
+			} else {
+				reference[i] = true
+			}
+		}
+	}
+
+	var want []int
+	for i := range reference {
+		want = append(want, i)
+	}
+	slices.Sort(want)
+	assert.Equal(want, b.ToSlice())
+	assert.Equal(len(reference), b.Count())
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package ibitset
+
+import (
+	"fmt"
+
+	"github.com/idichekop/gods/internal/ijson"
+)
+
+// MarshalJSON encodes the set as a JSON array of its indices, in ascending order.
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(b.All())
+}
+
+// UnmarshalJSON replaces the contents of the set with the indices of a JSON array.  It
+// returns an error if an index is negative.
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	indices, err := ijson.UnmarshalArray[int](data)
+	if err != nil || indices == nil {
+		return err
+	}
+	for _, i := range indices {
+		if i < 0 {
+			return fmt.Errorf("BitSet.UnmarshalJSON: negative index %d", i)
+		}
+	}
+	*b = *New(indices...)
+	return nil
+}
@@ -0,0 +1,27 @@
+package ibitset
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestBitSetJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBitSetJSON")
+
+	data, err := json.Marshal(New(130, 3, 64))
+	assert.IsNil(err)
+	assert.Equal("[3,64,130]", string(data))
+
+	var b BitSet
+	data, _ = json.Marshal(&b)
+	assert.Equal("[]", string(data))
+
+	b.Set(7)
+	assert.IsNil(json.Unmarshal([]byte("[1,5]"), &b))
+	assert.ShouldBeTrue(b.Equal(New(1, 5)))
+	assert.IsNotNil(json.Unmarshal([]byte("[-1]"), &b))
+}
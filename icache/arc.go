@@ -0,0 +1,181 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package icache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// ARC is a Cache with the Adaptive Replacement Cache policy of Megiddo and Modha.  It
+// keeps the entries used once (recent) apart from the entries used several times
+// (frequent), and remembers the keys recently evicted from each part (the ghosts).  A
+// miss on a ghost key shows that its part was too small, and moves the target balance
+// between both parts accordingly.  So ARC adapts to workloads favoring recency as well as
+// to those favoring frequency, and resists scans.  All its operations run in O(1).
+type ARC[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	// target is the adaptive target size of recent.
+	target int
+
+	recent        *list.List // T1: resident entries used once, most recent first
+	frequent      *list.List // T2: resident entries used several times, most recent first
+	recentGhost   *list.List // B1: keys evicted from recent
+	frequentGhost *list.List // B2: keys evicted from frequent
+
+	entries map[K]*list.Element
+}
+
+type arcEntry[K comparable, V any] struct {
+	key   K
+	value V
+	where *list.List
+}
+
+var _ Cache[string, int] = (*ARC[string, int])(nil)
+
+// NewARC creates an empty ARC cache.  It panics if capacity is not positive.
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	checkCapacity("NewARC", capacity)
+	return &ARC[K, V]{
+		capacity:      capacity,
+		recent:        list.New(),
+		frequent:      list.New(),
+		recentGhost:   list.New(),
+		frequentGhost: list.New(),
+		entries:       make(map[K]*list.Element, 2*capacity),
+	}
+}
+
+// Get implements Cache.
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok || !c.resident(elem) {
+		var zero V
+		return zero, false
+	}
+	c.move(elem, c.frequent)
+	return elem.Value.(*arcEntry[K, V]).value, true
+}
+
+// Put implements Cache.
+func (c *ARC[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		if c.recent.Len()+c.frequent.Len() >= c.capacity {
+			c.replace(false)
+		}
+		// Bound the ghosts, so that the cache tracks at most 2 x capacity keys.
+		if c.recentGhost.Len() > c.capacity-c.target {
+			c.drop(c.recentGhost.Back())
+		}
+		if c.frequentGhost.Len() > c.target {
+			c.drop(c.frequentGhost.Back())
+		}
+		c.entries[key] = c.push(&arcEntry[K, V]{key: key, value: value}, c.recent)
+		return
+	}
+
+	e := elem.Value.(*arcEntry[K, V])
+	switch e.where {
+	case c.recentGhost:
+		// The recent part was too small: grow its target.
+		c.target = min(c.capacity, c.target+max(c.frequentGhost.Len()/c.recentGhost.Len(), 1))
+		if c.recent.Len()+c.frequent.Len() >= c.capacity {
+			c.replace(false)
+		}
+	case c.frequentGhost:
+		// The frequent part was too small: shrink the target of the recent one.
+		c.target = max(0, c.target-max(c.recentGhost.Len()/c.frequentGhost.Len(), 1))
+		if c.recent.Len()+c.frequent.Len() >= c.capacity {
+			c.replace(true)
+		}
+	}
+
+	e.value = value
+	c.move(elem, c.frequent)
+}
+
+// Remove implements Cache.
+func (c *ARC[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	resident := c.resident(elem)
+	c.drop(elem)
+	return resident
+}
+
+// Len implements Cache.
+func (c *ARC[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.recent.Len() + c.frequent.Len()
+}
+
+// Capacity implements Cache.
+func (c *ARC[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// replace evicts the least recent entry of recent or of frequent, depending on the
+// target, and keeps its key as a ghost.  inFrequentGhost tells whether the key being put
+// is a ghost of frequent.
+func (c *ARC[K, V]) replace(inFrequentGhost bool) {
+	n := c.recent.Len()
+	if n > 0 && (n > c.target || (n == c.target && inFrequentGhost) || c.frequent.Len() == 0) {
+		c.evict(c.recent.Back(), c.recentGhost)
+	} else {
+		c.evict(c.frequent.Back(), c.frequentGhost)
+	}
+}
+
+// evict moves a resident entry to the given ghost list, releasing its value.
+func (c *ARC[K, V]) evict(elem *list.Element, ghost *list.List) {
+	e := elem.Value.(*arcEntry[K, V])
+	var zero V
+	e.value = zero
+	c.move(elem, ghost)
+	if ghost.Len() > c.capacity {
+		c.drop(ghost.Back())
+	}
+}
+
+// move moves the entry to the front of the given list.
+func (c *ARC[K, V]) move(elem *list.Element, to *list.List) {
+	e := elem.Value.(*arcEntry[K, V])
+	e.where.Remove(elem)
+	c.entries[e.key] = c.push(e, to)
+}
+
+// push adds the entry to the front of the given list.
+func (c *ARC[K, V]) push(e *arcEntry[K, V], to *list.List) *list.Element {
+	e.where = to
+	return to.PushFront(e)
+}
+
+// drop forgets the entry entirely.
+func (c *ARC[K, V]) drop(elem *list.Element) {
+	e := elem.Value.(*arcEntry[K, V])
+	e.where.Remove(elem)
+	delete(c.entries, e.key)
+}
+
+// resident reports whether the entry holds a value, i.e. is not a ghost.
+func (c *ARC[K, V]) resident(elem *list.Element) bool {
+	where := elem.Value.(*arcEntry[K, V]).where
+	return where == c.recent || where == c.frequent
+}
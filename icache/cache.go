@@ -0,0 +1,31 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package icache implements fixed-capacity caches with different eviction policies,
+// behind the common Cache interface, so that the policy can be swapped without changing
+// the call sites.
+package icache
+
+// Cache is a fixed-capacity key-value cache.  When a Put exceeds the capacity, the cache
+// evicts an entry chosen by its policy.  Implementations are safe for concurrent use.
+type Cache[K comparable, V any] interface {
+	// Get returns the value of key, and whether it was found.  A hit counts as a use of
+	// the entry for the eviction policy.
+	Get(key K) (V, bool)
+	// Put stores the value of key, evicting an entry if the cache is full.
+	Put(key K, value V)
+	// Remove removes key, and reports whether it was found.
+	Remove(key K) bool
+	// Len returns the number of entries.
+	Len() int
+	// Capacity returns the maximum number of entries.
+	Capacity() int
+}
+
+// checkCapacity panics if capacity is not positive.
+func checkCapacity(name string, capacity int) {
+	if capacity < 1 {
+		panic(name + ": capacity must be positive")
+	}
+}
@@ -0,0 +1,37 @@
+package icache
+
+import "fmt"
+
+func ExampleCache() {
+	// The policy is chosen in a single place.
+	var cache Cache[string, int] = NewARC[string, int](2)
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Put("c", 3)
+
+	_, hasA := cache.Get("a")
+	_, hasB := cache.Get("b")
+
+	fmt.Println(hasA, hasB, cache.Len())
+
+	// Output:
+	// true false 2
+}
+
+func ExampleNewLFU() {
+	cache := NewLFU[string, int](2)
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Put("c", 3)
+
+	_, hasB := cache.Get("b")
+
+	fmt.Println(hasB)
+
+	// Output:
+	// false
+}
@@ -0,0 +1,189 @@
+package icache
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+// policies are the constructors of all the caches, for the tests of the Cache contract.
+var policies = map[string]func(capacity int) Cache[int, string]{
+	"LFU": func(capacity int) Cache[int, string] { return NewLFU[int, string](capacity) },
+	"ARC": func(capacity int) Cache[int, string] { return NewARC[int, string](capacity) },
+}
+
+func TestCacheContract(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCacheContract")
+
+	for name, newCache := range policies {
+		t.Run(name, func(t *testing.T) {
+			c := newCache(3)
+			assert.Equal(3, c.Capacity())
+
+			_, ok := c.Get(1)
+			assert.ShouldBeFalse(ok)
+
+			for i := range 10 {
+				c.Put(i, strconv.Itoa(i))
+				assert.LessOrEqual(c.Len(), 3)
+			}
+			assert.Equal(3, c.Len())
+
+			c.Put(9, "nine")
+			v, ok := c.Get(9)
+			assert.ShouldBeTrue(ok)
+			assert.Equal("nine", v)
+
+			assert.ShouldBeTrue(c.Remove(9))
+			assert.ShouldBeFalse(c.Remove(9))
+			assert.Equal(2, c.Len())
+			_, ok = c.Get(9)
+			assert.ShouldBeFalse(ok)
+		})
+	}
+}
+
+func TestCacheCapacityPanics(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCacheCapacityPanics")
+
+	for name, newCache := range policies {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				assert.IsNotNil(recover())
+			}()
+			newCache(0)
+		})
+	}
+}
+
+func TestLFU(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestLFU")
+
+	c := NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	// b is used less often than a
+	c.Put("c", 3)
+	_, ok := c.Get("b")
+	assert.ShouldBeFalse(ok)
+
+	// c and d are used as often; the least recent of them goes
+	c.Remove("a")
+	c.Put("d", 4)
+	c.Put("e", 5)
+	_, ok = c.Get("c")
+	assert.ShouldBeFalse(ok)
+	_, ok = c.Get("d")
+	assert.ShouldBeTrue(ok)
+	_, ok = c.Get("e")
+	assert.ShouldBeTrue(ok)
+}
+
+func TestARC(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestARC")
+
+	c := NewARC[int, int](4)
+
+	// 1 and 2 become frequent
+	for _, k := range []int{1, 2, 1, 2} {
+		if _, ok := c.Get(k); !ok {
+			c.Put(k, k)
+		}
+	}
+
+	// a scan of keys used once must not evict them
+	for k := 100; k < 110; k++ {
+		c.Put(k, k)
+	}
+	_, ok := c.Get(1)
+	assert.ShouldBeTrue(ok)
+	_, ok = c.Get(2)
+	assert.ShouldBeTrue(ok)
+	assert.Equal(4, c.Len())
+
+	// the ghosts stay bounded
+	for k := range 1000 {
+		c.Put(k, k)
+		c.Get(k / 2)
+	}
+	assert.LessOrEqual(len(c.entries), 2*c.capacity+1)
+	assert.LessOrEqual(c.Len(), 4)
+	assert.ShouldBeFalse(c.Remove(-1))
+}
+
+func TestCacheConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCacheConcurrentUse")
+
+	for name, newCache := range policies {
+		t.Run(name, func(t *testing.T) {
+			c := newCache(16)
+
+			var wg sync.WaitGroup
+			for w := range 4 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range 500 {
+						k := (i * (w + 1)) % 64
+						if _, ok := c.Get(k); !ok {
+							c.Put(k, strconv.Itoa(k))
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			assert.LessOrEqual(c.Len(), 16)
+		})
+	}
+}
+
+// benchmarkHitRatio replays a skewed workload on each policy, and reports its hit ratio
+// along with its speed.
+func benchmarkHitRatio(b *testing.B, newCache func(capacity int) Cache[int, string]) {
+	rng := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(rng, 1.1, 1, 10000)
+	keys := make([]int, 1<<16)
+	for i := range keys {
+		keys[i] = int(zipf.Uint64())
+	}
+
+	c := newCache(512)
+	hits := 0
+
+	b.ResetTimer()
+	for i := range b.N {
+		k := keys[i%len(keys)]
+		if _, ok := c.Get(k); ok {
+			hits++
+		} else {
+			c.Put(k, "")
+		}
+	}
+	b.ReportMetric(float64(hits)/float64(b.N), "hits/op")
+}
+
+func BenchmarkLFU(b *testing.B) {
+	benchmarkHitRatio(b, policies["LFU"])
+}
+
+func BenchmarkARC(b *testing.B) {
+	benchmarkHitRatio(b, policies["ARC"])
+}
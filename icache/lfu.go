@@ -0,0 +1,136 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package icache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LFU is a Cache evicting the least frequently used entry; among entries used equally
+// often, the least recently used one.  All its operations run in O(1).
+type LFU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[K]*list.Element
+	// buckets holds, for each use count, the entries with that count, most recently used
+	// first.
+	buckets  map[int]*list.List
+	minCount int
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key   K
+	value V
+	count int
+}
+
+var _ Cache[string, int] = (*LFU[string, int])(nil)
+
+// NewLFU creates an empty LFU cache.  It panics if capacity is not positive.
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	checkCapacity("NewLFU", capacity)
+	return &LFU[K, V]{
+		capacity: capacity,
+		entries:  make(map[K]*list.Element, capacity),
+		buckets:  make(map[int]*list.List),
+	}
+}
+
+// Get implements Cache.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.touch(elem)
+	return elem.Value.(*lfuEntry[K, V]).value, true
+}
+
+// Put implements Cache.
+func (c *LFU[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*lfuEntry[K, V]).value = value
+		c.touch(elem)
+		return
+	}
+
+	if len(c.entries) >= c.capacity {
+		bucket := c.buckets[c.minCount]
+		c.unlink(bucket.Back())
+	}
+
+	c.entries[key] = c.bucket(1).PushFront(&lfuEntry[K, V]{key: key, value: value, count: 1})
+	c.minCount = 1
+}
+
+// Remove implements Cache.
+func (c *LFU[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if ok {
+		c.unlink(elem)
+	}
+	return ok
+}
+
+// Len implements Cache.
+func (c *LFU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Capacity implements Cache.
+func (c *LFU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// touch moves the entry to the bucket of its incremented use count.
+func (c *LFU[K, V]) touch(elem *list.Element) {
+	e := elem.Value.(*lfuEntry[K, V])
+	old := c.buckets[e.count]
+	old.Remove(elem)
+	if old.Len() == 0 {
+		delete(c.buckets, e.count)
+		if c.minCount == e.count {
+			c.minCount++
+		}
+	}
+
+	e.count++
+	c.entries[e.key] = c.bucket(e.count).PushFront(e)
+}
+
+// unlink removes the entry from the cache.  minCount may become stale; it is reset by
+// the next insertion, the only operation relying on it.
+func (c *LFU[K, V]) unlink(elem *list.Element) {
+	e := elem.Value.(*lfuEntry[K, V])
+	bucket := c.buckets[e.count]
+	bucket.Remove(elem)
+	if bucket.Len() == 0 {
+		delete(c.buckets, e.count)
+	}
+	delete(c.entries, e.key)
+}
+
+// bucket returns the bucket of the given use count, creating it if needed.
+func (c *LFU[K, V]) bucket(count int) *list.List {
+	b, ok := c.buckets[count]
+	if !ok {
+		b = list.New()
+		c.buckets[count] = b
+	}
+	return b
+}
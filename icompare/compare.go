@@ -0,0 +1,83 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package icompare defines the comparison function types shared by the packages of this
+// module, and combinators to build them.
+//
+// The types are named function types, so a Comparator[T] can be passed wherever a
+// func(a, b T) int is expected (e.g. islice.OrderByFunc or slices.SortFunc), and an
+// Equaler[T] wherever a func(a, b T) bool is expected.
+package icompare
+
+import (
+	"cmp"
+	"hash/maphash"
+)
+
+// Comparator returns a negative number when a < b, a positive number when a > b and zero
+// when a and b are equal.
+type Comparator[T any] func(a, b T) int
+
+// Equaler reports whether a and b are equal.
+type Equaler[T any] func(a, b T) bool
+
+// Hasher returns a hash of v.  Values that are equal must have the same hash.
+type Hasher[T any] func(v T) uint64
+
+// Natural returns the Comparator of the natural order of T.
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// ByKey returns a Comparator ordering elements by the keys produced by the key function.
+func ByKey[T any, K cmp.Ordered](key func(item T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Reversed returns a Comparator of the reverse order of c.
+func Reversed[T any](c Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// Then returns a Comparator which orders by c, and breaks ties with the next comparators,
+// in turn.
+func Then[T any](c Comparator[T], next ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		for _, n := range next {
+			if r := n(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// Equal returns the Equaler of the == operator of T.
+func Equal[T comparable]() Equaler[T] {
+	return func(a, b T) bool {
+		return a == b
+	}
+}
+
+// EqualBy returns an Equaler of the elements whose comparator c returns zero.
+func EqualBy[T any](c Comparator[T]) Equaler[T] {
+	return func(a, b T) bool {
+		return c(a, b) == 0
+	}
+}
+
+// Hash returns a Hasher of comparable values, with a random seed chosen once per call.
+func Hash[T comparable]() Hasher[T] {
+	seed := maphash.MakeSeed()
+	return func(v T) uint64 {
+		return maphash.Comparable(seed, v)
+	}
+}
@@ -0,0 +1,19 @@
+package icompare
+
+import (
+	"fmt"
+	"slices"
+)
+
+func ExampleThen() {
+	words := []string{"kiwi", "fig", "apple", "date", "pear"}
+
+	byLength := ByKey(func(s string) int { return len(s) })
+
+	slices.SortFunc(words, Then(Reversed(byLength), Natural[string]()))
+
+	fmt.Println(words)
+
+	// Output:
+	// [apple date kiwi pear fig]
+}
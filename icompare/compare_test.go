@@ -0,0 +1,59 @@
+package icompare
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestComparators(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestComparators")
+
+	natural := Natural[int]()
+	assert.Less(natural(1, 2), 0)
+	assert.Equal(0, natural(2, 2))
+	assert.Greater(Reversed(natural)(1, 2), 0)
+
+	people := []person{{"bob", 30}, {"al", 25}, {"cy", 30}, {"di", 25}}
+	byAge := ByKey(func(p person) int { return p.age })
+	byName := ByKey(func(p person) string { return p.name })
+
+	slices.SortFunc(people, Then(Reversed(byAge), byName))
+	assert.Equal([]person{{"bob", 30}, {"cy", 30}, {"al", 25}, {"di", 25}}, people)
+
+	assert.Equal(0, Then(byAge)(person{"x", 1}, person{"y", 1}))
+}
+
+func TestEqualers(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestEqualers")
+
+	assert.ShouldBeTrue(Equal[string]()("a", "a"))
+	assert.ShouldBeFalse(Equal[string]()("a", "b"))
+
+	sameLength := EqualBy(ByKey(func(s string) int { return len(s) }))
+	assert.ShouldBeTrue(sameLength("ab", "cd"))
+	assert.ShouldBeFalse(sameLength("ab", "c"))
+
+	assert.ShouldBeTrue(slices.EqualFunc([]string{"A"}, []string{"a"}, Equaler[string](strings.EqualFold)))
+}
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHash")
+
+	hash := Hash[person]()
+	assert.Equal(hash(person{"al", 25}), hash(person{"al", 25}))
+	assert.NotEqual(hash(person{"al", 25}), hash(person{"al", 26}))
+}
@@ -0,0 +1,52 @@
+package icontainer_test
+
+import (
+	"github.com/idichekop/gods/ibitset"
+	"github.com/idichekop/gods/icontainer"
+	"github.com/idichekop/gods/ilists"
+	"github.com/idichekop/gods/imaps"
+	"github.com/idichekop/gods/imatrix"
+	"github.com/idichekop/gods/iqueues"
+	"github.com/idichekop/gods/iseq"
+	"github.com/idichekop/gods/isets"
+	islice "github.com/idichekop/gods/islices"
+	"github.com/idichekop/gods/istacks"
+	"github.com/idichekop/gods/itrees"
+)
+
+// The containers of the module, checked at compile time against the interfaces.
+var (
+	_ icontainer.Collection[int]                 = (*isets.Set[int])(nil)
+	_ icontainer.Collection[int]                 = (*isets.SortedSet[int])(nil)
+	_ icontainer.Collection[int]                 = (*isets.ConcurrentSet[int])(nil)
+	_ icontainer.Collection[int]                 = (*ilists.List[int])(nil)
+	_ icontainer.Collection[int]                 = (*ilists.ConsList[int])(nil)
+	_ icontainer.Collection[int]                 = (*istacks.Stack[int])(nil)
+	_ icontainer.Collection[int]                 = (*iqueues.Queue[int])(nil)
+	_ icontainer.Collection[int]                 = (*iqueues.RingBuffer[int])(nil)
+	_ icontainer.Collection[int]                 = (*iqueues.PriorityQueue[int])(nil)
+	_ icontainer.Collection[int]                 = (*iqueues.Heap[int])(nil)
+	_ icontainer.Collection[int]                 = (*iqueues.BlockingQueue[int])(nil)
+	_ icontainer.Collection[int]                 = (*islice.SortedSlice[int])(nil)
+	_ icontainer.Collection[int]                 = (*itrees.BST[int])(nil)
+	_ icontainer.Collection[itrees.KDPoint[int]] = (*itrees.KDTree[int])(nil)
+	_ icontainer.Collection[int]                 = (*ibitset.BitSet)(nil)
+
+	_ icontainer.Associative[int, string]    = (*imaps.TreeMap[int, string])(nil)
+	_ icontainer.Associative[int, string]    = (*imaps.SkipList[int, string])(nil)
+	_ icontainer.Associative[int, string]    = (*imaps.ConcurrentMap[int, string])(nil)
+	_ icontainer.Associative[int, string]    = (*imaps.COWMap[int, string])(nil)
+	_ icontainer.Associative[int, string]    = imaps.COWSnapshot[int, string]{}
+	_ icontainer.Associative[int, string]    = (*imaps.ExpiringMap[int, string])(nil)
+	_ icontainer.Associative[int, string]    = (*imaps.MultiMap[int, string])(nil)
+	_ icontainer.Associative[int, string]    = (*itrees.RBTree[int, string])(nil)
+	_ icontainer.Associative[int, string]    = (*itrees.BTree[int, string])(nil)
+	_ icontainer.Associative[string, int]    = (*itrees.RadixTree[int])(nil)
+	_ icontainer.Associative[int, string]    = islice.ImmutableSlice[string]{}
+	_ icontainer.Associative[int, string]    = (*islice.SparseSlice[string])(nil)
+	_ icontainer.Associative[int, string]    = iseq.PVector[string]{}
+	_ icontainer.Associative[int, string]    = (*iseq.Rope[string])(nil)
+	_ icontainer.Associative[int, string]    = (*itrees.SegmentTree[string])(nil)
+	_ icontainer.Associative[[2]int, string] = (*imatrix.Grid[string])(nil)
+	_ icontainer.Associative[string, int]    = (*isets.MultiSet[string])(nil)
+)
@@ -0,0 +1,77 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package icontainer defines the iteration interfaces shared by the containers of this
+// module, so that they can be ranged over uniformly and fed to the iterator functions
+// of the standard library (slices.Collect, maps.Collect, ...).
+//
+// The conventions are:
+//
+//   - Every container has All and Values.  Values yields the values, in the order of All.
+//   - Containers of values (lists, sets, queues, stacks, ...) implement Collection: All
+//     yields the values, like Values.  Sets also have Keys, as their values are their
+//     keys.
+//   - Containers of key-value associations (maps, ordered trees, ...) implement
+//     Associative: All yields the pairs, Keys the distinct keys and Values the values,
+//     in the same order when All has a deterministic one.  Indexed containers, whose
+//     elements are read and written by index (sparse slices, immutable slices,
+//     persistent vectors, ropes, segment trees, grids), are associative too, keyed by
+//     their indexes, as slices.All is.
+//   - Every iterator documents its order.  Iterators are lazy and stop pulling as soon
+//     as the loop body breaks.  Unless documented otherwise, the container must not be
+//     modified during an iteration.
+//   - A ToSlice method returns a copy of the values, in the order of All.
+//
+// The MarshalBinary methods of the containers share a compact format: a version byte, a
+// format byte, the varint number of elements, then the elements.  Integers are varints,
+// floats are fixed-width, strings and slices are prefixed by their length, and structs
+// of exported fields are encoded field by field.  Types implementing
+// encoding.BinaryMarshaler keep their own encoding.  Elements of other types, such as
+// maps, pointers or interfaces, are encoded with encoding/gob instead, which must support
+// them.
+package icontainer
+
+import "iter"
+
+// Collection is implemented by the containers of values.
+type Collection[T any] interface {
+	// All returns an iterator over the values.
+	All() iter.Seq[T]
+	// Values returns an iterator over the values, in the order of All.
+	Values() iter.Seq[T]
+}
+
+// Associative is implemented by the containers of key-value associations.
+type Associative[K any, V any] interface {
+	// All returns an iterator over the keys and their values.
+	All() iter.Seq2[K, V]
+	// Keys returns an iterator over the distinct keys.
+	Keys() iter.Seq[K]
+	// Values returns an iterator over the values.
+	Values() iter.Seq[V]
+}
+
+// Keys returns an iterator over the keys of seq.  Containers implement their Keys method
+// with it when they have no faster way.
+func Keys[K any, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of seq.  Containers implement their Values
+// method with it when they have no faster way.
+func Values[K any, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package icontainer
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestKeysValues(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestKeysValues")
+
+	seq := slices.All([]string{"a", "b", "c"})
+	assert.Equal([]int{0, 1, 2}, slices.Collect(Keys(seq)))
+	assert.Equal([]string{"a", "b", "c"}, slices.Collect(Values(seq)))
+
+	var first []int
+	for k := range Keys(seq) {
+		first = append(first, k)
+		break
+	}
+	assert.Equal([]int{0}, first)
+
+	var firstValue []string
+	for v := range Values(maps.All(map[int]string{1: "x"})) {
+		firstValue = append(firstValue, v)
+		break
+	}
+	assert.Equal([]string{"x"}, firstValue)
+}
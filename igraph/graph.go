@@ -0,0 +1,352 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package igraph implements a generic graph and the classic algorithms on it.
+package igraph
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+var (
+	// ErrCycle is returned by TopologicalSort when the graph has a cycle.
+	ErrCycle = errors.New("graph has a cycle")
+	// ErrUndirected is returned by the algorithms defined only on directed graphs.
+	ErrUndirected = errors.New("graph is undirected")
+	// ErrDirected is returned by the algorithms defined only on undirected graphs.
+	ErrDirected = errors.New("graph is directed")
+	// ErrNodeNotFound is returned when an algorithm starts from a node not in the graph.
+	ErrNodeNotFound = errors.New("node not found")
+)
+
+// Graph is a directed or undirected graph over nodes of type N, stored as adjacency
+// lists.  There is at most one edge from a node to another, and every edge has a
+// weight, 1 unless set with AddWeightedEdge.  Nodes and neighbors are
+// visited in the order they were added, which makes every traversal deterministic.  A
+// Graph is not safe for concurrent use.
+type Graph[N comparable] struct {
+	directed bool
+	nodes    []N
+	// out holds the arcs to the successors of each node; for undirected graphs, to its
+	// neighbors.
+	out map[N][]arc[N]
+	// in holds the arcs to the predecessors of each node of a directed graph.
+	in    map[N][]arc[N]
+	edges int
+}
+
+// arc is one end of an edge, as seen from the other end.
+type arc[N comparable] struct {
+	node   N
+	weight float64
+}
+
+// Edge is an edge of a Graph.
+type Edge[N comparable] struct {
+	From, To N
+	Weight   float64
+}
+
+// NewDirected creates an empty directed graph.
+func NewDirected[N comparable]() *Graph[N] {
+	return &Graph[N]{directed: true, out: map[N][]arc[N]{}, in: map[N][]arc[N]{}}
+}
+
+// NewUndirected creates an empty undirected graph.
+func NewUndirected[N comparable]() *Graph[N] {
+	return &Graph[N]{out: map[N][]arc[N]{}}
+}
+
+// IsDirected reports whether the graph is directed.
+func (g *Graph[N]) IsDirected() bool {
+	return g.directed
+}
+
+// NodeCount returns the number of nodes.
+func (g *Graph[N]) NodeCount() int {
+	return len(g.nodes)
+}
+
+// EdgeCount returns the number of edges.  An undirected edge counts once.
+func (g *Graph[N]) EdgeCount() int {
+	return g.edges
+}
+
+// Nodes returns the nodes, in the order they were added.
+func (g *Graph[N]) Nodes() []N {
+	return slices.Clone(g.nodes)
+}
+
+// HasNode reports whether n is in the graph.
+func (g *Graph[N]) HasNode(n N) bool {
+	_, ok := g.out[n]
+	return ok
+}
+
+// AddNode adds n, and reports whether it was new.
+func (g *Graph[N]) AddNode(n N) bool {
+	if g.HasNode(n) {
+		return false
+	}
+	g.nodes = append(g.nodes, n)
+	g.out[n] = nil
+	if g.directed {
+		g.in[n] = nil
+	}
+	return true
+}
+
+// RemoveNode removes n and all of its edges, and reports whether it was found.
+func (g *Graph[N]) RemoveNode(n N) bool {
+	if !g.HasNode(n) {
+		return false
+	}
+	for _, a := range slices.Clone(g.out[n]) {
+		g.RemoveEdge(n, a.node)
+	}
+	if g.directed {
+		for _, a := range slices.Clone(g.in[n]) {
+			g.RemoveEdge(a.node, n)
+		}
+		delete(g.in, n)
+	}
+	delete(g.out, n)
+	g.nodes = slices.DeleteFunc(g.nodes, func(m N) bool { return m == n })
+	return true
+}
+
+// HasEdge reports whether there is an edge from one node to the other.  In an
+// undirected graph, the order of the nodes does not matter.
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	return indexOf(g.out[from], to) >= 0
+}
+
+// Weight returns the weight of the edge from one node to the other, and whether the
+// edge exists.
+func (g *Graph[N]) Weight(from, to N) (float64, bool) {
+	i := indexOf(g.out[from], to)
+	if i < 0 {
+		return 0, false
+	}
+	return g.out[from][i].weight, true
+}
+
+// AddEdge adds an edge of weight 1 from one node to the other, adding the nodes if
+// needed, and reports whether the edge was new.
+func (g *Graph[N]) AddEdge(from, to N) bool {
+	return g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge of the given weight from one node to the other, adding
+// the nodes if needed, and reports whether the edge was new.  The weight of an existing
+// edge is updated.
+func (g *Graph[N]) AddWeightedEdge(from, to N, weight float64) bool {
+	if i := indexOf(g.out[from], to); i >= 0 {
+		g.out[from][i].weight = weight
+		switch {
+		case g.directed:
+			g.in[to][indexOf(g.in[to], from)].weight = weight
+		case from != to:
+			g.out[to][indexOf(g.out[to], from)].weight = weight
+		}
+		return false
+	}
+	g.AddNode(from)
+	g.AddNode(to)
+	g.out[from] = append(g.out[from], arc[N]{node: to, weight: weight})
+	switch {
+	case g.directed:
+		g.in[to] = append(g.in[to], arc[N]{node: from, weight: weight})
+	case from != to:
+		g.out[to] = append(g.out[to], arc[N]{node: from, weight: weight})
+	}
+	g.edges++
+	return true
+}
+
+// RemoveEdge removes the edge from one node to the other, and reports whether it was
+// found.
+func (g *Graph[N]) RemoveEdge(from, to N) bool {
+	if !g.HasEdge(from, to) {
+		return false
+	}
+	g.out[from] = remove(g.out[from], to)
+	switch {
+	case g.directed:
+		g.in[to] = remove(g.in[to], from)
+	case from != to:
+		g.out[to] = remove(g.out[to], from)
+	}
+	g.edges--
+	return true
+}
+
+// Neighbors returns the successors of n, or its neighbors in an undirected graph, in
+// the order their edges were added.
+func (g *Graph[N]) Neighbors(n N) []N {
+	result := make([]N, len(g.out[n]))
+	for i, a := range g.out[n] {
+		result[i] = a.node
+	}
+	return result
+}
+
+// Edges returns the edges, grouped by source node in the order nodes were added.  An
+// undirected edge is returned once, from the node added first.
+func (g *Graph[N]) Edges() []Edge[N] {
+	result := make([]Edge[N], 0, g.edges)
+	seen := make(map[N]bool, len(g.nodes))
+	for _, n := range g.nodes {
+		seen[n] = true
+		for _, a := range g.out[n] {
+			if g.directed || !seen[a.node] || a.node == n {
+				result = append(result, Edge[N]{From: n, To: a.node, Weight: a.weight})
+			}
+		}
+	}
+	return result
+}
+
+// BFS returns an iterator over the nodes reachable from start, in breadth-first order.
+// It yields nothing if start is not in the graph.
+func (g *Graph[N]) BFS(start N) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := map[N]bool{start: true}
+		queue := []N{start}
+		for len(queue) > 0 {
+			n := queue[0]
+			queue = queue[1:]
+			if !yield(n) {
+				return
+			}
+			for _, a := range g.out[n] {
+				if !visited[a.node] {
+					visited[a.node] = true
+					queue = append(queue, a.node)
+				}
+			}
+		}
+	}
+}
+
+// DFS returns an iterator over the nodes reachable from start, in depth-first preorder:
+// the same order as a recursive traversal, without its depth limit.  It yields nothing
+// if start is not in the graph.
+func (g *Graph[N]) DFS(start N) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := map[N]bool{}
+		stack := []N{start}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited[n] {
+				continue
+			}
+			visited[n] = true
+			if !yield(n) {
+				return
+			}
+			// Push in reverse so that the first neighbor is visited first.
+			for i := len(g.out[n]) - 1; i >= 0; i-- {
+				if m := g.out[n][i].node; !visited[m] {
+					stack = append(stack, m)
+				}
+			}
+		}
+	}
+}
+
+// HasCycle reports whether the graph has a cycle.  In an undirected graph, an edge
+// travelled back and forth is not a cycle, but a self-loop is.
+func (g *Graph[N]) HasCycle() bool {
+	if g.directed {
+		_, err := g.TopologicalSort()
+		return err != nil
+	}
+
+	visited := map[N]bool{}
+	type frame struct{ node, parent N }
+	for _, root := range g.nodes {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		stack := []frame{{node: root, parent: root}}
+		for len(stack) > 0 {
+			f := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, a := range g.out[f.node] {
+				m := a.node
+				if m == f.node {
+					return true
+				}
+				if m == f.parent {
+					continue
+				}
+				if visited[m] {
+					return true
+				}
+				visited[m] = true
+				stack = append(stack, frame{node: m, parent: f.node})
+			}
+		}
+	}
+	return false
+}
+
+// TopologicalSort returns the nodes of a directed graph ordered so that every edge goes
+// from an earlier node to a later one.  The order is deterministic: it follows the order
+// in which nodes and edges were added.  It returns ErrCycle if the graph has a cycle, and
+// ErrUndirected if it is undirected.
+func (g *Graph[N]) TopologicalSort() ([]N, error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+	indegree := make(map[N]int, len(g.nodes))
+	var ready []N
+	for _, n := range g.nodes {
+		indegree[n] = len(g.in[n])
+		if indegree[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+
+	order := make([]N, 0, len(g.nodes))
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		order = append(order, n)
+		for _, a := range g.out[n] {
+			indegree[a.node]--
+			if indegree[a.node] == 0 {
+				ready = append(ready, a.node)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+// indexOf returns the position of the arc to n, or -1 if there is none.
+func indexOf[N comparable](arcs []arc[N], n N) int {
+	return slices.IndexFunc(arcs, func(a arc[N]) bool { return a.node == n })
+}
+
+// remove returns arcs without the arc to n.
+func remove[N comparable](arcs []arc[N], n N) []arc[N] {
+	if i := indexOf(arcs, n); i >= 0 {
+		return slices.Delete(arcs, i, i+1)
+	}
+	return arcs
+}
@@ -0,0 +1,49 @@
+package igraph
+
+import "fmt"
+
+func ExampleGraph_TopologicalSort() {
+	deps := NewDirected[string]()
+	deps.AddEdge("fetch", "build")
+	deps.AddEdge("build", "test")
+	deps.AddEdge("build", "package")
+	deps.AddEdge("test", "package")
+
+	order, err := deps.TopologicalSort()
+	fmt.Println(order, err)
+
+	deps.AddEdge("package", "fetch")
+	_, err = deps.TopologicalSort()
+	fmt.Println(err)
+
+	// Output:
+	// [fetch build test package] <nil>
+	// graph has a cycle
+}
+
+func ExampleGraph_Dijkstra() {
+	roads := NewUndirected[string]()
+	roads.AddWeightedEdge("home", "market", 2)
+	roads.AddWeightedEdge("market", "office", 3)
+	roads.AddWeightedEdge("home", "office", 7)
+
+	paths, _ := roads.Dijkstra("home")
+	path, _ := paths.PathTo("office")
+	fmt.Println(path.Nodes, path.Cost)
+
+	// Output:
+	// [home market office] 5
+}
+
+func ExampleGraph_Kruskal() {
+	cables := NewUndirected[string]()
+	cables.AddWeightedEdge("a", "b", 1)
+	cables.AddWeightedEdge("b", "c", 2)
+	cables.AddWeightedEdge("a", "c", 4)
+
+	tree, _ := cables.Kruskal()
+	fmt.Println(len(tree.Edges), tree.Weight)
+
+	// Output:
+	// 2 3
+}
@@ -0,0 +1,165 @@
+package igraph
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestGraphDirected(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGraphDirected")
+
+	g := NewDirected[string]()
+	assert.ShouldBeTrue(g.IsDirected())
+	assert.ShouldBeTrue(g.AddEdge("a", "b"))
+	assert.ShouldBeTrue(g.AddEdge("a", "c"))
+	assert.ShouldBeTrue(g.AddEdge("b", "d"))
+	assert.ShouldBeFalse(g.AddEdge("a", "b"))
+	assert.ShouldBeTrue(g.AddNode("e"))
+	assert.ShouldBeFalse(g.AddNode("e"))
+
+	assert.Equal(5, g.NodeCount())
+	assert.Equal(3, g.EdgeCount())
+	assert.Equal([]string{"a", "b", "c", "d", "e"}, g.Nodes())
+	assert.Equal([]string{"b", "c"}, g.Neighbors("a"))
+	assert.ShouldBeTrue(g.HasEdge("a", "b"))
+	assert.ShouldBeFalse(g.HasEdge("b", "a"))
+
+	assert.ShouldBeTrue(g.RemoveEdge("a", "c"))
+	assert.ShouldBeFalse(g.RemoveEdge("a", "c"))
+	assert.Equal(2, g.EdgeCount())
+
+	assert.ShouldBeTrue(g.RemoveNode("b"))
+	assert.ShouldBeFalse(g.RemoveNode("b"))
+	assert.ShouldBeFalse(g.HasNode("b"))
+	assert.Equal(0, g.EdgeCount())
+	assert.Equal([]string{"a", "c", "d", "e"}, g.Nodes())
+	assert.Equal([]string{}, g.Neighbors("a"))
+}
+
+func TestGraphUndirected(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGraphUndirected")
+
+	g := NewUndirected[int]()
+	assert.ShouldBeFalse(g.IsDirected())
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	assert.ShouldBeFalse(g.AddEdge(2, 1))
+
+	assert.Equal(2, g.EdgeCount())
+	assert.ShouldBeTrue(g.HasEdge(2, 1))
+	assert.Equal([]int{1, 3}, g.Neighbors(2))
+	assert.ShouldBeFalse(g.HasCycle())
+
+	_, err := g.TopologicalSort()
+	assert.Equal(ErrUndirected, err)
+
+	g.AddEdge(3, 1)
+	assert.ShouldBeTrue(g.HasCycle())
+	assert.ShouldBeTrue(g.RemoveEdge(1, 3))
+	assert.ShouldBeFalse(g.HasCycle())
+
+	g.AddEdge(4, 4)
+	assert.ShouldBeTrue(g.HasCycle())
+	assert.ShouldBeTrue(g.RemoveNode(4))
+	assert.Equal(2, g.EdgeCount())
+}
+
+func TestGraphTraversals(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGraphTraversals")
+
+	//   1 -> 2 -> 4
+	//   |    |
+	//   v    v
+	//   3 -> 5    6
+	g := NewDirected[int]()
+	for _, e := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}, {3, 5}} {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddNode(6)
+
+	assert.Equal([]int{1, 2, 3, 4, 5}, slices.Collect(g.BFS(1)))
+	assert.Equal([]int{1, 2, 4, 5, 3}, slices.Collect(g.DFS(1)))
+	assert.Equal([]int{3, 5}, slices.Collect(g.DFS(3)))
+	assert.Equal([]int{6}, slices.Collect(g.BFS(6)))
+	assert.Equal([]int(nil), slices.Collect(g.BFS(7)))
+
+	var firstTwo []int
+	for n := range g.DFS(1) {
+		firstTwo = append(firstTwo, n)
+		if len(firstTwo) == 2 {
+			break
+		}
+	}
+	assert.Equal([]int{1, 2}, firstTwo)
+}
+
+func TestGraphTopologicalSort(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGraphTopologicalSort")
+
+	g := NewDirected[string]()
+	g.AddNode("app")
+	g.AddEdge("log", "app")
+	g.AddEdge("db", "app")
+	g.AddEdge("config", "db")
+	g.AddEdge("config", "log")
+
+	order, err := g.TopologicalSort()
+	assert.IsNil(err)
+	assert.Equal([]string{"config", "db", "log", "app"}, order)
+	assert.ShouldBeFalse(g.HasCycle())
+
+	g.AddEdge("app", "config")
+	_, err = g.TopologicalSort()
+	assert.Equal(ErrCycle, err)
+	assert.ShouldBeTrue(g.HasCycle())
+
+	self := NewDirected[int]()
+	self.AddEdge(1, 1)
+	assert.ShouldBeTrue(self.HasCycle())
+}
+
+func TestGraphWeightedEdges(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGraphWeightedEdges")
+
+	g := NewUndirected[string]()
+	assert.ShouldBeTrue(g.AddWeightedEdge("a", "b", 2.5))
+	g.AddEdge("b", "c")
+	g.AddWeightedEdge("c", "c", 4)
+
+	w, ok := g.Weight("b", "a")
+	assert.Equal(2.5, w)
+	assert.ShouldBeTrue(ok)
+	w, _ = g.Weight("b", "c")
+	assert.Equal(1.0, w)
+	_, ok = g.Weight("a", "c")
+	assert.ShouldBeFalse(ok)
+
+	assert.ShouldBeFalse(g.AddWeightedEdge("b", "a", 3))
+	w, _ = g.Weight("a", "b")
+	assert.Equal(3.0, w)
+
+	assert.Equal([]Edge[string]{
+		{From: "a", To: "b", Weight: 3},
+		{From: "b", To: "c", Weight: 1},
+		{From: "c", To: "c", Weight: 4},
+	}, g.Edges())
+
+	d := NewDirected[int]()
+	d.AddWeightedEdge(1, 2, 5)
+	d.AddWeightedEdge(2, 1, 7)
+	d.AddWeightedEdge(1, 2, 6)
+	assert.Equal([]Edge[int]{{From: 1, To: 2, Weight: 6}, {From: 2, To: 1, Weight: 7}}, d.Edges())
+	assert.Equal(2, d.EdgeCount())
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package igraph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/idichekop/gods/iqueues"
+)
+
+// SpanningTree is a set of edges connecting the nodes of a graph without cycles, and
+// its total weight.  For a disconnected graph, it is a spanning forest: one tree per
+// connected component.
+type SpanningTree[N comparable] struct {
+	Edges  []Edge[N]
+	Weight float64
+}
+
+// Kruskal computes a minimum spanning tree of an undirected graph with Kruskal's
+// algorithm, in O(E log E).  The edges are returned by increasing weight.  It returns
+// ErrDirected if the graph is directed.
+func (g *Graph[N]) Kruskal() (SpanningTree[N], error) {
+	if g.directed {
+		return SpanningTree[N]{}, ErrDirected
+	}
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b Edge[N]) int {
+		return cmp.Compare(a.Weight, b.Weight)
+	})
+
+	components := newDisjointSet[N]()
+	tree := SpanningTree[N]{Edges: []Edge[N]{}}
+	for _, e := range edges {
+		if components.union(e.From, e.To) {
+			tree.Edges = append(tree.Edges, e)
+			tree.Weight += e.Weight
+		}
+	}
+	return tree, nil
+}
+
+// Prim computes a minimum spanning tree of an undirected graph with Prim's algorithm,
+// in O(E log E).  Each tree grows from the first node of its component, and edges are
+// returned in the order they join the tree.  It returns ErrDirected if the graph is
+// directed.
+func (g *Graph[N]) Prim() (SpanningTree[N], error) {
+	if g.directed {
+		return SpanningTree[N]{}, ErrDirected
+	}
+	inTree := make(map[N]bool, len(g.nodes))
+	tree := SpanningTree[N]{Edges: []Edge[N]{}}
+	candidates := iqueues.NewPriorityQueueFunc(func(a, b Edge[N]) int {
+		return cmp.Compare(a.Weight, b.Weight)
+	})
+
+	grow := func(n N) {
+		inTree[n] = true
+		for _, a := range g.out[n] {
+			if !inTree[a.node] {
+				candidates.Push(Edge[N]{From: n, To: a.node, Weight: a.weight})
+			}
+		}
+	}
+	for _, root := range g.nodes {
+		if inTree[root] {
+			continue
+		}
+		grow(root)
+		for !candidates.IsEmpty() {
+			e, _ := candidates.Pop()
+			if inTree[e.To] {
+				continue
+			}
+			tree.Edges = append(tree.Edges, e)
+			tree.Weight += e.Weight
+			grow(e.To)
+		}
+	}
+	return tree, nil
+}
+
+// disjointSet is a union-find structure with path halving and union by size.
+type disjointSet[N comparable] struct {
+	parent map[N]N
+	size   map[N]int
+}
+
+func newDisjointSet[N comparable]() *disjointSet[N] {
+	return &disjointSet[N]{parent: map[N]N{}, size: map[N]int{}}
+}
+
+// find returns the representative of the set of n, creating the set if needed.
+func (ds *disjointSet[N]) find(n N) N {
+	if _, ok := ds.parent[n]; !ok {
+		ds.parent[n], ds.size[n] = n, 1
+		return n
+	}
+	for ds.parent[n] != n {
+		ds.parent[n] = ds.parent[ds.parent[n]]
+		n = ds.parent[n]
+	}
+	return n
+}
+
+// union merges the sets of a and b, and reports whether they were distinct.
+func (ds *disjointSet[N]) union(a, b N) bool {
+	ra, rb := ds.find(a), ds.find(b)
+	if ra == rb {
+		return false
+	}
+	if ds.size[ra] < ds.size[rb] {
+		ra, rb = rb, ra
+	}
+	ds.parent[rb] = ra
+	ds.size[ra] += ds.size[rb]
+	return true
+}
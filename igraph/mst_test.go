@@ -0,0 +1,85 @@
+package igraph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMinimumSpanningTree")
+
+	g := NewUndirected[string]()
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("b", "c", 2)
+	g.AddWeightedEdge("b", "d", 5)
+	g.AddWeightedEdge("c", "d", 8)
+	g.AddWeightedEdge("x", "y", 3)
+
+	kruskal, err := g.Kruskal()
+	assert.IsNil(err)
+	assert.Equal(SpanningTree[string]{
+		Edges: []Edge[string]{
+			{From: "a", To: "c", Weight: 1},
+			{From: "b", To: "c", Weight: 2},
+			{From: "x", To: "y", Weight: 3},
+			{From: "b", To: "d", Weight: 5},
+		},
+		Weight: 11,
+	}, kruskal)
+
+	prim, err := g.Prim()
+	assert.IsNil(err)
+	assert.Equal(SpanningTree[string]{
+		Edges: []Edge[string]{
+			{From: "a", To: "c", Weight: 1},
+			{From: "c", To: "b", Weight: 2},
+			{From: "b", To: "d", Weight: 5},
+			{From: "x", To: "y", Weight: 3},
+		},
+		Weight: 11,
+	}, prim)
+
+	empty, _ := NewUndirected[int]().Kruskal()
+	assert.Equal(0, len(empty.Edges))
+
+	_, err = NewDirected[int]().Kruskal()
+	assert.Equal(ErrDirected, err)
+	_, err = NewDirected[int]().Prim()
+	assert.Equal(ErrDirected, err)
+}
+
+func TestMinimumSpanningTreeAgree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMinimumSpanningTreeAgree")
+
+	rng := rand.New(rand.NewSource(8))
+	g := NewUndirected[int]()
+	for range 300 {
+		g.AddWeightedEdge(rng.Intn(80), rng.Intn(80), float64(rng.Intn(50)))
+	}
+
+	kruskal, _ := g.Kruskal()
+	prim, _ := g.Prim()
+	assert.Equal(kruskal.Weight, prim.Weight)
+	assert.Equal(len(kruskal.Edges), len(prim.Edges))
+
+	// a spanning forest has one edge less than nodes per component
+	components := 0
+	seen := map[int]bool{}
+	for _, n := range g.Nodes() {
+		if seen[n] {
+			continue
+		}
+		components++
+		for m := range g.BFS(n) {
+			seen[m] = true
+		}
+	}
+	assert.Equal(g.NodeCount()-components, len(kruskal.Edges))
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package igraph
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/idichekop/gods/iqueues"
+)
+
+var (
+	// ErrNegativeWeight is returned by Dijkstra when the graph has an edge of negative
+	// weight.
+	ErrNegativeWeight = errors.New("graph has a negative weight")
+	// ErrNegativeCycle is returned by BellmanFord when a cycle of negative total weight
+	// is reachable from the source.
+	ErrNegativeCycle = errors.New("graph has a negative cycle")
+)
+
+// Path is a sequence of nodes joined by edges, and its total weight.
+type Path[N comparable] struct {
+	Nodes []N
+	Cost  float64
+}
+
+// ShortestPaths holds the shortest paths from a source node to every node reachable
+// from it, as computed by Dijkstra or BellmanFord.
+type ShortestPaths[N comparable] struct {
+	Source N
+	dist   map[N]float64
+	// prev holds the node preceding each node on its shortest path.
+	prev map[N]N
+}
+
+// Dist returns the cost of the shortest path to n, or false if n is not reachable.
+func (sp *ShortestPaths[N]) Dist(n N) (float64, bool) {
+	d, ok := sp.dist[n]
+	return d, ok
+}
+
+// PathTo returns the shortest path to n, or false if n is not reachable.
+func (sp *ShortestPaths[N]) PathTo(n N) (Path[N], bool) {
+	cost, ok := sp.dist[n]
+	if !ok {
+		return Path[N]{}, false
+	}
+	nodes := []N{n}
+	for n != sp.Source {
+		n = sp.prev[n]
+		nodes = append(nodes, n)
+	}
+	slices.Reverse(nodes)
+	return Path[N]{Nodes: nodes, Cost: cost}, true
+}
+
+// Dijkstra computes the shortest paths from source with Dijkstra's algorithm, in
+// O((V + E) log V).  It returns ErrNodeNotFound if source is not in the graph, and
+// ErrNegativeWeight if an edge has a negative weight; use BellmanFord then.
+func (g *Graph[N]) Dijkstra(source N) (*ShortestPaths[N], error) {
+	if !g.HasNode(source) {
+		return nil, ErrNodeNotFound
+	}
+	for _, arcs := range g.out {
+		for _, a := range arcs {
+			if a.weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+		}
+	}
+
+	type entry struct {
+		node N
+		dist float64
+	}
+	sp := &ShortestPaths[N]{Source: source, dist: map[N]float64{source: 0}, prev: map[N]N{}}
+	pq := iqueues.NewPriorityQueueFunc(func(a, b entry) int {
+		return cmp.Compare(a.dist, b.dist)
+	})
+	handles := map[N]*iqueues.Handle[entry]{source: pq.Push(entry{node: source})}
+
+	for !pq.IsEmpty() {
+		e, _ := pq.Pop()
+		for _, a := range g.out[e.node] {
+			d := e.dist + a.weight
+			if old, seen := sp.dist[a.node]; seen && d >= old {
+				continue
+			}
+			sp.dist[a.node], sp.prev[a.node] = d, e.node
+			if h, ok := handles[a.node]; ok {
+				pq.Update(h, entry{node: a.node, dist: d})
+			} else {
+				handles[a.node] = pq.Push(entry{node: a.node, dist: d})
+			}
+		}
+	}
+	return sp, nil
+}
+
+// BellmanFord computes the shortest paths from source with the Bellman-Ford algorithm,
+// in O(V * E).  Unlike Dijkstra, it accepts negative weights.  It returns
+// ErrNodeNotFound if source is not in the graph, and ErrNegativeCycle if a cycle of
+// negative total weight is reachable from source.  In an undirected graph, any
+// reachable edge of negative weight forms such a cycle.
+func (g *Graph[N]) BellmanFord(source N) (*ShortestPaths[N], error) {
+	if !g.HasNode(source) {
+		return nil, ErrNodeNotFound
+	}
+	sp := &ShortestPaths[N]{Source: source, dist: map[N]float64{source: 0}, prev: map[N]N{}}
+
+	// relax makes a pass over all edges, and reports whether a distance improved.
+	relax := func() bool {
+		improved := false
+		for _, n := range g.nodes {
+			dn, reached := sp.dist[n]
+			if !reached {
+				continue
+			}
+			for _, a := range g.out[n] {
+				if d, seen := sp.dist[a.node]; !seen || dn+a.weight < d {
+					sp.dist[a.node], sp.prev[a.node] = dn+a.weight, n
+					improved = true
+				}
+			}
+		}
+		return improved
+	}
+
+	for range len(g.nodes) - 1 {
+		if !relax() {
+			return sp, nil
+		}
+	}
+	if relax() {
+		return nil, ErrNegativeCycle
+	}
+	return sp, nil
+}
@@ -0,0 +1,114 @@
+package igraph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestDijkstra(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestDijkstra")
+
+	g := NewDirected[string]()
+	g.AddWeightedEdge("s", "a", 4)
+	g.AddWeightedEdge("s", "b", 1)
+	g.AddWeightedEdge("b", "a", 2)
+	g.AddWeightedEdge("a", "t", 1)
+	g.AddWeightedEdge("b", "t", 5)
+	g.AddNode("island")
+
+	sp, err := g.Dijkstra("s")
+	assert.IsNil(err)
+
+	path, ok := sp.PathTo("t")
+	assert.ShouldBeTrue(ok)
+	assert.Equal(Path[string]{Nodes: []string{"s", "b", "a", "t"}, Cost: 4}, path)
+	d, _ := sp.Dist("a")
+	assert.Equal(3.0, d)
+	path, _ = sp.PathTo("s")
+	assert.Equal(Path[string]{Nodes: []string{"s"}, Cost: 0}, path)
+	_, ok = sp.PathTo("island")
+	assert.ShouldBeFalse(ok)
+
+	_, err = g.Dijkstra("nowhere")
+	assert.Equal(ErrNodeNotFound, err)
+
+	g.AddWeightedEdge("t", "s", -1)
+	_, err = g.Dijkstra("s")
+	assert.Equal(ErrNegativeWeight, err)
+}
+
+func TestBellmanFord(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBellmanFord")
+
+	g := NewDirected[int]()
+	g.AddWeightedEdge(0, 1, 4)
+	g.AddWeightedEdge(0, 2, 5)
+	g.AddWeightedEdge(2, 1, -3)
+	g.AddWeightedEdge(1, 3, 2)
+
+	sp, err := g.BellmanFord(0)
+	assert.IsNil(err)
+	path, _ := sp.PathTo(3)
+	assert.Equal(Path[int]{Nodes: []int{0, 2, 1, 3}, Cost: 4}, path)
+
+	// a negative cycle not reachable from the source is ignored
+	g.AddWeightedEdge(4, 5, -1)
+	g.AddWeightedEdge(5, 4, -1)
+	_, err = g.BellmanFord(0)
+	assert.IsNil(err)
+
+	g.AddWeightedEdge(3, 4, 0)
+	_, err = g.BellmanFord(0)
+	assert.Equal(ErrNegativeCycle, err)
+
+	_, err = g.BellmanFord(9)
+	assert.Equal(ErrNodeNotFound, err)
+
+	u := NewUndirected[int]()
+	u.AddWeightedEdge(0, 1, -1)
+	_, err = u.BellmanFord(0)
+	assert.Equal(ErrNegativeCycle, err)
+}
+
+func TestShortestPathsAgree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestShortestPathsAgree")
+
+	rng := rand.New(rand.NewSource(6))
+	g := NewDirected[int]()
+	for range 400 {
+		g.AddWeightedEdge(rng.Intn(60), rng.Intn(60), float64(rng.Intn(20)))
+	}
+
+	dijkstra, err := g.Dijkstra(0)
+	assert.IsNil(err)
+	bellmanFord, err := g.BellmanFord(0)
+	assert.IsNil(err)
+
+	for _, n := range g.Nodes() {
+		d1, ok1 := dijkstra.Dist(n)
+		d2, ok2 := bellmanFord.Dist(n)
+		assert.Equal(ok2, ok1)
+		assert.Equal(d2, d1)
+
+		// the path found is made of existing edges and adds up to its cost
+		path, ok := dijkstra.PathTo(n)
+		if !ok {
+			continue
+		}
+		cost := 0.0
+		for i := 1; i < len(path.Nodes); i++ {
+			w, exists := g.Weight(path.Nodes[i-1], path.Nodes[i])
+			assert.ShouldBeTrue(exists)
+			cost += w
+		}
+		assert.Equal(path.Cost, cost)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package ilists
+
+import "github.com/idichekop/gods/internal/ibinary"
+
+// MarshalBinary encodes the elements of the list, from the front to the back.
+func (l *List[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(l.All())
+}
+
+// UnmarshalBinary replaces the contents of the list with the elements encoded by
+// MarshalBinary.  The elements previously held are removed from the list.
+func (l *List[T]) UnmarshalBinary(data []byte) error {
+	values, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	for e := l.Front(); e != nil; e = l.Front() {
+		l.Remove(e)
+	}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the elements of the list, from the head to the end.  As for JSON,
+// ConsList has no UnmarshalBinary: decode a slice and pass it to ConsOf instead.
+func (l *ConsList[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(l.All())
+}
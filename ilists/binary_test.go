@@ -0,0 +1,25 @@
+package ilists
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestListBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestListBinary")
+
+	data, err := New(1, 2, 3).MarshalBinary()
+	assert.IsNil(err)
+
+	l := New(9)
+	assert.IsNil(l.UnmarshalBinary(data))
+	assert.Equal([]int{1, 2, 3}, l.ToSlice())
+
+	data, err = ConsOf(4, 5).MarshalBinary()
+	assert.IsNil(err)
+	assert.IsNil(l.UnmarshalBinary(data))
+	assert.Equal([]int{4, 5}, l.ToSlice())
+}
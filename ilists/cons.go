@@ -0,0 +1,132 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package ilists
+
+import "iter"
+
+// ConsList is a persistent (immutable) singly linked list.  Prepending shares the
+// existing list as the tail of the new one, so a list is never copied nor modified: it can
+// be shared across goroutines without locking.  The nil *ConsList is the empty list.
+type ConsList[T any] struct {
+	head T
+	tail *ConsList[T]
+	len  int
+}
+
+// Cons returns the list made of head followed by tail, in O(1).
+func Cons[T any](head T, tail *ConsList[T]) *ConsList[T] {
+	return &ConsList[T]{head: head, tail: tail, len: tail.Len() + 1}
+}
+
+// ConsOf returns the list of the given values, in order.
+func ConsOf[T any](values ...T) *ConsList[T] {
+	var l *ConsList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = Cons(values[i], l)
+	}
+	return l
+}
+
+// IsEmpty reports whether the list is empty.
+func (l *ConsList[T]) IsEmpty() bool {
+	return l == nil
+}
+
+// Len returns the number of elements, in O(1).
+func (l *ConsList[T]) Len() int {
+	if l == nil {
+		return 0
+	}
+	return l.len
+}
+
+// Head returns the first element, or false if the list is empty.
+func (l *ConsList[T]) Head() (T, bool) {
+	if l == nil {
+		var zero T
+		return zero, false
+	}
+	return l.head, true
+}
+
+// Tail returns the list without its first element, in O(1).  The tail of the empty list
+// is the empty list.
+func (l *ConsList[T]) Tail() *ConsList[T] {
+	if l == nil {
+		return nil
+	}
+	return l.tail
+}
+
+// Prepend returns the list made of value followed by l, in O(1).
+func (l *ConsList[T]) Prepend(value T) *ConsList[T] {
+	return Cons(value, l)
+}
+
+// All returns an iterator over the elements, in order.
+func (l *ConsList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l; n != nil; n = n.tail {
+			if !yield(n.head) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, in order.
+func (l *ConsList[T]) Values() iter.Seq[T] {
+	return l.All()
+}
+
+// ToSlice returns the elements of the list, in order.
+func (l *ConsList[T]) ToSlice() []T {
+	result := make([]T, 0, l.Len())
+	for v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Filter returns the list of the elements that pass the predicate function.  The longest
+// suffix of l whose elements all pass is shared with the result.
+func (l *ConsList[T]) Filter(predicate func(item T) bool) *ConsList[T] {
+	values := l.ToSlice()
+	pass := make([]bool, len(values))
+	// suffix is the start of the longest suffix whose elements all pass.
+	suffix := len(values)
+	for i := len(values) - 1; i >= 0; i-- {
+		pass[i] = predicate(values[i])
+		if pass[i] && suffix == i+1 {
+			suffix = i
+		}
+	}
+
+	result := l.drop(suffix)
+	for i := suffix - 1; i >= 0; i-- {
+		if pass[i] {
+			result = Cons(values[i], result)
+		}
+	}
+	return result
+}
+
+// drop returns the list without its n first elements.
+func (l *ConsList[T]) drop(n int) *ConsList[T] {
+	for range n {
+		l = l.Tail()
+	}
+	return l
+}
+
+// MapCons returns the list of the results of the iteratee on each element.
+func MapCons[T any, U any](l *ConsList[T], iteratee func(item T) U) *ConsList[U] {
+	values := l.ToSlice()
+	var result *ConsList[U]
+	for i := len(values) - 1; i >= 0; i-- {
+		result = Cons(iteratee(values[i]), result)
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package ilists
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestConsList(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConsList")
+
+	var empty *ConsList[int]
+	assert.ShouldBeTrue(empty.IsEmpty())
+	assert.Equal(0, empty.Len())
+	_, ok := empty.Head()
+	assert.ShouldBeFalse(ok)
+	assert.IsNil(empty.Tail())
+	assert.Equal([]int{}, empty.ToSlice())
+
+	base := ConsOf(2, 3)
+	a := base.Prepend(1)
+	b := Cons(10, base)
+
+	assert.Equal([]int{1, 2, 3}, a.ToSlice())
+	assert.Equal([]int{10, 2, 3}, b.ToSlice())
+	assert.Equal(3, a.Len())
+
+	// both lists share base as their tail
+	assert.Equal(base, a.Tail())
+	assert.Equal(base, b.Tail())
+
+	head, ok := a.Head()
+	assert.Equal(1, head)
+	assert.ShouldBeTrue(ok)
+	assert.Equal([]int{1, 2}, slices.Collect(a.All())[:2])
+}
+
+func TestConsListFilterAndMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConsListFilterAndMap")
+
+	l := ConsOf(1, 2, 3, 4, 6, 8)
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	evens := l.Filter(isEven)
+	assert.Equal([]int{2, 4, 6, 8}, evens.ToSlice())
+	assert.Equal(4, evens.Len())
+	// the suffix [4, 6, 8] is shared
+	assert.Equal(l.drop(3), evens.Tail())
+
+	assert.Equal(l, l.Filter(func(int) bool { return true }))
+	assert.IsNil(l.Filter(func(int) bool { return false }))
+	assert.Equal([]int{1, 3}, l.Filter(func(n int) bool { return n%2 == 1 }).ToSlice())
+
+	labels := MapCons(l, strconv.Itoa)
+	assert.Equal([]string{"1", "2", "3", "4", "6", "8"}, labels.ToSlice())
+	assert.Equal([]int{1, 2, 3, 4, 6, 8}, l.ToSlice())
+}
+
+func TestConsListSharedAcrossGoroutines(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConsListSharedAcrossGoroutines")
+
+	history := ConsOf("start")
+
+	var wg sync.WaitGroup
+	results := make([]*ConsList[string], 8)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = history.Prepend(strconv.Itoa(i))
+		}()
+	}
+	wg.Wait()
+
+	for i, r := range results {
+		assert.Equal([]string{strconv.Itoa(i), "start"}, r.ToSlice())
+	}
+	assert.Equal([]string{"start"}, history.ToSlice())
+}
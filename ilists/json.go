@@ -0,0 +1,35 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package ilists
+
+import "github.com/idichekop/gods/internal/ijson"
+
+// MarshalJSON encodes the list as a JSON array, from the front to the back.
+func (l *List[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(l.All())
+}
+
+// UnmarshalJSON replaces the contents of the list with the elements of a JSON array, in
+// order.  The elements previously held are removed from the list.
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	values, err := ijson.UnmarshalArray[T](data)
+	if err != nil || values == nil {
+		return err
+	}
+	for e := l.Front(); e != nil; e = l.Front() {
+		l.Remove(e)
+	}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array, from the head to the end.  ConsList has no
+// UnmarshalJSON, as decoding would modify a list that may be shared: decode a slice and
+// pass it to ConsOf instead.
+func (l *ConsList[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(l.All())
+}
@@ -0,0 +1,35 @@
+package ilists
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestListJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestListJSON")
+
+	data, err := json.Marshal(New("a", "b"))
+	assert.IsNil(err)
+	assert.Equal(`["a","b"]`, string(data))
+
+	l := New("x")
+	old := l.Front()
+	assert.IsNil(json.Unmarshal(data, l))
+	assert.Equal([]string{"a", "b"}, l.ToSlice())
+	// The previous elements are no longer part of the list.
+	assert.ShouldBeTrue(old.Next() == nil)
+	l.Remove(old)
+	assert.Equal(2, l.Len())
+
+	var zero List[string]
+	data, _ = json.Marshal(&zero)
+	assert.Equal("[]", string(data))
+
+	data, err = json.Marshal(ConsOf(1, 2, 3))
+	assert.IsNil(err)
+	assert.Equal("[1,2,3]", string(data))
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package ilists implements generic linked lists.
+package ilists
+
+import "iter"
+
+// List is a doubly linked list.  It is the typed counterpart of container/list: elements
+// hold a T instead of an any.  Inserting, removing and moving an element run in O(1).
+// The zero List is empty and ready to use.  A List is not safe for concurrent use.
+type List[T any] struct {
+	// root is a sentinel: root.next is the front of the list and root.prev its back.
+	root Element[T]
+	len  int
+}
+
+// Element is an element of a List.
+type Element[T any] struct {
+	// Value is the value stored in the element.
+	Value T
+
+	next, prev *Element[T]
+	list       *List[T]
+}
+
+// Next returns the next element, or nil at the back of the list.
+func (e *Element[T]) Next() *Element[T] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+	return nil
+}
+
+// Prev returns the previous element, or nil at the front of the list.
+func (e *Element[T]) Prev() *Element[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// New creates a list holding the given values, in order.
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// Len returns the number of elements.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element, or nil if the list is empty.
+func (l *List[T]) Front() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element, or nil if the list is empty.
+func (l *List[T]) Back() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront inserts the value at the front of the list, and returns its element.
+func (l *List[T]) PushFront(value T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: value}, &l.root)
+}
+
+// PushBack inserts the value at the back of the list, and returns its element.
+func (l *List[T]) PushBack(value T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: value}, l.root.prev)
+}
+
+// InsertBefore inserts the value before mark, and returns its element.  If mark is not an
+// element of l, the list is not modified and InsertBefore returns nil.
+func (l *List[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: value}, mark.prev)
+}
+
+// InsertAfter inserts the value after mark, and returns its element.  If mark is not an
+// element of l, the list is not modified and InsertAfter returns nil.
+func (l *List[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&Element[T]{Value: value}, mark)
+}
+
+// Remove removes e from l if it is an element of l, and returns its value.
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+	return e.Value
+}
+
+// MoveToFront moves e to the front of l.  If e is not an element of l, the list is not
+// modified.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
+
+// MoveToBack moves e to the back of l.  If e is not an element of l, the list is not
+// modified.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.move(e, l.root.prev)
+}
+
+// MoveBefore moves e before mark.  If e or mark is not an element of l, or e == mark, the
+// list is not modified.
+func (l *List[T]) MoveBefore(e, mark *Element[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+	l.move(e, mark.prev)
+}
+
+// MoveAfter moves e after mark.  If e or mark is not an element of l, or e == mark, the
+// list is not modified.
+func (l *List[T]) MoveAfter(e, mark *Element[T]) {
+	if e.list != l || mark.list != l || e == mark {
+		return
+	}
+	l.move(e, mark)
+}
+
+// SpliceBack moves all the elements of other, in order, to the back of l, leaving other
+// empty.  The elements keep their identity, so the handles held on them stay valid.  It
+// runs in O(other.Len()), since every element must be reassigned to l.
+func (l *List[T]) SpliceBack(other *List[T]) {
+	if other == l || other.len == 0 {
+		return
+	}
+	l.lazyInit()
+
+	first, last := other.root.next, other.root.prev
+	for e := first; e != &other.root; e = e.next {
+		e.list = l
+	}
+
+	last.next = &l.root
+	first.prev = l.root.prev
+	l.root.prev.next = first
+	l.root.prev = last
+	l.len += other.len
+
+	other.root.next = &other.root
+	other.root.prev = &other.root
+	other.len = 0
+}
+
+// All returns an iterator over the values, from the front to the back of the list.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values, from the front to the back of the list.
+func (l *List[T]) Values() iter.Seq[T] {
+	return l.All()
+}
+
+// Backward returns an iterator over the values, from the back to the front of the list.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the values of the list, from the front to the back.
+func (l *List[T]) ToSlice() []T {
+	result := make([]T, 0, l.len)
+	for v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// lazyInit initializes the sentinel of a zero List.
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+// insert inserts e after at, and returns e.
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+	return e
+}
+
+// unlink removes e from the list.
+func (l *List[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.len--
+}
+
+// move moves e after at.
+func (l *List[T]) move(e, at *Element[T]) {
+	if e == at {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
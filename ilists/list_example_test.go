@@ -0,0 +1,36 @@
+package ilists
+
+import "fmt"
+
+func ExampleList() {
+	recent := New("a", "b", "c")
+
+	// use b: move it to the front, like an LRU does
+	b := recent.Front().Next()
+	recent.MoveToFront(b)
+
+	// evict the least recently used
+	recent.Remove(recent.Back())
+
+	for v := range recent.All() {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// b
+	// a
+}
+
+func ExampleConsList() {
+	history := ConsOf("v1")
+
+	v2 := history.Prepend("v2")
+	branch := history.Prepend("hotfix")
+
+	fmt.Println(v2.ToSlice(), branch.ToSlice(), history.ToSlice())
+	fmt.Println(MapCons(v2, func(s string) int { return len(s) }).ToSlice())
+
+	// Output:
+	// [v2 v1] [hotfix v1] [v1]
+	// [2 2]
+}
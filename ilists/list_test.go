@@ -0,0 +1,98 @@
+package ilists
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestList")
+
+	var l List[int]
+	assert.Equal(0, l.Len())
+	assert.IsNil(l.Front())
+	assert.IsNil(l.Back())
+
+	two := l.PushBack(2)
+	l.PushFront(1)
+	four := l.PushBack(4)
+	three := l.InsertBefore(3, four)
+	l.InsertAfter(5, four)
+
+	assert.Equal([]int{1, 2, 3, 4, 5}, l.ToSlice())
+	assert.Equal([]int{5, 4, 3, 2, 1}, slices.Collect(l.Backward()))
+	assert.Equal(5, l.Len())
+	assert.Equal(3, two.Next().Value)
+	assert.Equal(1, two.Prev().Value)
+	assert.IsNil(l.Front().Prev())
+	assert.IsNil(l.Back().Next())
+
+	assert.Equal(3, l.Remove(three))
+	assert.Equal([]int{1, 2, 4, 5}, l.ToSlice())
+	assert.IsNil(three.Next())
+
+	// operations on foreign elements are ignored
+	other := New(9)
+	assert.IsNil(l.InsertAfter(0, other.Front()))
+	l.Remove(other.Front())
+	assert.Equal(1, other.Len())
+	assert.Equal(4, l.Len())
+}
+
+func TestListMoves(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestListMoves")
+
+	l := New(1, 2, 3, 4)
+	one, four := l.Front(), l.Back()
+
+	l.MoveToFront(four)
+	assert.Equal([]int{4, 1, 2, 3}, l.ToSlice())
+
+	l.MoveToBack(four)
+	assert.Equal([]int{1, 2, 3, 4}, l.ToSlice())
+
+	l.MoveAfter(one, four)
+	assert.Equal([]int{2, 3, 4, 1}, l.ToSlice())
+
+	l.MoveBefore(one, l.Front())
+	assert.Equal([]int{1, 2, 3, 4}, l.ToSlice())
+
+	l.MoveBefore(one, one)
+	l.MoveToFront(one)
+	l.MoveAfter(four, four.Prev())
+	assert.Equal([]int{1, 2, 3, 4}, l.ToSlice())
+	assert.Equal(4, l.Len())
+}
+
+func TestListSpliceBack(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestListSpliceBack")
+
+	l := New(1, 2)
+	other := New(3, 4)
+	three := other.Front()
+
+	l.SpliceBack(other)
+	assert.Equal([]int{1, 2, 3, 4}, l.ToSlice())
+	assert.Equal(4, l.Len())
+	assert.Equal(0, other.Len())
+	assert.Equal([]int{}, other.ToSlice())
+
+	// the moved elements now belong to l
+	l.MoveToFront(three)
+	assert.Equal([]int{3, 1, 2, 4}, l.ToSlice())
+
+	var empty List[int]
+	empty.SpliceBack(l)
+	assert.Equal([]int{3, 1, 2, 4}, empty.ToSlice())
+	l.SpliceBack(l)
+	other.PushBack(5)
+	assert.Equal([]int{5}, other.ToSlice())
+}
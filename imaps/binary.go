@@ -0,0 +1,119 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"errors"
+	"maps"
+
+	"github.com/idichekop/gods/internal/ibinary"
+)
+
+// MarshalBinary encodes the keys and values of the map.  It makes a TreeMap usable with
+// gob and net/rpc.
+func (m *TreeMap[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(m.All())
+}
+
+// UnmarshalBinary replaces the contents of the map with the entries encoded by
+// MarshalBinary.  The map keeps its comparator, so it must have been created by
+// NewTreeMap or NewTreeMapFunc.
+func (m *TreeMap[K, V]) UnmarshalBinary(data []byte) error {
+	if m.tree == nil {
+		return errors.New("TreeMap.UnmarshalBinary: map has no comparator")
+	}
+	keys, values, err := ibinary.DecodePairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	m.tree.Clear()
+	for i, k := range keys {
+		m.Put(k, values[i])
+	}
+	return nil
+}
+
+// MarshalBinary encodes the keys and values of the list.
+func (s *SkipList[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(s.All())
+}
+
+// UnmarshalBinary replaces the contents of the list with the entries encoded by
+// MarshalBinary.  The list keeps its comparator, so it must have been created by
+// NewSkipList or NewSkipListFunc.
+func (s *SkipList[K, V]) UnmarshalBinary(data []byte) error {
+	if s.compare == nil {
+		return errors.New("SkipList.UnmarshalBinary: list has no comparator")
+	}
+	keys, values, err := ibinary.DecodePairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	s.clear()
+	for i, k := range keys {
+		s.Put(k, values[i])
+	}
+	return nil
+}
+
+// MarshalBinary encodes the keys of the multimap and their lists of values.
+func (mm *MultiMap[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(maps.All(mm.values))
+}
+
+// UnmarshalBinary replaces the contents of the multimap with the entries encoded by
+// MarshalBinary.
+func (mm *MultiMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, groups, err := ibinary.DecodePairs[K, []V](data)
+	if err != nil {
+		return err
+	}
+	*mm = *NewMultiMap[K, V]()
+	for i, k := range keys {
+		mm.Add(k, groups[i]...)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the keys and values of the map.  As MarshalJSON, it does not
+// encode a consistent snapshot of a map being written.
+func (m *ConcurrentMap[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(m.All())
+}
+
+// UnmarshalBinary replaces the contents of the map with the entries encoded by
+// MarshalBinary, atomically as UnmarshalJSON.
+func (m *ConcurrentMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := ibinary.DecodePairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	decoded := make(map[K]V, len(keys))
+	for i, k := range keys {
+		decoded[k] = values[i]
+	}
+	m.replace(decoded)
+	return nil
+}
+
+// MarshalBinary encodes the keys and values of the current snapshot of the map.
+func (m *COWMap[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(m.Snapshot().All())
+}
+
+// UnmarshalBinary replaces the contents of the map with the entries encoded by
+// MarshalBinary, atomically as UnmarshalJSON.
+func (m *COWMap[K, V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := ibinary.DecodePairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	decoded := make(map[K]V, len(keys))
+	for i, k := range keys {
+		decoded[k] = values[i]
+	}
+	m.replace(decoded)
+	return nil
+}
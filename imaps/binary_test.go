@@ -0,0 +1,109 @@
+package imaps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestTreeMapBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTreeMapBinary")
+
+	type snapshot struct {
+		Name   string
+		Scores *TreeMap[string, int]
+	}
+
+	m := NewTreeMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	var buf bytes.Buffer
+	assert.IsNil(gob.NewEncoder(&buf).Encode(snapshot{Name: "s", Scores: m}))
+
+	decoded := snapshot{Scores: NewTreeMap[string, int]()}
+	decoded.Scores.Put("z", 26)
+	assert.IsNil(gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal("s", decoded.Name)
+	assert.Equal([]string{"a", "b"}, slices.Collect(decoded.Scores.Keys()))
+
+	data, _ := m.MarshalBinary()
+	var zero TreeMap[string, int]
+	assert.IsNotNil(zero.UnmarshalBinary(data))
+	assert.IsNotNil(m.UnmarshalBinary(data[:3]))
+	assert.Equal(2, m.Len())
+}
+
+func TestSkipListBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipListBinary")
+
+	s := NewSkipList[int, string]()
+	for i := range 50 {
+		s.Put(i, "v")
+	}
+	data, err := s.MarshalBinary()
+	assert.IsNil(err)
+
+	decoded := NewSkipList[int, string]()
+	decoded.Put(-1, "gone")
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal(50, decoded.Len())
+	assert.ShouldBeFalse(decoded.Contains(-1))
+	checkSkipList(t, decoded)
+}
+
+func TestMultiMapBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiMapBinary")
+
+	mm := NewMultiMap[string, int]()
+	mm.Add("a", 1, 2)
+	mm.Add("b", 3)
+	data, err := mm.MarshalBinary()
+	assert.IsNil(err)
+
+	var decoded MultiMap[string, int]
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal(mm.ToMap(), decoded.ToMap())
+	assert.Equal(3, decoded.Size())
+}
+
+func TestConcurrentMapBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcurrentMapBinary")
+
+	m := NewConcurrentMap[string, int]()
+	m.Store("a", 1)
+	m.Store("b", 2)
+	data, err := m.MarshalBinary()
+	assert.IsNil(err)
+
+	var decoded ConcurrentMap[string, int]
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal(2, decoded.Len())
+	v, _ := decoded.Load("b")
+	assert.Equal(2, v)
+}
+
+func TestCOWMapBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCOWMapBinary")
+
+	m := COWMapFrom(map[string]int{"a": 1, "b": 2})
+	data, err := m.MarshalBinary()
+	assert.IsNil(err)
+
+	var decoded COWMap[string, int]
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal(m.Snapshot().ToMap(), decoded.Snapshot().ToMap())
+}
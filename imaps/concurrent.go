@@ -0,0 +1,191 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/icontainer"
+)
+
+// DefaultShardCount is the number of shards of a ConcurrentMap created without an
+// explicit count.
+const DefaultShardCount = 32
+
+// ConcurrentMap is a typed map safe for concurrent use.  Its keys are spread over
+// independent shards, each guarded by its own lock, so that writers to different shards
+// do not contend.  Unlike sync.Map, which is tuned for keys written once and read many
+// times, it suits write-heavy workloads.
+type ConcurrentMap[K comparable, V any] struct {
+	hash   icompare.Hasher[K]
+	shards []concurrentShard[K, V]
+}
+
+type concurrentShard[K comparable, V any] struct {
+	mu     sync.RWMutex
+	values map[K]V
+}
+
+// NewConcurrentMap creates an empty ConcurrentMap.  The optional shardCount defaults to
+// DefaultShardCount; it panics if it is not positive.
+func NewConcurrentMap[K comparable, V any](shardCount ...int) *ConcurrentMap[K, V] {
+	n := DefaultShardCount
+	if len(shardCount) > 0 {
+		n = shardCount[0]
+	}
+	if n < 1 {
+		panic("NewConcurrentMap: shard count must be positive")
+	}
+
+	m := &ConcurrentMap[K, V]{hash: icompare.Hash[K](), shards: make([]concurrentShard[K, V], n)}
+	for i := range m.shards {
+		m.shards[i].values = make(map[K]V)
+	}
+	return m
+}
+
+// Load returns the value of key, and whether it was found.
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Store sets the value of key.
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+}
+
+// Delete removes key.
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+}
+
+// LoadOrStore returns the existing value of key and true if there is one.  Otherwise, it
+// stores value and returns it with false.
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.values[key]; ok {
+		return v, true
+	}
+	s.values[key] = value
+	return value, false
+}
+
+// LoadAndDelete removes key, and returns its previous value and whether it was found.
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	delete(s.values, key)
+	return v, ok
+}
+
+// Compute atomically updates key.  The update function receives the current value and
+// whether it was found, and returns the new value and whether to keep it; when keep is
+// false, the key is removed.  Compute returns the new value and keep.
+//
+// The update function runs under the lock of the key's shard: it must be fast, and must
+// not use the map.
+func (m *ConcurrentMap[K, V]) Compute(key K, update func(current V, found bool) (newValue V, keep bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, found := s.values[key]
+	v, keep := update(current, found)
+	if keep {
+		s.values[key] = v
+	} else {
+		delete(s.values, key)
+	}
+	return v, keep
+}
+
+// Len returns the number of keys.
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.values)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Range calls f for each key and value, until f returns false.  Each shard is locked for
+// reading while it is visited, so f must not write to the map.  Range does not see a
+// consistent snapshot: entries of other shards may change during the call.
+func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		for k, v := range s.values {
+			if !f(k, v) {
+				s.mu.RUnlock()
+				return
+			}
+		}
+		s.mu.RUnlock()
+	}
+}
+
+// All returns an iterator over the keys and values, in no particular order.  It has the
+// locking behavior of Range: the loop body must not write to the map.
+func (m *ConcurrentMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Range
+}
+
+// Keys returns an iterator over the keys, in no particular order.  It has the locking
+// behavior of Range.
+func (m *ConcurrentMap[K, V]) Keys() iter.Seq[K] {
+	return icontainer.Keys(m.All())
+}
+
+// Values returns an iterator over the values, in no particular order.  It has the
+// locking behavior of Range.
+func (m *ConcurrentMap[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(m.All())
+}
+
+// replace replaces the contents of the map with values, with all the shards locked.
+func (m *ConcurrentMap[K, V]) replace(values map[K]V) {
+	if m.shards == nil {
+		fresh := NewConcurrentMap[K, V]()
+		m.hash, m.shards = fresh.hash, fresh.shards
+	}
+
+	for i := range m.shards {
+		m.shards[i].mu.Lock()
+	}
+	for i := range m.shards {
+		clear(m.shards[i].values)
+	}
+	for k, v := range values {
+		m.shard(k).values[k] = v
+	}
+	for i := range m.shards {
+		m.shards[i].mu.Unlock()
+	}
+}
+
+// shard returns the shard of key.
+func (m *ConcurrentMap[K, V]) shard(key K) *concurrentShard[K, V] {
+	return &m.shards[m.hash(key)%uint64(len(m.shards))]
+}
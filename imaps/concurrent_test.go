@@ -0,0 +1,136 @@
+package imaps
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcurrentMap")
+
+	m := NewConcurrentMap[string, int]()
+
+	_, ok := m.Load("a")
+	assert.ShouldBeFalse(ok)
+
+	m.Store("a", 1)
+	v, ok := m.Load("a")
+	assert.Equal(1, v)
+	assert.ShouldBeTrue(ok)
+
+	v, loaded := m.LoadOrStore("a", 2)
+	assert.Equal(1, v)
+	assert.ShouldBeTrue(loaded)
+	v, loaded = m.LoadOrStore("b", 2)
+	assert.Equal(2, v)
+	assert.ShouldBeFalse(loaded)
+	assert.Equal(2, m.Len())
+
+	v, ok = m.LoadAndDelete("b")
+	assert.Equal(2, v)
+	assert.ShouldBeTrue(ok)
+	m.Delete("a")
+	assert.Equal(0, m.Len())
+
+	v, keep := m.Compute("c", func(current int, found bool) (int, bool) {
+		assert.ShouldBeFalse(found)
+		return current + 5, true
+	})
+	assert.Equal(5, v)
+	assert.ShouldBeTrue(keep)
+	m.Compute("c", func(current int, found bool) (int, bool) { return 0, false })
+	assert.Equal(0, m.Len())
+
+	for i := range 10 {
+		m.Store(string(rune('a'+i)), i)
+	}
+	visited := 0
+	m.Range(func(_ string, _ int) bool {
+		visited++
+		return visited < 3
+	})
+	assert.Equal(3, visited)
+
+	all := maps.Collect(m.All())
+	assert.Equal(10, len(all))
+	assert.Equal(4, all["e"])
+	keys := slices.Sorted(m.Keys())
+	assert.Equal("a", keys[0])
+	sum := 0
+	for v := range m.Values() {
+		sum += v
+	}
+	assert.Equal(45, sum)
+
+	single := NewConcurrentMap[int, int](1)
+	single.Store(1, 1)
+	single.Store(2, 2)
+	assert.Equal(2, single.Len())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewConcurrentMap[int, int](0)
+}
+
+func TestConcurrentMapParallel(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcurrentMapParallel")
+
+	m := NewConcurrentMap[int, int](4)
+
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				m.Compute(i%10, func(current int, _ bool) (int, bool) {
+					return current + 1, true
+				})
+				m.Store(1000+w*1000+i, i)
+				m.Load(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for i := range 10 {
+		v, _ := m.Load(i)
+		total += v
+	}
+	assert.Equal(8000, total)
+	assert.Equal(10+8000, m.Len())
+}
+
+func BenchmarkConcurrentMapStore(b *testing.B) {
+	m := NewConcurrentMap[int, int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Store(i%4096, i)
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncMapStore(b *testing.B) {
+	var m sync.Map
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Store(i%4096, i)
+			i++
+		}
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"iter"
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// COWMap is a copy-on-write map safe for concurrent use, for read-mostly workloads such
+// as configurations or routing tables.  Reads are lock-free: they look up an immutable
+// snapshot of the map.  Each write copies the map, changes the copy and publishes it
+// atomically, so a write costs O(n); Update batches several changes into one copy.
+// Writers are serialized.  For write-heavy workloads, use ConcurrentMap.
+//
+// The zero COWMap is empty and ready to use.
+type COWMap[K comparable, V any] struct {
+	mu      sync.Mutex
+	current atomic.Pointer[map[K]V]
+}
+
+// COWSnapshot is a read-only view of a COWMap at some point in time.  Later writes to the
+// map do not change it.  The zero COWSnapshot is empty.
+type COWSnapshot[K comparable, V any] struct {
+	values map[K]V
+}
+
+// NewCOWMap creates an empty COWMap.
+func NewCOWMap[K comparable, V any]() *COWMap[K, V] {
+	return &COWMap[K, V]{}
+}
+
+// COWMapFrom creates a COWMap holding a copy of values.
+func COWMapFrom[K comparable, V any](values map[K]V) *COWMap[K, V] {
+	m := NewCOWMap[K, V]()
+	clone := maps.Clone(values)
+	m.current.Store(&clone)
+	return m
+}
+
+// Load returns the value of key, and whether it was found.
+func (m *COWMap[K, V]) Load(key K) (V, bool) {
+	return m.Snapshot().Load(key)
+}
+
+// Len returns the number of keys.
+func (m *COWMap[K, V]) Len() int {
+	return m.Snapshot().Len()
+}
+
+// Store sets the value of key.
+func (m *COWMap[K, V]) Store(key K, value V) {
+	m.Update(func(values map[K]V) {
+		values[key] = value
+	})
+}
+
+// Delete removes key, and reports whether it was found.  The map is not copied if key is
+// absent.
+func (m *COWMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := m.Snapshot().values
+	if _, ok := current[key]; !ok {
+		return false
+	}
+	next := maps.Clone(current)
+	delete(next, key)
+	m.current.Store(&next)
+	return true
+}
+
+// Update applies the changes made by fn to a copy of the map, then publishes the copy: the
+// readers observe either none or all of the changes.  fn must not retain values, nor call
+// the writing methods of m.
+func (m *COWMap[K, V]) Update(fn func(values map[K]V)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next := maps.Clone(m.Snapshot().values)
+	if next == nil {
+		next = make(map[K]V)
+	}
+	fn(next)
+	m.current.Store(&next)
+}
+
+// replace swaps the contents of the map for values, which the map takes ownership of.
+func (m *COWMap[K, V]) replace(values map[K]V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.current.Store(&values)
+}
+
+// Snapshot returns the current contents of the map, in O(1).  The snapshot stays
+// unchanged as the map is written, which makes it a stable view for iterations and
+// consistent multi-key reads.
+func (m *COWMap[K, V]) Snapshot() COWSnapshot[K, V] {
+	if p := m.current.Load(); p != nil {
+		return COWSnapshot[K, V]{values: *p}
+	}
+	return COWSnapshot[K, V]{}
+}
+
+// All returns an iterator over the keys and values of the current snapshot, in no
+// particular order.  The loop body may write to the map.
+func (m *COWMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Snapshot().All()(yield)
+	}
+}
+
+// Keys returns an iterator over the keys of the current snapshot, in no particular order.
+func (m *COWMap[K, V]) Keys() iter.Seq[K] {
+	return icontainer.Keys(m.All())
+}
+
+// Values returns an iterator over the values of the current snapshot, in no particular
+// order.
+func (m *COWMap[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(m.All())
+}
+
+// Load returns the value of key, and whether it was found.
+func (s COWSnapshot[K, V]) Load(key K) (V, bool) {
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Len returns the number of keys.
+func (s COWSnapshot[K, V]) Len() int {
+	return len(s.values)
+}
+
+// All returns an iterator over the keys and values, in no particular order.
+func (s COWSnapshot[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(s.values)
+}
+
+// Keys returns an iterator over the keys, in no particular order.
+func (s COWSnapshot[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(s.values)
+}
+
+// Values returns an iterator over the values, in no particular order.
+func (s COWSnapshot[K, V]) Values() iter.Seq[V] {
+	return maps.Values(s.values)
+}
+
+// ToMap returns a mutable copy of the snapshot.
+func (s COWSnapshot[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, len(s.values))
+	maps.Copy(result, s.values)
+	return result
+}
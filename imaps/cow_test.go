@@ -0,0 +1,93 @@
+package imaps
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestCOWMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCOWMap")
+
+	var m COWMap[string, int]
+	_, ok := m.Load("a")
+	assert.ShouldBeFalse(ok)
+	assert.Equal(0, m.Len())
+	assert.ShouldBeFalse(m.Delete("a"))
+
+	m.Store("a", 1)
+	m.Store("b", 2)
+	v, ok := m.Load("a")
+	assert.Equal(1, v)
+	assert.ShouldBeTrue(ok)
+	assert.Equal(2, m.Len())
+
+	snapshot := m.Snapshot()
+	m.Update(func(values map[string]int) {
+		values["a"] = 10
+		values["c"] = 3
+	})
+	assert.ShouldBeTrue(m.Delete("b"))
+	assert.Equal(map[string]int{"a": 10, "c": 3}, maps.Collect(m.All()))
+	assert.Equal([]string{"a", "c"}, slices.Sorted(m.Keys()))
+	assert.Equal([]int{3, 10}, slices.Sorted(m.Values()))
+
+	// The snapshot is unaffected by the later writes.
+	assert.Equal(2, snapshot.Len())
+	v, _ = snapshot.Load("a")
+	assert.Equal(1, v)
+	assert.Equal(map[string]int{"a": 1, "b": 2}, snapshot.ToMap())
+	assert.Equal([]string{"a", "b"}, slices.Sorted(snapshot.Keys()))
+	assert.Equal([]int{1, 2}, slices.Sorted(snapshot.Values()))
+
+	// The loop body may write to the map.
+	for k, v := range m.All() {
+		m.Store(k+k, v)
+	}
+	assert.Equal(4, m.Len())
+
+	source := map[string]int{"x": 1}
+	from := COWMapFrom(source)
+	source["y"] = 2
+	assert.Equal(1, from.Len())
+	assert.Equal(0, COWMapFrom[string, int](nil).Len())
+}
+
+func TestCOWMapConcurrent(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCOWMapConcurrent")
+
+	m := NewCOWMap[int, int]()
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				m.Store(w*100+i, i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				// Each snapshot is consistent: its length matches its contents.
+				s := m.Snapshot()
+				n := 0
+				for range s.All() {
+					n++
+				}
+				if n != s.Len() {
+					t.Errorf("snapshot of length %d holds %d entries", s.Len(), n)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(400, m.Len())
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// ExpiringMap is a map whose entries expire after a time-to-live (TTL).  Expired entries
+// are never returned; they are evicted lazily when they are accessed, by EvictExpired, or
+// periodically by the goroutine started with StartJanitor.  An ExpiringMap is safe for
+// concurrent use.
+type ExpiringMap[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[K]expiringEntry[V]
+	onEvict func(key K, value V)
+	now     func() time.Time
+}
+
+type expiringEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// NewExpiringMap creates an empty ExpiringMap, whose entries live for ttl unless set with
+// SetWithTTL.  It panics if ttl is not positive.
+func NewExpiringMap[K comparable, V any](ttl time.Duration) *ExpiringMap[K, V] {
+	if ttl <= 0 {
+		panic("NewExpiringMap: ttl must be positive")
+	}
+	return &ExpiringMap[K, V]{ttl: ttl, entries: make(map[K]expiringEntry[V]), now: time.Now}
+}
+
+// OnEvict registers a callback, called with every entry evicted because it expired (not
+// with the entries removed by Delete or overwritten by Set).  The callback is called
+// without holding the lock of the map, so it may use the map.  OnEvict returns the map,
+// for chaining with NewExpiringMap.
+func (m *ExpiringMap[K, V]) OnEvict(callback func(key K, value V)) *ExpiringMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onEvict = callback
+	return m
+}
+
+// Set stores the value of key, with the default TTL of the map.
+func (m *ExpiringMap[K, V]) Set(key K, value V) {
+	m.SetWithTTL(key, value, m.ttl)
+}
+
+// SetWithTTL stores the value of key, expiring after ttl.
+func (m *ExpiringMap[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = expiringEntry[V]{value: value, expiresAt: m.now().Add(ttl)}
+}
+
+// Get returns the value of key, and whether it was found and has not expired.
+func (m *ExpiringMap[K, V]) Get(key K) (V, bool) {
+	m.mu.Lock()
+	e, ok := m.entries[key]
+	if ok && !m.now().Before(e.expiresAt) {
+		delete(m.entries, key)
+		callback := m.onEvict
+		m.mu.Unlock()
+
+		if callback != nil {
+			callback(key, e.value)
+		}
+		var zero V
+		return zero, false
+	}
+	m.mu.Unlock()
+
+	return e.value, ok
+}
+
+// Delete removes key.
+func (m *ExpiringMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+}
+
+// Len returns the number of entries, including the expired entries not evicted yet.
+// Call EvictExpired first for an exact count.
+func (m *ExpiringMap[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entries)
+}
+
+// All returns an iterator over the keys and values that have not expired, in no
+// particular order.  It iterates over a snapshot taken when the iteration starts, so the
+// loop body may use the map.
+func (m *ExpiringMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.mu.Lock()
+		now := m.now()
+		live := make(map[K]V, len(m.entries))
+		for k, e := range m.entries {
+			if now.Before(e.expiresAt) {
+				live[k] = e.value
+			}
+		}
+		m.mu.Unlock()
+
+		for k, v := range live {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys that have not expired, in no particular order.
+func (m *ExpiringMap[K, V]) Keys() iter.Seq[K] {
+	return icontainer.Keys(m.All())
+}
+
+// Values returns an iterator over the values that have not expired, in no particular
+// order.
+func (m *ExpiringMap[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(m.All())
+}
+
+// EvictExpired evicts all the expired entries, and returns their number.
+func (m *ExpiringMap[K, V]) EvictExpired() int {
+	type evicted struct {
+		key   K
+		value V
+	}
+
+	m.mu.Lock()
+	now := m.now()
+	var expired []evicted
+	for k, e := range m.entries {
+		if !now.Before(e.expiresAt) {
+			expired = append(expired, evicted{key: k, value: e.value})
+			delete(m.entries, k)
+		}
+	}
+	callback := m.onEvict
+	m.mu.Unlock()
+
+	if callback != nil {
+		for _, e := range expired {
+			callback(e.key, e.value)
+		}
+	}
+	return len(expired)
+}
+
+// StartJanitor starts a goroutine calling EvictExpired every interval, until ctx is done.
+func (m *ExpiringMap[K, V]) StartJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.EvictExpired()
+			}
+		}
+	}()
+}
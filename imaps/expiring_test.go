@@ -0,0 +1,129 @@
+package imaps
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/idichekop/gods/internal"
+)
+
+// fakeClock is a manually advanced clock for the ExpiringMap tests.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestExpiringMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestExpiringMap")
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evicted []string
+
+	m := NewExpiringMap[string, int](time.Minute).OnEvict(func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	m.now = clock.Now
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, 2*time.Minute)
+	m.Set("c", 3)
+	m.Delete("c")
+
+	v, ok := m.Get("a")
+	assert.Equal(1, v)
+	assert.ShouldBeTrue(ok)
+	assert.Equal(2, m.Len())
+
+	clock.Advance(time.Minute)
+
+	v, ok = m.Get("a")
+	assert.Equal(0, v)
+	assert.ShouldBeFalse(ok)
+	assert.Equal([]string{"a"}, evicted)
+	assert.Equal(1, m.Len())
+
+	_, ok = m.Get("b")
+	assert.ShouldBeTrue(ok)
+
+	clock.Advance(time.Minute)
+	assert.Equal(1, m.EvictExpired())
+	assert.Equal([]string{"a", "b"}, evicted)
+	assert.Equal(0, m.Len())
+
+	m.Set("d", 4)
+	m.Set("d", 5)
+	v, _ = m.Get("d")
+	assert.Equal(5, v)
+	assert.Equal(0, m.EvictExpired())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewExpiringMap[string, int](0)
+}
+
+func TestExpiringMapIterators(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestExpiringMapIterators")
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	m := NewExpiringMap[string, int](time.Minute)
+	m.now = clock.Now
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, 2*time.Minute)
+	clock.Advance(time.Minute)
+
+	assert.Equal(map[string]int{"b": 2}, maps.Collect(m.All()))
+	assert.Equal([]string{"b"}, slices.Collect(m.Keys()))
+	assert.Equal([]int{2}, slices.Collect(m.Values()))
+
+	// The loop body may write to the map.
+	for k := range m.Keys() {
+		m.Delete(k)
+	}
+	_, ok := m.Get("b")
+	assert.ShouldBeFalse(ok)
+}
+
+func TestExpiringMapJanitor(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestExpiringMapJanitor")
+
+	evicted := make(chan string, 1)
+	m := NewExpiringMap[string, int](time.Millisecond).OnEvict(func(key string, _ int) {
+		evicted <- key
+	})
+	m.Set("a", 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m.StartJanitor(ctx, time.Millisecond)
+
+	select {
+	case key := <-evicted:
+		assert.Equal("a", key)
+	case <-time.After(time.Second):
+		t.Fatal("the janitor did not evict the expired entry")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/idichekop/gods/internal/ijson"
+)
+
+// MarshalJSON encodes the map as a JSON object whose members are in ascending order of
+// keys.  Keys are encoded as encoding/json encodes map keys: they must be strings,
+// integers or implement encoding.TextMarshaler.
+func (m *TreeMap[K, V]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalObject(m.All())
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object.  The
+// map keeps its comparator, so it must have been created by NewTreeMap or
+// NewTreeMapFunc.
+func (m *TreeMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m.tree == nil {
+		return errors.New("TreeMap.UnmarshalJSON: map has no comparator")
+	}
+	if ijson.IsNull(data) {
+		return nil
+	}
+	m.tree.Clear()
+	return ijson.UnmarshalObject(data, m.Put)
+}
+
+// MarshalJSON encodes the list as a JSON object whose members are in ascending order of
+// keys.  Keys are encoded as encoding/json encodes map keys.
+func (s *SkipList[K, V]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalObject(s.All())
+}
+
+// UnmarshalJSON replaces the contents of the list with the members of a JSON object.  The
+// list keeps its comparator, so it must have been created by NewSkipList or
+// NewSkipListFunc.
+func (s *SkipList[K, V]) UnmarshalJSON(data []byte) error {
+	if s.compare == nil {
+		return errors.New("SkipList.UnmarshalJSON: list has no comparator")
+	}
+	if ijson.IsNull(data) {
+		return nil
+	}
+	s.clear()
+	return ijson.UnmarshalObject(data, s.Put)
+}
+
+// MarshalJSON encodes the multimap as a JSON object mapping each key to the array of its
+// values.  As for Go maps, the members are sorted by key.
+func (mm *MultiMap[K, V]) MarshalJSON() ([]byte, error) {
+	if mm.values == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(mm.values)
+}
+
+// UnmarshalJSON replaces the contents of the multimap with the members of a JSON object
+// of arrays.  Keys mapped to an empty array are skipped.
+func (mm *MultiMap[K, V]) UnmarshalJSON(data []byte) error {
+	var groups map[K][]V
+	if err := json.Unmarshal(data, &groups); err != nil || groups == nil {
+		return err
+	}
+	*mm = *MultiMapFrom(groups)
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object.  As for Go maps, the members are sorted
+// by key.  The map is not locked as a whole: the object does not reflect a consistent
+// snapshot of a map being written.
+func (m *ConcurrentMap[K, V]) MarshalJSON() ([]byte, error) {
+	values := make(map[K]V)
+	for k, v := range m.All() {
+		values[k] = v
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object.  The
+// replacement is atomic: the map is locked as a whole while its contents are swapped.
+func (m *ConcurrentMap[K, V]) UnmarshalJSON(data []byte) error {
+	var values map[K]V
+	if err := json.Unmarshal(data, &values); err != nil || values == nil {
+		return err
+	}
+	m.replace(values)
+	return nil
+}
+
+// MarshalJSON encodes the current snapshot of the map as a JSON object.  As for Go maps,
+// the members are sorted by key.
+func (m *COWMap[K, V]) MarshalJSON() ([]byte, error) {
+	values := m.Snapshot().values
+	if values == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON object, in a
+// single atomic step.
+func (m *COWMap[K, V]) UnmarshalJSON(data []byte) error {
+	var values map[K]V
+	if err := json.Unmarshal(data, &values); err != nil || values == nil {
+		return err
+	}
+	m.replace(values)
+	return nil
+}
@@ -0,0 +1,142 @@
+package imaps
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/internal"
+)
+
+func TestTreeMapJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTreeMapJSON")
+
+	m := NewTreeMap[string, int]()
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	data, err := json.Marshal(m)
+	assert.IsNil(err)
+	assert.Equal(`{"a":1,"b":2,"c":3}`, string(data))
+
+	// The comparator is kept: the members come back in descending order.
+	desc := NewTreeMapFunc[string, int](icompare.Reversed(icompare.Natural[string]()))
+	desc.Put("z", 26)
+	assert.IsNil(json.Unmarshal(data, desc))
+	assert.Equal([]string{"c", "b", "a"}, slices.Collect(desc.Keys()))
+
+	data, err = json.Marshal(desc)
+	assert.IsNil(err)
+	assert.Equal(`{"c":3,"b":2,"a":1}`, string(data))
+
+	assert.IsNil(json.Unmarshal([]byte("null"), desc))
+	assert.Equal(3, desc.Len())
+
+	var zero TreeMap[string, int]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+
+	ints := NewTreeMap[int, bool]()
+	assert.IsNil(json.Unmarshal([]byte(`{"10":true,"-2":false}`), ints))
+	data, _ = json.Marshal(ints)
+	assert.Equal(`{"-2":false,"10":true}`, string(data))
+	assert.IsNotNil(json.Unmarshal([]byte(`{"x":true}`), ints))
+}
+
+func TestSkipListJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipListJSON")
+
+	s := NewSkipList[int, string]()
+	for i := range 100 {
+		s.Put(i, strings.Repeat("x", i%3))
+	}
+	data, err := json.Marshal(s)
+	assert.IsNil(err)
+
+	decoded := NewSkipList[int, string]()
+	decoded.Put(1000, "gone")
+	assert.IsNil(json.Unmarshal(data, decoded))
+	assert.Equal(100, decoded.Len())
+	assert.ShouldBeFalse(decoded.Contains(1000))
+	checkSkipList(t, decoded)
+
+	again, _ := json.Marshal(decoded)
+	assert.Equal(string(data), string(again))
+
+	var zero SkipList[int, string]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
+
+func TestMultiMapJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiMapJSON")
+
+	mm := NewMultiMap[string, int]()
+	mm.Add("b", 3, 1)
+	mm.Add("a", 2)
+	data, err := json.Marshal(mm)
+	assert.IsNil(err)
+	assert.Equal(`{"a":[2],"b":[3,1]}`, string(data))
+
+	var decoded MultiMap[string, int]
+	assert.IsNil(json.Unmarshal([]byte(`{"a":[2],"b":[3,1],"c":[]}`), &decoded))
+	assert.Equal(3, decoded.Size())
+	assert.Equal(2, decoded.KeyCount())
+	assert.Equal([]int{3, 1}, decoded.Get("b"))
+	assert.ShouldBeFalse(decoded.ContainsKey("c"))
+
+	var empty MultiMap[string, int]
+	data, _ = json.Marshal(&empty)
+	assert.Equal("{}", string(data))
+}
+
+func TestConcurrentMapJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcurrentMapJSON")
+
+	m := NewConcurrentMap[string, int](4)
+	m.Store("b", 2)
+	m.Store("a", 1)
+	data, err := json.Marshal(m)
+	assert.IsNil(err)
+	assert.Equal(`{"a":1,"b":2}`, string(data))
+
+	m.Store("c", 3)
+	assert.IsNil(json.Unmarshal([]byte(`{"x":9}`), m))
+	assert.Equal(1, m.Len())
+	v, _ := m.Load("x")
+	assert.Equal(9, v)
+
+	var zero ConcurrentMap[string, int]
+	assert.IsNil(json.Unmarshal(data, &zero))
+	assert.Equal(2, zero.Len())
+}
+
+func TestCOWMapJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCOWMapJSON")
+
+	var m COWMap[string, int]
+	data, err := json.Marshal(&m)
+	assert.IsNil(err)
+	assert.Equal("{}", string(data))
+
+	m.Store("b", 2)
+	m.Store("a", 1)
+	data, err = json.Marshal(&m)
+	assert.IsNil(err)
+	assert.Equal(`{"a":1,"b":2}`, string(data))
+
+	assert.IsNil(json.Unmarshal([]byte(`{"x":9}`), &m))
+	assert.Equal(map[string]int{"x": 9}, m.Snapshot().ToMap())
+	assert.IsNil(json.Unmarshal([]byte("null"), &m))
+	assert.Equal(1, m.Len())
+}
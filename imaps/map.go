@@ -0,0 +1,134 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package imaps implements utility functions to manipulate maps.  Like the functions of
+// package islice, they never modify the given maps and return new ones.
+//
+// Go randomizes the iteration order of maps.  The functions returning slices therefore
+// sort them by key, so that their results are deterministic.
+package imaps
+
+import (
+	"maps"
+	stdslices "slices"
+
+	"github.com/idichekop/gods/ituples"
+	"golang.org/x/exp/constraints"
+)
+
+// Keys returns the keys of the map, in ascending order.
+func Keys[K constraints.Ordered, V any](m map[K]V) []K {
+	result := stdslices.AppendSeq(make([]K, 0, len(m)), maps.Keys(m))
+	stdslices.Sort(result)
+	return result
+}
+
+// Values returns the values of the map, in the ascending order of their keys.
+func Values[K constraints.Ordered, V any](m map[K]V) []V {
+	keys := Keys(m)
+	result := make([]V, len(keys))
+	for i, k := range keys {
+		result[i] = m[k]
+	}
+	return result
+}
+
+// Entries returns the key-value pairs of the map, in ascending order of keys.
+func Entries[K constraints.Ordered, V any](m map[K]V) []ituples.Pair[K, V] {
+	keys := Keys(m)
+	result := make([]ituples.Pair[K, V], len(keys))
+	for i, k := range keys {
+		result[i] = ituples.NewPair(k, m[k])
+	}
+	return result
+}
+
+// FromEntries creates a map from key-value pairs.  When a key occurs several times, the
+// last pair wins.
+func FromEntries[K comparable, V any](entries []ituples.Pair[K, V]) map[K]V {
+	result := make(map[K]V, len(entries))
+	for _, e := range entries {
+		result[e.First] = e.Second
+	}
+	return result
+}
+
+// Invert returns a map from the values to the keys of the given map.  When several keys
+// share a value, the greatest one wins; use InvertGrouped to keep all of them.
+func Invert[K constraints.Ordered, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for _, k := range Keys(m) {
+		result[m[k]] = k
+	}
+	return result
+}
+
+// InvertGrouped returns a map from the values of the given map to all the keys holding
+// them, in ascending order.
+func InvertGrouped[K constraints.Ordered, V comparable](m map[K]V) map[V][]K {
+	result := make(map[V][]K)
+	for _, k := range Keys(m) {
+		result[m[k]] = append(result[m[k]], k)
+	}
+	return result
+}
+
+// MergeWith merges the maps into a new one.  When a key is in several maps, resolve is
+// called with the key, the value merged so far and the new value, in the order of the
+// maps, and its result is kept.
+func MergeWith[K comparable, V any](resolve func(key K, current, next V) V, ms ...map[K]V) map[K]V {
+	result := make(map[K]V)
+	for _, m := range ms {
+		for k, v := range m {
+			if current, ok := result[k]; ok {
+				v = resolve(k, current, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Filter returns a map with the entries of m that pass the predicate function.
+func Filter[K comparable, V any](m map[K]V, predicate func(key K, value V) bool) map[K]V {
+	result := make(map[K]V)
+	for k, v := range m {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// FilterMap returns a map which applies both filtering and mapping to the values of m.
+// The entries for which iteratee returns false are dropped.
+func FilterMap[K comparable, V any, U any](m map[K]V, iteratee func(key K, value V) (U, bool)) map[K]U {
+	result := make(map[K]U)
+	for k, v := range m {
+		if u, ok := iteratee(k, v); ok {
+			result[k] = u
+		}
+	}
+	return result
+}
+
+// MapValues returns a map with the same keys as m, and the values returned by iteratee.
+func MapValues[K comparable, V any, U any](m map[K]V, iteratee func(key K, value V) U) map[K]U {
+	result := make(map[K]U, len(m))
+	for k, v := range m {
+		result[k] = iteratee(k, v)
+	}
+	return result
+}
+
+// MapKeys returns a map with the same values as m, under the keys returned by iteratee.
+// When iteratee returns the same key for several entries, the one with the greatest
+// original key wins.
+func MapKeys[K constraints.Ordered, V any, L comparable](m map[K]V, iteratee func(key K, value V) L) map[L]V {
+	result := make(map[L]V, len(m))
+	for _, k := range Keys(m) {
+		result[iteratee(k, m[k])] = m[k]
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+package imaps
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+func ExampleKeys() {
+	stock := map[string]int{"pear": 3, "apple": 5, "fig": 0}
+
+	fmt.Println(Keys(stock))
+	fmt.Println(Values(stock))
+	fmt.Println(Entries(stock))
+
+	// Output:
+	// [apple fig pear]
+	// [5 0 3]
+	// [(apple, 5) (fig, 0) (pear, 3)]
+}
+
+func ExampleMergeWith() {
+	morning := map[string]int{"apple": 2, "pear": 1}
+	evening := map[string]int{"apple": 3, "fig": 4}
+
+	total := MergeWith(func(_ string, current, next int) int {
+		return current + next
+	}, morning, evening)
+
+	fmt.Println(Entries(total))
+
+	// Output:
+	// [(apple, 5) (fig, 4) (pear, 1)]
+}
+
+func ExampleInvert() {
+	codes := map[string]int{"ok": 200, "created": 201}
+
+	fmt.Println(Invert(codes))
+
+	// Output:
+	// map[200:ok 201:created]
+}
+
+func ExampleMultiMap() {
+	tags := NewMultiMap[string, string]()
+
+	tags.Add("go", "generics", "iterators")
+	tags.Add("rust", "traits")
+	tags.Add("go", "channels")
+
+	tags.RemoveValue("go", func(tag string) bool { return tag == "iterators" })
+
+	fmt.Println(tags.Get("go"))
+	fmt.Println(tags.KeyCount(), tags.Size())
+
+	// Output:
+	// [generics channels]
+	// 2 3
+}
+
+func ExampleConcurrentMap() {
+	hits := NewConcurrentMap[string, int]()
+
+	for _, page := range []string{"/", "/about", "/"} {
+		hits.Compute(page, func(current int, _ bool) (int, bool) {
+			return current + 1, true
+		})
+	}
+
+	home, _ := hits.Load("/")
+	fmt.Println(home, hits.Len())
+
+	// Output:
+	// 2 2
+}
+
+func ExampleCOWMap() {
+	routes := COWMapFrom(map[string]string{"/": "home"})
+
+	snapshot := routes.Snapshot()
+	routes.Update(func(values map[string]string) {
+		values["/about"] = "about"
+		delete(values, "/")
+	})
+
+	_, ok := snapshot.Load("/")
+	fmt.Println(snapshot.Len(), ok)
+	_, ok = routes.Load("/")
+	fmt.Println(routes.Len(), ok)
+
+	// Output:
+	// 1 true
+	// 1 false
+}
+
+func ExampleExpiringMap() {
+	sessions := NewExpiringMap[string, string](30 * time.Minute)
+
+	sessions.Set("token-1", "alice")
+	sessions.SetWithTTL("token-2", "bob", -time.Second)
+
+	user, ok := sessions.Get("token-1")
+	fmt.Println(user, ok)
+
+	_, ok = sessions.Get("token-2")
+	fmt.Println(ok)
+
+	// Output:
+	// alice true
+	// false
+}
+
+func ExampleTreeMap() {
+	readings := NewTreeMap[int, float64]()
+
+	readings.Put(1200, 20.5)
+	readings.Put(1000, 18.0)
+	readings.Put(1400, 22.1)
+	readings.Put(1100, 19.2)
+
+	for t, v := range readings.Range(1050, 1400) {
+		fmt.Println(t, v)
+	}
+
+	t, v, _ := readings.Floor(1350)
+	fmt.Println("floor", t, v)
+
+	// Output:
+	// 1100 19.2
+	// 1200 20.5
+	// floor 1200 20.5
+}
+
+func ExampleSkipList() {
+	scores := NewSkipList[string, int]()
+
+	scores.Put("carol", 72)
+	scores.Put("alice", 90)
+	scores.Put("bob", 85)
+
+	for name, score := range scores.Range("alice", "carol") {
+		fmt.Println(name, score)
+	}
+
+	name, _, _ := scores.Ceiling("b")
+	fmt.Println("ceiling", name)
+
+	// Output:
+	// alice 90
+	// bob 85
+	// ceiling bob
+}
+
+func ExampleTreeMap_MarshalJSON() {
+	type response struct {
+		Scores *TreeMap[string, int] `json:"scores"`
+	}
+
+	scores := NewTreeMap[string, int]()
+	scores.Put("carol", 7)
+	scores.Put("alice", 9)
+	scores.Put("bob", 4)
+
+	data, _ := json.Marshal(response{Scores: scores})
+	fmt.Println(string(data))
+
+	decoded := response{Scores: NewTreeMap[string, int]()}
+	_ = json.Unmarshal([]byte(`{"scores":{"zoe":1,"adam":2}}`), &decoded)
+	fmt.Println(slices.Collect(decoded.Scores.Keys()))
+
+	// Output:
+	// {"scores":{"alice":9,"bob":4,"carol":7}}
+	// [adam zoe]
+}
@@ -0,0 +1,77 @@
+package imaps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+	"github.com/idichekop/gods/ituples"
+)
+
+func TestKeysValuesEntries(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestKeysValuesEntries")
+
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	assert.Equal([]string{"a", "b", "c"}, Keys(m))
+	assert.Equal([]int{1, 2, 3}, Values(m))
+	assert.Equal([]ituples.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "c", Second: 3}}, Entries(m))
+	assert.Equal(m, FromEntries(Entries(m)))
+
+	assert.Equal([]string{}, Keys(map[string]int{}))
+	assert.Equal(map[string]int{"a": 2}, FromEntries([]ituples.Pair[string, int]{{First: "a", Second: 1}, {First: "a", Second: 2}}))
+}
+
+func TestInvert(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestInvert")
+
+	m := map[string]int{"a": 1, "b": 2, "c": 1}
+
+	assert.Equal(map[int]string{1: "c", 2: "b"}, Invert(m))
+	assert.Equal(map[int][]string{1: {"a", "c"}, 2: {"b"}}, InvertGrouped(m))
+}
+
+func TestMergeWith(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMergeWith")
+
+	sum := func(_ string, current, next int) int { return current + next }
+
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 10, "c": 3}
+	m3 := map[string]int{"b": 100}
+
+	assert.Equal(map[string]int{"a": 1, "b": 112, "c": 3}, MergeWith(sum, m1, m2, m3))
+	assert.Equal(map[string]int{"a": 1, "b": 2}, m1)
+	assert.Equal(map[string]int{}, MergeWith(sum))
+}
+
+func TestFilterAndMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFilterAndMap")
+
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	assert.Equal(map[string]int{"b": 2}, Filter(m, func(_ string, v int) bool { return v%2 == 0 }))
+
+	assert.Equal(map[string]int{"a": 10, "c": 30}, FilterMap(m, func(_ string, v int) (int, bool) {
+		return v * 10, v%2 == 1
+	}))
+
+	assert.Equal(map[string]string{"a": "a1", "b": "b2", "c": "c3"}, MapValues(m, func(k string, v int) string {
+		return k + string(rune('0'+v))
+	}))
+
+	assert.Equal(map[string]int{"A": 1, "B": 2, "C": 3}, MapKeys(m, func(k string, _ int) string {
+		return strings.ToUpper(k)
+	}))
+	assert.Equal(map[bool]int{true: 3, false: 2}, MapKeys(m, func(_ string, v int) bool {
+		return v%2 == 1
+	}))
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"iter"
+	stdslices "slices"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// MultiMap maps each key to a list of values, in insertion order.  It replaces the
+// hand-managed map[K][]V (e.g. the result of islice.GroupBy): a key exists as long as it
+// has at least one value, and the returned slices never alias the internal storage.
+// A MultiMap is not safe for concurrent use.
+type MultiMap[K comparable, V any] struct {
+	values map[K][]V
+	size   int
+}
+
+// NewMultiMap creates an empty MultiMap.
+func NewMultiMap[K comparable, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{values: make(map[K][]V)}
+}
+
+// MultiMapFrom creates a MultiMap holding a copy of the given groups.  Keys without values
+// are skipped.
+func MultiMapFrom[K comparable, V any](groups map[K][]V) *MultiMap[K, V] {
+	mm := NewMultiMap[K, V]()
+	for k, vs := range groups {
+		mm.Add(k, vs...)
+	}
+	return mm
+}
+
+// Add appends the values to the list of key.
+func (mm *MultiMap[K, V]) Add(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	mm.values[key] = append(mm.values[key], values...)
+	mm.size += len(values)
+}
+
+// Get returns a copy of the values of key, or nil if the key has none.
+func (mm *MultiMap[K, V]) Get(key K) []V {
+	return stdslices.Clone(mm.values[key])
+}
+
+// ContainsKey reports whether key has at least one value.
+func (mm *MultiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := mm.values[key]
+	return ok
+}
+
+// RemoveValue removes the values of key for which match returns true, and returns their
+// number.  The key is removed with its last value.
+func (mm *MultiMap[K, V]) RemoveValue(key K, match func(value V) bool) int {
+	values, ok := mm.values[key]
+	if !ok {
+		return 0
+	}
+
+	kept := stdslices.DeleteFunc(values, match)
+	removed := len(values) - len(kept)
+	mm.size -= removed
+
+	if len(kept) == 0 {
+		delete(mm.values, key)
+	} else {
+		mm.values[key] = kept
+	}
+	return removed
+}
+
+// RemoveKey removes key and all of its values, and returns their number.
+func (mm *MultiMap[K, V]) RemoveKey(key K) int {
+	removed := len(mm.values[key])
+	delete(mm.values, key)
+	mm.size -= removed
+	return removed
+}
+
+// KeyCount returns the number of keys.
+func (mm *MultiMap[K, V]) KeyCount() int {
+	return len(mm.values)
+}
+
+// Size returns the number of values, over all keys.
+func (mm *MultiMap[K, V]) Size() int {
+	return mm.size
+}
+
+// Keys returns an iterator over the keys, in no particular order.
+func (mm *MultiMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range mm.values {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over every key-value association.  The keys come in no
+// particular order; the values of a key come in insertion order.
+func (mm *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, vs := range mm.values {
+			for _, v := range vs {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Values returns an iterator over every value, in the order of All.
+func (mm *MultiMap[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(mm.All())
+}
+
+// ToMap returns a copy of the MultiMap as a map of slices.
+func (mm *MultiMap[K, V]) ToMap() map[K][]V {
+	result := make(map[K][]V, len(mm.values))
+	for k, vs := range mm.values {
+		result[k] = stdslices.Clone(vs)
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package imaps
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestMultiMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiMap")
+
+	mm := NewMultiMap[string, int]()
+	mm.Add("a", 1, 2)
+	mm.Add("b", 3)
+	mm.Add("a", 2)
+	mm.Add("c")
+
+	assert.Equal([]int{1, 2, 2}, mm.Get("a"))
+	assert.Equal([]int(nil), mm.Get("c"))
+	assert.ShouldBeTrue(mm.ContainsKey("b"))
+	assert.ShouldBeFalse(mm.ContainsKey("c"))
+	assert.Equal(2, mm.KeyCount())
+	assert.Equal(4, mm.Size())
+
+	got := mm.Get("a")
+	got[0] = 100
+	assert.Equal([]int{1, 2, 2}, mm.Get("a"))
+
+	assert.Equal(2, mm.RemoveValue("a", func(v int) bool { return v == 2 }))
+	assert.Equal([]int{1}, mm.Get("a"))
+	assert.Equal(0, mm.RemoveValue("z", func(v int) bool { return true }))
+
+	assert.Equal(1, mm.RemoveValue("b", func(v int) bool { return v == 3 }))
+	assert.ShouldBeFalse(mm.ContainsKey("b"))
+	assert.Equal(1, mm.Size())
+
+	mm.Add("d", 4, 5)
+	assert.Equal(2, mm.RemoveKey("d"))
+	assert.Equal(0, mm.RemoveKey("d"))
+	assert.Equal(map[string][]int{"a": {1}}, mm.ToMap())
+}
+
+func TestMultiMapIteration(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiMapIteration")
+
+	mm := MultiMapFrom(map[int][]string{1: {"a", "b"}, 2: {"c"}, 3: nil})
+	assert.Equal(3, mm.Size())
+	assert.Equal(2, mm.KeyCount())
+
+	collected := map[int][]string{}
+	for k, v := range mm.All() {
+		collected[k] = append(collected[k], v)
+	}
+	assert.Equal(mm.ToMap(), collected)
+
+	keys := 0
+	for range mm.Keys() {
+		keys++
+	}
+	assert.Equal(2, keys)
+}
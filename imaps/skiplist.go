@@ -0,0 +1,275 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/icontainer"
+	"golang.org/x/exp/constraints"
+)
+
+// skipListMaxLevel bounds the number of levels of a SkipList.  With a promotion
+// probability of 1/4 it is enough for far more keys than fit in memory.
+const skipListMaxLevel = 24
+
+// SkipList is a map whose keys are kept in order, in a skip list: a sorted linked list
+// with randomly chosen express lanes.  Put, Get and Delete run in expected O(log n);
+// iterations visit the keys in ascending order.  It has the methods of TreeMap, so it may
+// replace one, and back a SortedSet (see isets.NewSortedWith).
+//
+// A SkipList is safe for concurrent use, and its readers never block: the links are
+// atomic pointers, which writers, serialized by a lock, update in an order that always
+// leaves the list well formed.  A read that overlaps a write may or may not see it; an
+// iteration sees the writes made to the keys it has yet to reach.
+type SkipList[K any, V any] struct {
+	// mu serializes the writers.  The readers take no lock.
+	mu      sync.Mutex
+	head    skipNode[K, V]
+	level   atomic.Int32
+	length  atomic.Int64
+	compare icompare.Comparator[K]
+}
+
+type skipNode[K any, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	// next holds the following node at each level the node belongs to.
+	next []atomic.Pointer[skipNode[K, V]]
+}
+
+// NewSkipList creates an empty SkipList ordered by the natural order of the keys.
+func NewSkipList[K constraints.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListFunc[K, V](icompare.Natural[K]())
+}
+
+// NewSkipListFunc creates an empty SkipList ordered by the given comparator.
+func NewSkipListFunc[K any, V any](compare icompare.Comparator[K]) *SkipList[K, V] {
+	s := &SkipList[K, V]{
+		head:    skipNode[K, V]{next: make([]atomic.Pointer[skipNode[K, V]], skipListMaxLevel)},
+		compare: compare,
+	}
+	s.level.Store(1)
+	return s
+}
+
+// Len returns the number of keys.
+func (s *SkipList[K, V]) Len() int {
+	return int(s.length.Load())
+}
+
+// Get returns the value of key, and whether it was found.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.search(key, nil); n != nil && s.compare(n.key, key) == 0 {
+		return *n.value.Load(), true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the list.
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+// Put sets the value of key.
+func (s *SkipList[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [skipListMaxLevel]*skipNode[K, V]
+	if next := s.search(key, &update); next != nil && s.compare(next.key, key) == 0 {
+		next.value.Store(&value)
+		return
+	}
+
+	level := randomLevel()
+	for lvl := int(s.level.Load()); lvl < level; lvl++ {
+		update[lvl] = &s.head
+	}
+	node := &skipNode[K, V]{key: key, next: make([]atomic.Pointer[skipNode[K, V]], level)}
+	node.value.Store(&value)
+	// Link the node from the bottom up, each level once the node points past itself, so
+	// that a reader finding it at a level also finds it below.
+	for lvl := range level {
+		node.next[lvl].Store(update[lvl].next[lvl].Load())
+		update[lvl].next[lvl].Store(node)
+	}
+	if level > int(s.level.Load()) {
+		s.level.Store(int32(level))
+	}
+	s.length.Add(1)
+}
+
+// Delete removes key, and reports whether it was found.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [skipListMaxLevel]*skipNode[K, V]
+	target := s.search(key, &update)
+	if target == nil || s.compare(target.key, key) != 0 {
+		return false
+	}
+
+	// Unlink the node from the top down.  Its own links are kept, so that the readers on
+	// it go on to the following nodes.
+	for lvl := len(target.next) - 1; lvl >= 0; lvl-- {
+		update[lvl].next[lvl].Store(target.next[lvl].Load())
+	}
+	level := s.level.Load()
+	for level > 1 && s.head.next[level-1].Load() == nil {
+		level--
+	}
+	s.level.Store(level)
+	s.length.Add(-1)
+	return true
+}
+
+// Min returns the smallest key and its value, or false if the list is empty.
+func (s *SkipList[K, V]) Min() (K, V, bool) {
+	return s.head.next[0].Load().entry()
+}
+
+// Max returns the greatest key and its value, or false if the list is empty.
+func (s *SkipList[K, V]) Max() (K, V, bool) {
+	n := &s.head
+	for lvl := int(s.level.Load()) - 1; lvl >= 0; lvl-- {
+		for next := n.next[lvl].Load(); next != nil; next = n.next[lvl].Load() {
+			n = next
+		}
+	}
+	if n == &s.head {
+		return (*skipNode[K, V])(nil).entry()
+	}
+	return n.entry()
+}
+
+// Floor returns the greatest key less than or equal to key, and its value, or false if
+// there is none.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	n := &s.head
+	for lvl := int(s.level.Load()) - 1; lvl >= 0; lvl-- {
+		for next := n.next[lvl].Load(); next != nil && s.compare(next.key, key) <= 0; next = n.next[lvl].Load() {
+			n = next
+		}
+	}
+	if n == &s.head {
+		return (*skipNode[K, V])(nil).entry()
+	}
+	return n.entry()
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value, or false
+// if there is none.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return s.search(key, nil).entry()
+}
+
+// Rank returns the number of keys strictly less than key.  The list keeps no counts, so
+// Rank walks the smaller keys, in O(n).
+func (s *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+	for n := s.head.next[0].Load(); n != nil && s.compare(n.key, key) < 0; n = n.next[0].Load() {
+		rank++
+	}
+	return rank
+}
+
+// Select returns the key of the given rank (the index-th smallest, from 0) and its value,
+// or false if index is out of range.  Like Rank, it runs in O(n).
+func (s *SkipList[K, V]) Select(index int) (K, V, bool) {
+	if index < 0 {
+		return (*skipNode[K, V])(nil).entry()
+	}
+	n := s.head.next[0].Load()
+	for ; n != nil && index > 0; index-- {
+		n = n.next[0].Load()
+	}
+	return n.entry()
+}
+
+// All returns an iterator over the keys and values, in ascending order of keys.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.head.next[0].Load(); n != nil; n = n.next[0].Load() {
+			if !yield(n.key, *n.value.Load()) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys, in ascending order.
+func (s *SkipList[K, V]) Keys() iter.Seq[K] {
+	return icontainer.Keys(s.All())
+}
+
+// Values returns an iterator over the values, in ascending order of keys.
+func (s *SkipList[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(s.All())
+}
+
+// Range returns an iterator over the keys in [from, to) and their values, in ascending
+// order.
+func (s *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.search(from, nil); n != nil && s.compare(n.key, to) < 0; n = n.next[0].Load() {
+			if !yield(n.key, *n.value.Load()) {
+				return
+			}
+		}
+	}
+}
+
+// search returns the node of the smallest key greater than or equal to key, or nil if
+// there is none.  If update is not nil, it also stores there the last node before key at
+// each level.
+func (s *SkipList[K, V]) search(key K, update *[skipListMaxLevel]*skipNode[K, V]) *skipNode[K, V] {
+	n := &s.head
+	for lvl := int(s.level.Load()) - 1; lvl >= 0; lvl-- {
+		for next := n.next[lvl].Load(); next != nil && s.compare(next.key, key) < 0; next = n.next[lvl].Load() {
+			n = next
+		}
+		if update != nil {
+			update[lvl] = n
+		}
+	}
+	return n.next[0].Load()
+}
+
+// clear removes all the keys.
+func (s *SkipList[K, V]) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for lvl := range s.head.next {
+		s.head.next[lvl].Store(nil)
+	}
+	s.level.Store(1)
+	s.length.Store(0)
+}
+
+// entry returns the key and value of n, or false if n is nil.
+func (n *skipNode[K, V]) entry() (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, *n.value.Load(), true
+}
+
+// randomLevel draws the level of a new node: each extra level is kept with probability
+// 1/4.
+func randomLevel() int {
+	level := 1 + bits.TrailingZeros64(rand.Uint64())/2
+	return min(level, skipListMaxLevel)
+}
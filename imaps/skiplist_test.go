@@ -0,0 +1,265 @@
+package imaps
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+// checkSkipList verifies that every level of the list is sorted, that each level is a
+// subsequence of the one below and that the length is right.
+func checkSkipList[K any, V any](t *testing.T, s *SkipList[K, V]) {
+	below := map[*skipNode[K, V]]bool{}
+	for lvl := 0; lvl < int(s.level.Load()); lvl++ {
+		current := map[*skipNode[K, V]]bool{}
+		count := 0
+		for n := s.head.next[lvl].Load(); n != nil; n = n.next[lvl].Load() {
+			if next := n.next[lvl].Load(); next != nil && s.compare(n.key, next.key) >= 0 {
+				t.Fatalf("unordered keys at level %d: %v", lvl, n.key)
+			}
+			if lvl > 0 && !below[n] {
+				t.Fatalf("node %v at level %d is missing from level %d", n.key, lvl, lvl-1)
+			}
+			current[n] = true
+			count++
+		}
+		if lvl == 0 && count != s.Len() {
+			t.Fatalf("length is %d, but level 0 has %d nodes", s.Len(), count)
+		}
+		below = current
+	}
+}
+
+func TestSkipList(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipList")
+
+	s := NewSkipList[int, string]()
+	_, _, ok := s.Min()
+	assert.ShouldBeFalse(ok)
+	_, _, ok = s.Max()
+	assert.ShouldBeFalse(ok)
+	assert.ShouldBeFalse(s.Delete(1))
+
+	for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+		s.Put(k, "v"+strings.Repeat("x", k/10))
+	}
+	s.Put(30, "thirty")
+
+	assert.Equal(7, s.Len())
+	v, ok := s.Get(30)
+	assert.Equal("thirty", v)
+	assert.ShouldBeTrue(ok)
+	assert.ShouldBeFalse(s.Contains(40))
+
+	k, _, _ := s.Min()
+	assert.Equal(10, k)
+	k, _, _ = s.Max()
+	assert.Equal(90, k)
+
+	k, _, ok = s.Floor(45)
+	assert.Equal(30, k)
+	assert.ShouldBeTrue(ok)
+	k, _, _ = s.Floor(50)
+	assert.Equal(50, k)
+	_, _, ok = s.Floor(5)
+	assert.ShouldBeFalse(ok)
+
+	k, _, _ = s.Ceiling(45)
+	assert.Equal(50, k)
+	_, _, ok = s.Ceiling(95)
+	assert.ShouldBeFalse(ok)
+
+	var keys []int
+	for k := range s.Range(20, 80) {
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{20, 30, 50, 70}, keys)
+
+	keys = nil
+	for k := range s.All() {
+		if k > 30 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{10, 20, 30}, keys)
+
+	assert.ShouldBeTrue(s.Delete(50))
+	assert.ShouldBeFalse(s.Contains(50))
+	assert.Equal(6, s.Len())
+	checkSkipList(t, s)
+}
+
+func TestSkipListFunc(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipListFunc")
+
+	s := NewSkipListFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	s.Put("b", 1)
+	s.Put("A", 2)
+	s.Put("B", 3)
+
+	assert.Equal(2, s.Len())
+	v, _ := s.Get("b")
+	assert.Equal(3, v)
+	k, _, _ := s.Min()
+	assert.Equal("A", k)
+}
+
+func TestSkipListRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipListRandomized")
+
+	rng := rand.New(rand.NewSource(11))
+	s := NewSkipList[int, int]()
+	reference := NewTreeMap[int, int]()
+
+	for i := range 5000 {
+		k := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			assert.Equal(reference.Delete(k), s.Delete(k))
+		} else {
+			s.Put(k, i)
+			reference.Put(k, i)
+		}
+		if i%500 == 0 {
+			checkSkipList(t, s)
+		}
+	}
+	checkSkipList(t, s)
+
+	assert.Equal(reference.Len(), s.Len())
+	var got, want [][2]int
+	for k, v := range s.All() {
+		got = append(got, [2]int{k, v})
+	}
+	for k, v := range reference.All() {
+		want = append(want, [2]int{k, v})
+	}
+	assert.Equal(want, got)
+
+	for probe := -1; probe <= 501; probe += 7 {
+		fk, _, fok := s.Floor(probe)
+		rk, _, rok := reference.Floor(probe)
+		assert.Equal(rok, fok)
+		assert.Equal(rk, fk)
+		ck, _, cok := s.Ceiling(probe)
+		rk, _, rok = reference.Ceiling(probe)
+		assert.Equal(rok, cok)
+		assert.Equal(rk, ck)
+		assert.Equal(reference.Rank(probe), s.Rank(probe))
+	}
+	for index := -1; index <= s.Len(); index++ {
+		sk, sv, sok := s.Select(index)
+		rk, rv, rok := reference.Select(index)
+		assert.Equal(rok, sok)
+		assert.Equal(rk, sk)
+		assert.Equal(rv, sv)
+	}
+
+	for k := range reference.All() {
+		s.Delete(k)
+	}
+	assert.Equal(0, s.Len())
+	assert.Equal(int32(1), s.level.Load())
+}
+
+func TestSkipListConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipListConcurrentReads")
+
+	s := NewSkipList[int, int]()
+	for i := range 1000 {
+		s.Put(i, i*i)
+	}
+
+	var wg sync.WaitGroup
+	sums := make([]int, 8)
+	for g := range sums {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k, v := range s.Range(g*100, g*100+100) {
+				if got, _ := s.Get(k); got == v {
+					sums[g]++
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, sum := range sums {
+		assert.Equal(100, sum)
+	}
+}
+
+func TestSkipListReadsDuringWrites(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSkipListReadsDuringWrites")
+
+	// The even keys stay put, while the writers add and remove the odd ones.
+	s := NewSkipList[int, int]()
+	for k := 0; k < 1000; k += 2 {
+		s.Put(k, k)
+	}
+
+	var wg sync.WaitGroup
+	for w := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(int64(w)))
+			for range 5000 {
+				k := 2*rng.Intn(500) + 1
+				if rng.Intn(2) == 0 {
+					s.Put(k, k)
+				} else {
+					s.Delete(k)
+				}
+			}
+		}()
+	}
+
+	failures := make([]int, 4)
+	for r := range failures {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				previous, evens := -1, 0
+				for k, v := range s.All() {
+					if k <= previous || k != v {
+						failures[r]++
+					}
+					if k%2 == 0 {
+						evens++
+					}
+					previous = k
+				}
+				if evens != 500 {
+					failures[r]++
+				}
+				for k := 0; k < 1000; k += 50 {
+					if v, ok := s.Get(k); !ok || v != k {
+						failures[r]++
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal([]int{0, 0, 0, 0}, failures)
+	checkSkipList(t, s)
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imaps
+
+import (
+	"iter"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/itrees"
+	"golang.org/x/exp/constraints"
+)
+
+// TreeMap is a map whose keys are kept in order, in an itrees.RBTree.  Put, Get, Delete
+// and the order queries (Floor, Ceiling, Rank, Select, ...) run in O(log n); iterations
+// visit the keys in ascending order.  A TreeMap is not safe for concurrent use.
+type TreeMap[K any, V any] struct {
+	tree *itrees.RBTree[K, V]
+}
+
+// NewTreeMap creates an empty TreeMap ordered by the natural order of the keys.
+func NewTreeMap[K constraints.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](icompare.Natural[K]())
+}
+
+// NewTreeMapFunc creates an empty TreeMap ordered by the given comparator.
+func NewTreeMapFunc[K any, V any](compare icompare.Comparator[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: itrees.NewRBTreeFunc[K, V](compare)}
+}
+
+// Len returns the number of keys.
+func (m *TreeMap[K, V]) Len() int {
+	return m.tree.Len()
+}
+
+// Get returns the value of key, and whether it was found.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	return m.tree.Get(key)
+}
+
+// Contains reports whether key is in the map.
+func (m *TreeMap[K, V]) Contains(key K) bool {
+	return m.tree.Contains(key)
+}
+
+// Put sets the value of key.
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	m.tree.Put(key, value)
+}
+
+// Delete removes key, and reports whether it was found.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	return m.tree.Delete(key)
+}
+
+// Min returns the smallest key and its value, or false if the map is empty.
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	return m.tree.Min()
+}
+
+// Max returns the greatest key and its value, or false if the map is empty.
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	return m.tree.Max()
+}
+
+// Floor returns the greatest key less than or equal to key, and its value, or false if
+// there is none.
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.tree.Floor(key)
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value, or false
+// if there is none.
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.tree.Ceiling(key)
+}
+
+// Rank returns the number of keys strictly less than key.
+func (m *TreeMap[K, V]) Rank(key K) int {
+	return m.tree.Rank(key)
+}
+
+// Select returns the key of the given rank (the index-th smallest, from 0) and its value,
+// or false if index is out of range.
+func (m *TreeMap[K, V]) Select(index int) (K, V, bool) {
+	return m.tree.Select(index)
+}
+
+// All returns an iterator over the keys and values, in ascending order of keys.
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return m.tree.All()
+}
+
+// Keys returns an iterator over the keys, in ascending order.
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return m.tree.Keys()
+}
+
+// Values returns an iterator over the values, in ascending order of keys.
+func (m *TreeMap[K, V]) Values() iter.Seq[V] {
+	return m.tree.Values()
+}
+
+// Range returns an iterator over the keys in [from, to) and their values, in ascending
+// order.
+func (m *TreeMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return m.tree.Range(from, to)
+}
@@ -0,0 +1,152 @@
+package imaps
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+// checkTree verifies the invariants of the red-black tree of m.
+func checkTree[K any, V any](t *testing.T, m *TreeMap[K, V]) {
+	t.Helper()
+
+	if err := m.tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTreeMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTreeMap")
+
+	m := NewTreeMap[int, string]()
+	_, _, ok := m.Min()
+	assert.ShouldBeFalse(ok)
+	assert.ShouldBeFalse(m.Delete(1))
+
+	for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+		m.Put(k, "v"+strings.Repeat("x", k/10))
+	}
+	m.Put(30, "thirty")
+
+	assert.Equal(7, m.Len())
+	v, ok := m.Get(30)
+	assert.Equal("thirty", v)
+	assert.ShouldBeTrue(ok)
+	assert.ShouldBeFalse(m.Contains(40))
+
+	k, _, _ := m.Min()
+	assert.Equal(10, k)
+	k, _, _ = m.Max()
+	assert.Equal(90, k)
+
+	k, _, ok = m.Floor(45)
+	assert.Equal(30, k)
+	assert.ShouldBeTrue(ok)
+	k, _, _ = m.Floor(50)
+	assert.Equal(50, k)
+	_, _, ok = m.Floor(5)
+	assert.ShouldBeFalse(ok)
+
+	k, _, _ = m.Ceiling(45)
+	assert.Equal(50, k)
+	_, _, ok = m.Ceiling(95)
+	assert.ShouldBeFalse(ok)
+
+	assert.Equal(0, m.Rank(10))
+	assert.Equal(3, m.Rank(45))
+	assert.Equal(7, m.Rank(100))
+	k, _, _ = m.Select(3)
+	assert.Equal(50, k)
+	_, _, ok = m.Select(7)
+	assert.ShouldBeFalse(ok)
+
+	var keys []int
+	for k := range m.Range(20, 80) {
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{20, 30, 50, 70}, keys)
+
+	keys = nil
+	for k := range m.All() {
+		if k > 30 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{10, 20, 30}, keys)
+
+	assert.ShouldBeTrue(m.Delete(50))
+	assert.ShouldBeFalse(m.Contains(50))
+	assert.Equal(6, m.Len())
+	checkTree(t, m)
+}
+
+func TestTreeMapFunc(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTreeMapFunc")
+
+	m := NewTreeMapFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Put("b", 1)
+	m.Put("A", 2)
+	m.Put("B", 3)
+
+	assert.Equal(2, m.Len())
+	v, _ := m.Get("b")
+	assert.Equal(3, v)
+	k, _, _ := m.Min()
+	assert.Equal("A", k)
+}
+
+func TestTreeMapRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTreeMapRandomized")
+
+	rng := rand.New(rand.NewSource(7))
+	m := NewTreeMap[int, int]()
+	reference := map[int]int{}
+
+	for i := range 5000 {
+		k := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			_, found := reference[k]
+			assert.Equal(found, m.Delete(k))
+			delete(reference, k)
+		} else {
+			m.Put(k, i)
+			reference[k] = i
+		}
+		if i%500 == 0 {
+			checkTree(t, m)
+		}
+	}
+	checkTree(t, m)
+
+	assert.Equal(len(reference), m.Len())
+	var keys []int
+	for k, v := range m.All() {
+		assert.Equal(reference[k], v)
+		keys = append(keys, k)
+	}
+	assert.Equal(Keys(reference), keys)
+	assert.ShouldBeTrue(slices.IsSorted(keys))
+
+	for i, k := range keys {
+		assert.Equal(i, m.Rank(k))
+		selected, _, _ := m.Select(i)
+		assert.Equal(k, selected)
+	}
+
+	for _, k := range keys {
+		m.Delete(k)
+	}
+	assert.Equal(0, m.Len())
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imatrix
+
+import (
+	"slices"
+
+	"github.com/idichekop/gods/internal/ibinary"
+)
+
+// MarshalBinary encodes the rows of the grid.
+func (g *Grid[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(slices.Values(g.ToSlices()))
+}
+
+// UnmarshalBinary replaces the grid with the rows encoded by MarshalBinary.  As GridFrom,
+// it returns an error if the rows do not all have the same length.
+func (g *Grid[T]) UnmarshalBinary(data []byte) error {
+	rows, err := ibinary.DecodeSlice[[]T](data)
+	if err != nil {
+		return err
+	}
+	decoded, err := GridFrom(rows)
+	if err != nil {
+		return err
+	}
+	*g = *decoded
+	return nil
+}
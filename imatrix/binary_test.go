@@ -0,0 +1,22 @@
+package imatrix
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestGridBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGridBinary")
+
+	g, _ := GridFrom([][]string{{"a", "b"}, {"c", "d"}, {"e", "f"}})
+	data, err := g.MarshalBinary()
+	assert.IsNil(err)
+
+	var decoded Grid[string]
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal(g.ToSlices(), decoded.ToSlices())
+	assert.IsNotNil(decoded.UnmarshalBinary(data[:4]))
+}
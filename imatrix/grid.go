@@ -0,0 +1,207 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package imatrix implements a generic two-dimensional grid, for game boards, images,
+// tabular data and the like.
+package imatrix
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// Grid is a rectangular two-dimensional array of rows x cols elements.  The elements are
+// stored in a single slice, row after row, so a Grid can never be ragged.  A Grid is not
+// safe for concurrent use.
+type Grid[T any] struct {
+	rows  int
+	cols  int
+	cells []T
+}
+
+// offsets4 and offsets8 are the (row, col) offsets of the orthogonal and of all the
+// neighbors of a cell.
+var (
+	offsets4 = [][2]int{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}
+	offsets8 = [][2]int{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+)
+
+// NewGrid creates a grid of rows x cols zero values.  It panics if a dimension is negative.
+func NewGrid[T any](rows, cols int) *Grid[T] {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("NewGrid: invalid dimensions %dx%d", rows, cols))
+	}
+	return &Grid[T]{rows: rows, cols: cols, cells: make([]T, rows*cols)}
+}
+
+// GridFrom creates a grid holding a copy of the given rows.  It returns an error if the
+// rows do not all have the same length.
+func GridFrom[T any](rows [][]T) (*Grid[T], error) {
+	if len(rows) == 0 {
+		return NewGrid[T](0, 0), nil
+	}
+
+	g := NewGrid[T](len(rows), len(rows[0]))
+	for r, row := range rows {
+		if len(row) != g.cols {
+			return nil, fmt.Errorf("ragged rows: row %d has length %d, row 0 has length %d", r, len(row), g.cols)
+		}
+		copy(g.cells[r*g.cols:], row)
+	}
+	return g, nil
+}
+
+// Rows returns the number of rows.
+func (g *Grid[T]) Rows() int {
+	return g.rows
+}
+
+// Cols returns the number of columns.
+func (g *Grid[T]) Cols() int {
+	return g.cols
+}
+
+// InBounds reports whether (row, col) is a cell of the grid.
+func (g *Grid[T]) InBounds(row, col int) bool {
+	return row >= 0 && row < g.rows && col >= 0 && col < g.cols
+}
+
+// Get returns the element at (row, col).  It panics if the cell is out of bounds.
+func (g *Grid[T]) Get(row, col int) T {
+	return g.cells[g.offset(row, col)]
+}
+
+// Set sets the element at (row, col).  It panics if the cell is out of bounds.
+func (g *Grid[T]) Set(row, col int, value T) {
+	g.cells[g.offset(row, col)] = value
+}
+
+// Row returns a view of the given row: writes to it change the grid.  It panics if row is
+// out of bounds.
+func (g *Grid[T]) Row(row int) []T {
+	if row < 0 || row >= g.rows {
+		panic(fmt.Sprintf("Grid.Row: row %d out of bounds [0, %d)", row, g.rows))
+	}
+	start := row * g.cols
+	return g.cells[start : start+g.cols : start+g.cols]
+}
+
+// Col returns a copy of the given column.  Columns are not contiguous in memory, so unlike
+// Row this cannot be a view; use Set to change the grid.  It panics if col is out of bounds.
+func (g *Grid[T]) Col(col int) []T {
+	if col < 0 || col >= g.cols {
+		panic(fmt.Sprintf("Grid.Col: col %d out of bounds [0, %d)", col, g.cols))
+	}
+	result := make([]T, g.rows)
+	for r := range g.rows {
+		result[r] = g.cells[r*g.cols+col]
+	}
+	return result
+}
+
+// Fill sets every element of the grid to value.
+func (g *Grid[T]) Fill(value T) {
+	for i := range g.cells {
+		g.cells[i] = value
+	}
+}
+
+// Transpose returns a new cols x rows grid, where the element at (row, col) is the element
+// at (col, row) of this grid.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	result := NewGrid[T](g.cols, g.rows)
+	for r := range g.rows {
+		for c := range g.cols {
+			result.cells[c*g.rows+r] = g.cells[r*g.cols+c]
+		}
+	}
+	return result
+}
+
+// All returns an iterator over the cells of the grid, row after row, yielding the
+// position and the element of each cell.
+func (g *Grid[T]) All() iter.Seq2[[2]int, T] {
+	return func(yield func([2]int, T) bool) {
+		for i, v := range g.cells {
+			if !yield([2]int{i / g.cols, i % g.cols}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the positions of the cells, row after row.
+func (g *Grid[T]) Keys() iter.Seq[[2]int] {
+	return icontainer.Keys(g.All())
+}
+
+// Values returns an iterator over the elements of the cells, row after row.
+func (g *Grid[T]) Values() iter.Seq[T] {
+	return slices.Values(g.cells)
+}
+
+// Neighbors returns an iterator over the (row, col) positions of the in-bounds neighbors
+// of a cell: the 4 orthogonal ones, plus the 4 diagonal ones if diagonal is true.
+func (g *Grid[T]) Neighbors(row, col int, diagonal bool) iter.Seq2[int, int] {
+	offsets := offsets4
+	if diagonal {
+		offsets = offsets8
+	}
+
+	return func(yield func(int, int) bool) {
+		for _, o := range offsets {
+			r, c := row+o[0], col+o[1]
+			if !g.InBounds(r, c) {
+				continue
+			}
+			if !yield(r, c) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlices returns a copy of the grid as a slice of rows.
+func (g *Grid[T]) ToSlices() [][]T {
+	result := make([][]T, g.rows)
+	for r := range g.rows {
+		result[r] = append([]T(nil), g.Row(r)...)
+	}
+	return result
+}
+
+// String returns the grid formatted one row per line.
+func (g *Grid[T]) String() string {
+	var sb strings.Builder
+	for r := range g.rows {
+		if r > 0 {
+			sb.WriteByte('\n')
+		}
+		fmt.Fprint(&sb, g.Row(r))
+	}
+	return sb.String()
+}
+
+// offset returns the position of (row, col) in cells.  It panics if the cell is out of
+// bounds.
+func (g *Grid[T]) offset(row, col int) int {
+	if !g.InBounds(row, col) {
+		panic(fmt.Sprintf("Grid: cell (%d, %d) out of bounds %dx%d", row, col, g.rows, g.cols))
+	}
+	return row*g.cols + col
+}
+
+// Map returns a new grid of the same dimensions, holding the results of the iteratee for
+// every cell.
+func Map[T any, U any](g *Grid[T], iteratee func(row, col int, item T) U) *Grid[U] {
+	result := NewGrid[U](g.rows, g.cols)
+	for i, v := range g.cells {
+		result.cells[i] = iteratee(i/g.cols, i%g.cols, v)
+	}
+	return result
+}
@@ -0,0 +1,38 @@
+package imatrix
+
+import "fmt"
+
+func ExampleGrid() {
+	board := NewGrid[string](3, 3)
+	board.Fill(".")
+
+	board.Set(1, 1, "X")
+	for r, c := range board.Neighbors(1, 1, false) {
+		board.Set(r, c, "o")
+	}
+
+	fmt.Println(board)
+
+	// Output:
+	// [. o .]
+	// [o X o]
+	// [. o .]
+}
+
+func ExampleGridFrom() {
+	g, err := GridFrom([][]int{{1, 2, 3}, {4, 5, 6}})
+	if err != nil {
+		return
+	}
+
+	doubled := Map(g, func(_, _ int, item int) int { return item * 2 })
+
+	fmt.Println(g.Transpose())
+	fmt.Println(doubled.Row(1), g.Col(2))
+
+	// Output:
+	// [1 4]
+	// [2 5]
+	// [3 6]
+	// [8 10 12] [3 6]
+}
@@ -0,0 +1,104 @@
+package imatrix
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestGrid(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGrid")
+
+	g := NewGrid[int](2, 3)
+	assert.Equal(2, g.Rows())
+	assert.Equal(3, g.Cols())
+	assert.Equal([][]int{{0, 0, 0}, {0, 0, 0}}, g.ToSlices())
+
+	g.Set(1, 2, 5)
+	assert.Equal(5, g.Get(1, 2))
+	assert.ShouldBeTrue(g.InBounds(1, 2))
+	assert.ShouldBeFalse(g.InBounds(2, 0))
+	assert.ShouldBeFalse(g.InBounds(0, -1))
+
+	row := g.Row(0)
+	row[1] = 7
+	assert.Equal(7, g.Get(0, 1))
+	assert.Equal([]int{7, 0}, g.Col(1))
+
+	// appending to a row view must not overwrite the next row
+	_ = append(g.Row(0), 9)
+	assert.Equal(0, g.Get(1, 0))
+
+	g.Fill(1)
+	assert.Equal([][]int{{1, 1, 1}, {1, 1, 1}}, g.ToSlices())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	g.Get(2, 0)
+}
+
+func TestGridFrom(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGridFrom")
+
+	g, err := GridFrom([][]string{{"a", "b"}, {"c", "d"}, {"e", "f"}})
+	assert.IsNil(err)
+	assert.Equal(3, g.Rows())
+	assert.Equal("d", g.Get(1, 1))
+
+	_, err = GridFrom([][]int{{1, 2}, {3}})
+	assert.IsNotNil(err)
+
+	empty, err := GridFrom[int](nil)
+	assert.IsNil(err)
+	assert.Equal(0, empty.Rows())
+}
+
+func TestGridTransposeAndMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGridTransposeAndMap")
+
+	g, _ := GridFrom([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	assert.Equal([][]int{{1, 4}, {2, 5}, {3, 6}}, g.Transpose().ToSlices())
+
+	labels := Map(g, func(row, col int, item int) string {
+		if (row+col)%2 == 0 {
+			return "x"
+		}
+		return "o"
+	})
+	assert.Equal([][]string{{"x", "o", "x"}, {"o", "x", "o"}}, labels.ToSlices())
+
+	sum := 0
+	for pos, v := range g.All() {
+		sum += pos[0]*10 + pos[1] + v
+	}
+	assert.Equal(21+(0+1+2+10+11+12), sum)
+}
+
+func TestGridNeighbors(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGridNeighbors")
+
+	g := NewGrid[bool](3, 3)
+
+	collect := func(row, col int, diagonal bool) [][2]int {
+		var result [][2]int
+		for r, c := range g.Neighbors(row, col, diagonal) {
+			result = append(result, [2]int{r, c})
+		}
+		return result
+	}
+
+	assert.Equal([][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 1}}, collect(1, 1, false))
+	assert.Equal(8, len(collect(1, 1, true)))
+	assert.Equal([][2]int{{0, 1}, {1, 0}}, collect(0, 0, false))
+	assert.Equal([][2]int{{0, 1}, {1, 0}, {1, 1}}, collect(0, 0, true))
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package imatrix
+
+import "encoding/json"
+
+// MarshalJSON encodes the grid as a JSON array of rows, each an array of cells.
+func (g *Grid[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.ToSlices())
+}
+
+// UnmarshalJSON replaces the grid with the rows of a JSON array of arrays.  As GridFrom,
+// it returns an error if the rows do not all have the same length.
+func (g *Grid[T]) UnmarshalJSON(data []byte) error {
+	var rows [][]T
+	if err := json.Unmarshal(data, &rows); err != nil || rows == nil {
+		return err
+	}
+	decoded, err := GridFrom(rows)
+	if err != nil {
+		return err
+	}
+	*g = *decoded
+	return nil
+}
@@ -0,0 +1,28 @@
+package imatrix
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestGridJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGridJSON")
+
+	g, _ := GridFrom([][]int{{1, 2, 3}, {4, 5, 6}})
+	data, err := json.Marshal(g)
+	assert.IsNil(err)
+	assert.Equal("[[1,2,3],[4,5,6]]", string(data))
+
+	var decoded Grid[int]
+	assert.IsNil(json.Unmarshal(data, &decoded))
+	assert.Equal(2, decoded.Rows())
+	assert.Equal(3, decoded.Cols())
+	assert.Equal(6, decoded.Get(1, 2))
+
+	assert.IsNotNil(json.Unmarshal([]byte("[[1],[2,3]]"), &decoded))
+	assert.Equal(3, decoded.Cols())
+}
@@ -0,0 +1,575 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package ibinary implements the binary format shared by the MarshalBinary methods of the
+// containers of this module.  An encoding starts with a version byte and a format byte.
+//
+// In the compact format, a varint count follows, then the elements (for associative
+// containers, each key followed by its value).  Booleans take a byte, integers are
+// varints, floats are little-endian IEEE 754 bits, and strings and slices are prefixed by
+// their varint length.  Arrays and structs, whose fields must all be exported, are
+// encoded field by field; types implementing encoding.BinaryMarshaler are encoded by it,
+// prefixed by their length.
+//
+// Element types without a compact encoding (maps, pointers, interfaces, recursive types,
+// structs with unexported fields) use the gob format instead: the elements are encoded with
+// encoding/gob, as a slice (for associative containers, a slice of keys followed by a slice
+// of values).
+package ibinary
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"reflect"
+)
+
+// version is the first byte of every encoding.
+const version = 1
+
+// The second byte of an encoding tells its format.
+const (
+	formatCompact = 0
+	formatGob     = 1
+)
+
+var (
+	errTruncated          = errors.New("truncated data")
+	binaryMarshalerType   = reflect.TypeFor[encoding.BinaryMarshaler]()
+	binaryUnmarshalerType = reflect.TypeFor[encoding.BinaryUnmarshaler]()
+)
+
+// EncodeSlice encodes the items of seq.
+func EncodeSlice[T any](seq iter.Seq[T]) ([]byte, error) {
+	c, ok := codecFor(reflect.TypeFor[T](), map[reflect.Type]bool{})
+	if !ok {
+		items := []T{}
+		for item := range seq {
+			items = append(items, item)
+		}
+		return encodeGob(items)
+	}
+
+	var body []byte
+	n := 0
+	for item := range seq {
+		var err error
+		if body, err = c.encode(body, reflect.ValueOf(&item).Elem()); err != nil {
+			return nil, err
+		}
+		n++
+	}
+	return appendHeader(n, body), nil
+}
+
+// DecodeSlice decodes a slice encoded by EncodeSlice.  It returns a non-nil slice.
+func DecodeSlice[T any](data []byte) ([]T, error) {
+	format, r, err := readHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if format == formatGob {
+		items := []T{}
+		if err := decodeGob(r.data, &items); err != nil {
+			return nil, err
+		}
+		return items, nil
+	}
+
+	c, ok := codecFor(reflect.TypeFor[T](), map[reflect.Type]bool{})
+	if !ok {
+		return nil, fmt.Errorf("no compact encoding for %s", reflect.TypeFor[T]())
+	}
+	n, err := r.count(c.minSize)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]T, n)
+	for i := range items {
+		if err := c.decode(r, reflect.ValueOf(&items[i]).Elem()); err != nil {
+			return nil, err
+		}
+	}
+	return items, r.end()
+}
+
+// EncodePairs encodes the keys and the values of seq, in the order of seq.
+func EncodePairs[K any, V any](seq iter.Seq2[K, V]) ([]byte, error) {
+	visiting := map[reflect.Type]bool{}
+	kc, kok := codecFor(reflect.TypeFor[K](), visiting)
+	vc, vok := codecFor(reflect.TypeFor[V](), visiting)
+	if !kok || !vok {
+		keys, values := []K{}, []V{}
+		for k, v := range seq {
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+		return encodeGob(keys, values)
+	}
+
+	var body []byte
+	n := 0
+	for k, v := range seq {
+		var err error
+		if body, err = kc.encode(body, reflect.ValueOf(&k).Elem()); err != nil {
+			return nil, err
+		}
+		if body, err = vc.encode(body, reflect.ValueOf(&v).Elem()); err != nil {
+			return nil, err
+		}
+		n++
+	}
+	return appendHeader(n, body), nil
+}
+
+// DecodePairs decodes the keys and the values encoded by EncodePairs.  It returns an
+// error if their numbers differ.
+func DecodePairs[K any, V any](data []byte) ([]K, []V, error) {
+	format, r, err := readHeader(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if format == formatGob {
+		var keys []K
+		var values []V
+		if err := decodeGob(r.data, &keys, &values); err != nil {
+			return nil, nil, err
+		}
+		if len(keys) != len(values) {
+			return nil, nil, fmt.Errorf("%d keys for %d values", len(keys), len(values))
+		}
+		return keys, values, nil
+	}
+
+	visiting := map[reflect.Type]bool{}
+	kc, kok := codecFor(reflect.TypeFor[K](), visiting)
+	vc, vok := codecFor(reflect.TypeFor[V](), visiting)
+	if !kok || !vok {
+		return nil, nil, fmt.Errorf("no compact encoding for %s and %s", reflect.TypeFor[K](), reflect.TypeFor[V]())
+	}
+	n, err := r.count(kc.minSize + vc.minSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, values := make([]K, n), make([]V, n)
+	for i := range n {
+		if err := kc.decode(r, reflect.ValueOf(&keys[i]).Elem()); err != nil {
+			return nil, nil, err
+		}
+		if err := vc.decode(r, reflect.ValueOf(&values[i]).Elem()); err != nil {
+			return nil, nil, err
+		}
+	}
+	return keys, values, r.end()
+}
+
+func appendHeader(n int, body []byte) []byte {
+	data := make([]byte, 0, 2+binary.MaxVarintLen64+len(body))
+	data = append(data, version, formatCompact)
+	data = binary.AppendUvarint(data, uint64(n))
+	return append(data, body...)
+}
+
+func readHeader(data []byte) (byte, *reader, error) {
+	if len(data) < 2 {
+		return 0, nil, errTruncated
+	}
+	if data[0] != version {
+		return 0, nil, fmt.Errorf("unknown encoding version %d", data[0])
+	}
+	if data[1] != formatCompact && data[1] != formatGob {
+		return 0, nil, fmt.Errorf("unknown encoding format %d", data[1])
+	}
+	return data[1], &reader{data: data[2:]}, nil
+}
+
+func encodeGob(slices ...any) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{version, formatGob})
+	enc := gob.NewEncoder(buf)
+	for _, s := range slices {
+		if err := enc.Encode(s); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeGob(data []byte, slices ...any) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	for _, s := range slices {
+		if err := dec.Decode(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// codec encodes and decodes the values of a type in the compact format.  The values must be
+// addressable.
+type codec struct {
+	encode func(data []byte, v reflect.Value) ([]byte, error)
+	decode func(r *reader, v reflect.Value) error
+	// minSize is the least number of bytes of an encoded value.
+	minSize int
+}
+
+// codecFor returns the compact codec of t, or false if t has none.  visiting holds the
+// types whose codec is being built, to detect recursive types.
+func codecFor(t reflect.Type, visiting map[reflect.Type]bool) (codec, bool) {
+	if visiting[t] {
+		return codec{}, false
+	}
+	pt := reflect.PointerTo(t)
+	if pt.Implements(binaryMarshalerType) && pt.Implements(binaryUnmarshalerType) {
+		return marshalerCodec, true
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return boolCodec, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intCodec, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintCodec, true
+	case reflect.Float32, reflect.Float64:
+		return floatCodec(t.Bits()), true
+	case reflect.Complex64, reflect.Complex128:
+		return complexCodec(t.Bits() / 2), true
+	case reflect.String:
+		return stringCodec, true
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && !reflect.PointerTo(t.Elem()).Implements(binaryMarshalerType) {
+			return bytesCodec, true
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		elem, ok := codecFor(t.Elem(), visiting)
+		return sliceCodec(elem), ok
+	case reflect.Array:
+		visiting[t] = true
+		defer delete(visiting, t)
+		elem, ok := codecFor(t.Elem(), visiting)
+		return arrayCodec(elem, t.Len()), ok
+	case reflect.Struct:
+		visiting[t] = true
+		defer delete(visiting, t)
+		fields := make([]codec, t.NumField())
+		for i := range fields {
+			if !t.Field(i).IsExported() {
+				return codec{}, false
+			}
+			var ok bool
+			if fields[i], ok = codecFor(t.Field(i).Type, visiting); !ok {
+				return codec{}, false
+			}
+		}
+		return structCodec(fields), true
+	}
+	return codec{}, false
+}
+
+var boolCodec = codec{
+	encode: func(data []byte, v reflect.Value) ([]byte, error) {
+		if v.Bool() {
+			return append(data, 1), nil
+		}
+		return append(data, 0), nil
+	},
+	decode: func(r *reader, v reflect.Value) error {
+		b, err := r.bytes(1)
+		if err != nil {
+			return err
+		}
+		if b[0] > 1 {
+			return fmt.Errorf("invalid boolean %d", b[0])
+		}
+		v.SetBool(b[0] == 1)
+		return nil
+	},
+	minSize: 1,
+}
+
+var intCodec = codec{
+	encode: func(data []byte, v reflect.Value) ([]byte, error) {
+		return binary.AppendVarint(data, v.Int()), nil
+	},
+	decode: func(r *reader, v reflect.Value) error {
+		n, err := r.varint()
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, v.Type())
+		}
+		v.SetInt(n)
+		return nil
+	},
+	minSize: 1,
+}
+
+var uintCodec = codec{
+	encode: func(data []byte, v reflect.Value) ([]byte, error) {
+		return binary.AppendUvarint(data, v.Uint()), nil
+	},
+	decode: func(r *reader, v reflect.Value) error {
+		n, err := r.uvarint()
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("value %d overflows %s", n, v.Type())
+		}
+		v.SetUint(n)
+		return nil
+	},
+	minSize: 1,
+}
+
+func floatCodec(bits int) codec {
+	return codec{
+		encode: func(data []byte, v reflect.Value) ([]byte, error) {
+			return appendFloat(data, v.Float(), bits), nil
+		},
+		decode: func(r *reader, v reflect.Value) error {
+			f, err := r.float(bits)
+			v.SetFloat(f)
+			return err
+		},
+		minSize: bits / 8,
+	}
+}
+
+func complexCodec(bits int) codec {
+	return codec{
+		encode: func(data []byte, v reflect.Value) ([]byte, error) {
+			c := v.Complex()
+			return appendFloat(appendFloat(data, real(c), bits), imag(c), bits), nil
+		},
+		decode: func(r *reader, v reflect.Value) error {
+			re, err := r.float(bits)
+			if err != nil {
+				return err
+			}
+			im, err := r.float(bits)
+			v.SetComplex(complex(re, im))
+			return err
+		},
+		minSize: bits / 4,
+	}
+}
+
+var stringCodec = codec{
+	encode: func(data []byte, v reflect.Value) ([]byte, error) {
+		data = binary.AppendUvarint(data, uint64(v.Len()))
+		return append(data, v.String()...), nil
+	},
+	decode: func(r *reader, v reflect.Value) error {
+		b, err := r.prefixed()
+		v.SetString(string(b))
+		return err
+	},
+	minSize: 1,
+}
+
+var bytesCodec = codec{
+	encode: func(data []byte, v reflect.Value) ([]byte, error) {
+		data = binary.AppendUvarint(data, uint64(v.Len()))
+		return append(data, v.Bytes()...), nil
+	},
+	decode: func(r *reader, v reflect.Value) error {
+		b, err := r.prefixed()
+		if err != nil || len(b) == 0 {
+			return err
+		}
+		v.SetBytes(bytes.Clone(b))
+		return nil
+	},
+	minSize: 1,
+}
+
+var marshalerCodec = codec{
+	encode: func(data []byte, v reflect.Value) ([]byte, error) {
+		b, err := v.Addr().Interface().(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		data = binary.AppendUvarint(data, uint64(len(b)))
+		return append(data, b...), nil
+	},
+	decode: func(r *reader, v reflect.Value) error {
+		b, err := r.prefixed()
+		if err != nil {
+			return err
+		}
+		return v.Addr().Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(b)
+	},
+	minSize: 1,
+}
+
+func sliceCodec(elem codec) codec {
+	return codec{
+		encode: func(data []byte, v reflect.Value) ([]byte, error) {
+			data = binary.AppendUvarint(data, uint64(v.Len()))
+			for i := range v.Len() {
+				var err error
+				if data, err = elem.encode(data, v.Index(i)); err != nil {
+					return nil, err
+				}
+			}
+			return data, nil
+		},
+		decode: func(r *reader, v reflect.Value) error {
+			n, err := r.count(elem.minSize)
+			if err != nil || n == 0 {
+				return err
+			}
+			v.Set(reflect.MakeSlice(v.Type(), n, n))
+			for i := range n {
+				if err := elem.decode(r, v.Index(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		minSize: 1,
+	}
+}
+
+func arrayCodec(elem codec, n int) codec {
+	return codec{
+		encode: func(data []byte, v reflect.Value) ([]byte, error) {
+			for i := range n {
+				var err error
+				if data, err = elem.encode(data, v.Index(i)); err != nil {
+					return nil, err
+				}
+			}
+			return data, nil
+		},
+		decode: func(r *reader, v reflect.Value) error {
+			for i := range n {
+				if err := elem.decode(r, v.Index(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		minSize: n * elem.minSize,
+	}
+}
+
+func structCodec(fields []codec) codec {
+	size := 0
+	for _, f := range fields {
+		size += f.minSize
+	}
+	return codec{
+		encode: func(data []byte, v reflect.Value) ([]byte, error) {
+			for i, f := range fields {
+				var err error
+				if data, err = f.encode(data, v.Field(i)); err != nil {
+					return nil, err
+				}
+			}
+			return data, nil
+		},
+		decode: func(r *reader, v reflect.Value) error {
+			for i, f := range fields {
+				if err := f.decode(r, v.Field(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		minSize: size,
+	}
+}
+
+func appendFloat(data []byte, f float64, bits int) []byte {
+	if bits == 32 {
+		return binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(f)))
+	}
+	return binary.LittleEndian.AppendUint64(data, math.Float64bits(f))
+}
+
+// reader consumes an encoding in the compact format.
+type reader struct {
+	data []byte
+}
+
+func (r *reader) bytes(n int) ([]byte, error) {
+	if n > len(r.data) {
+		return nil, errTruncated
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b, nil
+}
+
+func (r *reader) uvarint() (uint64, error) {
+	n, size := binary.Uvarint(r.data)
+	if size <= 0 {
+		return 0, errTruncated
+	}
+	r.data = r.data[size:]
+	return n, nil
+}
+
+func (r *reader) varint() (int64, error) {
+	n, size := binary.Varint(r.data)
+	if size <= 0 {
+		return 0, errTruncated
+	}
+	r.data = r.data[size:]
+	return n, nil
+}
+
+func (r *reader) float(bits int) (float64, error) {
+	b, err := r.bytes(bits / 8)
+	if err != nil {
+		return 0, err
+	}
+	if bits == 32 {
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), nil
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+}
+
+// prefixed reads bytes prefixed by their varint length.
+func (r *reader) prefixed() ([]byte, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.data)) {
+		return nil, errTruncated
+	}
+	return r.bytes(int(n))
+}
+
+// count reads a number of elements of minSize bytes at least, and checks that the data
+// can hold them, so that corrupt data never causes a huge allocation.
+func (r *reader) count(minSize int) (int, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt32 || minSize > 0 && n > uint64(len(r.data)/minSize) {
+		return 0, errTruncated
+	}
+	return int(n), nil
+}
+
+// end returns an error if data remains after the encoding.
+func (r *reader) end() error {
+	if len(r.data) > 0 {
+		return fmt.Errorf("%d bytes of trailing data", len(r.data))
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package ibinary
+
+import (
+	"encoding/binary"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/idichekop/gods/internal"
+)
+
+type point struct {
+	X, Y  int
+	Label string
+	Tags  []string
+	At    time.Time
+}
+
+type node struct {
+	Value    int
+	Children []node
+}
+
+type hidden struct {
+	value int
+}
+
+func TestSlice(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSlice")
+
+	data, err := EncodeSlice(slices.Values([]string{"a", "b"}))
+	assert.IsNil(err)
+	assert.Equal([]byte{version, formatCompact, 2, 1, 'a', 1, 'b'}, data)
+	items, err := DecodeSlice[string](data)
+	assert.IsNil(err)
+	assert.Equal([]string{"a", "b"}, items)
+
+	data, err = EncodeSlice(slices.Values([]int(nil)))
+	assert.IsNil(err)
+	empty, err := DecodeSlice[int](data)
+	assert.IsNil(err)
+	assert.ShouldBeTrue(empty != nil)
+	assert.Equal(0, len(empty))
+
+	_, err = DecodeSlice[int](data[:1])
+	assert.IsNotNil(err)
+	_, err = EncodeSlice(slices.Values([]func(){nil}))
+	assert.IsNotNil(err)
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCompact")
+
+	// Small integers take a byte each.
+	data, err := EncodeSlice(slices.Values([]int{1, -2, 3}))
+	assert.IsNil(err)
+	assert.Equal(6, len(data))
+
+	floats := []float64{0, -1.5, math.Inf(1), math.MaxFloat64}
+	data, err = EncodeSlice(slices.Values(floats))
+	assert.IsNil(err)
+	decodedFloats, err := DecodeSlice[float64](data)
+	assert.IsNil(err)
+	assert.Equal(floats, decodedFloats)
+
+	mixed := []struct {
+		B  bool
+		I8 int8
+		U  uint64
+		F  float32
+		C  complex128
+		A  [2]uint16
+		Bs []byte
+	}{{true, -128, math.MaxUint64, 1.25, 1 + 2i, [2]uint16{7, 65535}, []byte("xyz")}, {}}
+	data, err = EncodeSlice(slices.Values(mixed))
+	assert.IsNil(err)
+	decodedMixed, err := DecodeSlice[struct {
+		B  bool
+		I8 int8
+		U  uint64
+		F  float32
+		C  complex128
+		A  [2]uint16
+		Bs []byte
+	}](data)
+	assert.IsNil(err)
+	assert.Equal(mixed, decodedMixed)
+
+	// Structs are encoded field by field, and time.Time by its MarshalBinary method.
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	points := []point{{X: 1, Y: -1, Label: "p", Tags: []string{"a"}, At: at}, {}}
+	data, err = EncodeSlice(slices.Values(points))
+	assert.IsNil(err)
+	assert.Equal(byte(formatCompact), data[1])
+	decodedPoints, err := DecodeSlice[point](data)
+	assert.IsNil(err)
+	assert.Equal(points, decodedPoints)
+
+	// A value that overflows the decoded type is an error.
+	data, _ = EncodeSlice(slices.Values([]int{300}))
+	_, err = DecodeSlice[int8](data)
+	assert.IsNotNil(err)
+}
+
+func TestGobFallback(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGobFallback")
+
+	trees := []node{{Value: 1, Children: []node{{Value: 2}}}}
+	data, err := EncodeSlice(slices.Values(trees))
+	assert.IsNil(err)
+	assert.Equal(byte(formatGob), data[1])
+	decodedTrees, err := DecodeSlice[node](data)
+	assert.IsNil(err)
+	assert.Equal(trees, decodedTrees)
+
+	ms := []map[string]int{{"a": 1}}
+	data, err = EncodeSlice(slices.Values(ms))
+	assert.IsNil(err)
+	decodedMaps, err := DecodeSlice[map[string]int](data)
+	assert.IsNil(err)
+	assert.Equal(ms, decodedMaps)
+
+	_, ok := codecFor(reflect.TypeFor[hidden](), map[reflect.Type]bool{})
+	assert.ShouldBeFalse(ok)
+}
+
+func TestPairs(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPairs")
+
+	data, err := EncodePairs(slices.All([]string{"x", "y"}))
+	assert.IsNil(err)
+	assert.Equal([]byte{version, formatCompact, 2, 0, 1, 'x', 2, 1, 'y'}, data)
+	keys, values, err := DecodePairs[int, string](data)
+	assert.IsNil(err)
+	assert.Equal([]int{0, 1}, keys)
+	assert.Equal([]string{"x", "y"}, values)
+
+	_, _, err = DecodePairs[int, string](data[:len(data)-1])
+	assert.IsNotNil(err)
+	_, _, err = DecodePairs[int, string](append(data, 0))
+	assert.IsNotNil(err)
+
+	data, err = EncodePairs(maps.All(map[string][]int{"a": {1, 2}}))
+	assert.IsNil(err)
+	groupKeys, groups, err := DecodePairs[string, []int](data)
+	assert.IsNil(err)
+	assert.Equal([]string{"a"}, groupKeys)
+	assert.Equal([][]int{{1, 2}}, groups)
+
+	one := 1
+	data, err = EncodePairs(maps.All(map[string]*int{"a": &one}))
+	assert.IsNil(err)
+	assert.Equal(byte(formatGob), data[1])
+	_, pointers, err := DecodePairs[string, *int](data)
+	assert.IsNil(err)
+	assert.Equal(1, *pointers[0])
+}
+
+func TestCorruptData(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCorruptData")
+
+	for _, data := range [][]byte{
+		nil,
+		{2, formatCompact, 0},
+		{version, 7, 0},
+		{version, formatCompact},
+		// A huge count is rejected before allocating.
+		binary.AppendUvarint([]byte{version, formatCompact}, math.MaxInt64),
+		{version, formatCompact, 1, 5, 'a'},
+	} {
+		_, err := DecodeSlice[string](data)
+		assert.IsNotNil(err)
+	}
+
+	_, err := DecodeSlice[bool]([]byte{version, formatCompact, 1, 2})
+	assert.IsNotNil(err)
+}
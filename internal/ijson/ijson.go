@@ -0,0 +1,153 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package ijson holds the helpers shared by the JSON encodings of the containers of this
+// module: collections encode as JSON arrays, associative containers as JSON objects.
+package ijson
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// IsNull reports whether data is the JSON null literal.  As for the types of the
+// standard library, UnmarshalJSON treats it as a no-op.
+func IsNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// MarshalArray encodes the items of seq as a JSON array.  An empty seq encodes as [],
+// never as null.
+func MarshalArray[T any](seq iter.Seq[T]) ([]byte, error) {
+	items := []T{}
+	for item := range seq {
+		items = append(items, item)
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalArray decodes the JSON array data.  It returns a nil slice for a JSON null,
+// and a non-nil one otherwise.
+func UnmarshalArray[T any](data []byte) ([]T, error) {
+	if IsNull(data) {
+		return nil, nil
+	}
+	items := []T{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// MarshalObject encodes the pairs of seq as a JSON object whose members keep the order of
+// seq.  Keys are encoded as encoding/json encodes map keys: strings as they are, then
+// encoding.TextMarshaler, then integers in decimal.
+func MarshalObject[K any, V any](seq iter.Seq2[K, V]) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for key, value := range seq {
+		name, err := marshalKey(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedName, _ := json.Marshal(name)
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.Write(encodedName)
+		buf.WriteByte(':')
+		buf.Write(encodedValue)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalObject decodes the JSON object data, and calls put with each of its members,
+// in order.  Keys are decoded as encoding/json decodes map keys.  A JSON null calls put
+// for nothing.
+func UnmarshalObject[K any, V any](data []byte, put func(key K, value V)) error {
+	if IsNull(data) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return fmt.Errorf("cannot unmarshal %v into an object", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var key K
+		if err := unmarshalKey(tok.(string), &key); err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		put(key, value)
+	}
+	_, err := dec.Token()
+	return err
+}
+
+func marshalKey(key any) (string, error) {
+	v := reflect.ValueOf(key)
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported key type %T", key)
+}
+
+func unmarshalKey(name string, key any) error {
+	v := reflect.ValueOf(key).Elem()
+	if v.Kind() == reflect.String {
+		v.SetString(name)
+		return nil
+	}
+	if tu, ok := key.(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(name))
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return fmt.Errorf("invalid key %q for type %s", name, v.Type())
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return fmt.Errorf("invalid key %q for type %s", name, v.Type())
+		}
+		v.SetUint(n)
+		return nil
+	}
+	return fmt.Errorf("unsupported key type %s", v.Type())
+}
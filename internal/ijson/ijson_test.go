@@ -0,0 +1,91 @@
+package ijson
+
+import (
+	"maps"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+type pair struct {
+	key   string
+	value int
+}
+
+func TestArray(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestArray")
+
+	data, err := MarshalArray(slices.Values([]int(nil)))
+	assert.IsNil(err)
+	assert.Equal("[]", string(data))
+
+	data, err = MarshalArray(slices.Values([]string{"a", "b"}))
+	assert.IsNil(err)
+	assert.Equal(`["a","b"]`, string(data))
+
+	items, err := UnmarshalArray[int]([]byte(" null "))
+	assert.IsNil(err)
+	assert.ShouldBeTrue(items == nil)
+
+	items, err = UnmarshalArray[int]([]byte("[]"))
+	assert.IsNil(err)
+	assert.ShouldBeTrue(items != nil)
+	assert.Equal(0, len(items))
+
+	_, err = UnmarshalArray[int]([]byte(`["a"]`))
+	assert.IsNotNil(err)
+}
+
+func TestObject(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestObject")
+
+	data, err := MarshalObject(slices.All([]string{"x", "y"}))
+	assert.IsNil(err)
+	assert.Equal(`{"0":"x","1":"y"}`, string(data))
+
+	data, err = MarshalObject(maps.All(map[string]int{}))
+	assert.IsNil(err)
+	assert.Equal("{}", string(data))
+
+	// Members keep the order of the sequence, not the sorted one of Go maps.
+	seq := func(yield func(string, int) bool) {
+		_ = yield("b", 1) && yield("a\"", 2)
+	}
+	data, err = MarshalObject(seq)
+	assert.IsNil(err)
+	assert.Equal(`{"b":1,"a\"":2}`, string(data))
+
+	addr := netip.MustParseAddr("10.0.0.1")
+	data, err = MarshalObject(maps.All(map[netip.Addr]bool{addr: true}))
+	assert.IsNil(err)
+	assert.Equal(`{"10.0.0.1":true}`, string(data))
+
+	_, err = MarshalObject(maps.All(map[float64]int{1.5: 1}))
+	assert.IsNotNil(err)
+
+	var got []pair
+	err = UnmarshalObject([]byte(`{"b":1,"a":2}`), func(k string, v int) {
+		got = append(got, pair{k, v})
+	})
+	assert.IsNil(err)
+	assert.Equal([]pair{{"b", 1}, {"a", 2}}, got)
+
+	ints := map[int8]string{}
+	assert.IsNil(UnmarshalObject([]byte(`{"-3":"x"}`), func(k int8, v string) { ints[k] = v }))
+	assert.Equal(map[int8]string{-3: "x"}, ints)
+	assert.IsNotNil(UnmarshalObject([]byte(`{"300":"x"}`), func(k int8, v string) {}))
+	assert.IsNotNil(UnmarshalObject([]byte(`{"1":"x"}`), func(k uint, v int) {}))
+
+	addrs := map[netip.Addr]bool{}
+	assert.IsNil(UnmarshalObject([]byte(`{"10.0.0.1":true}`), func(k netip.Addr, v bool) { addrs[k] = v }))
+	assert.ShouldBeTrue(addrs[addr])
+
+	assert.IsNil(UnmarshalObject([]byte("null"), func(k string, v int) { t.Error("unexpected member") }))
+	assert.IsNotNil(UnmarshalObject([]byte("[1]"), func(k string, v int) {}))
+}
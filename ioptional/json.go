@@ -0,0 +1,36 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package ioptional
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the value of the Optional, or null if it is empty.  Since the empty
+// Optional is the zero one, a struct field tagged omitzero is omitted when empty.
+//
+// A present value that encodes to null, such as Some of a nil pointer, interface, map or
+// slice, cannot be told from an empty Optional in JSON: UnmarshalJSON decodes it as None.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON sets the Optional to the decoded value, or empties it for a JSON null.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
@@ -0,0 +1,45 @@
+package ioptional
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestOptionalJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestOptionalJSON")
+
+	type request struct {
+		Name  Optional[string] `json:"name"`
+		Limit Optional[int]    `json:"limit,omitzero"`
+	}
+
+	data, err := json.Marshal(request{Name: Some("x")})
+	assert.IsNil(err)
+	assert.Equal(`{"name":"x"}`, string(data))
+
+	data, err = json.Marshal(request{Limit: Some(0)})
+	assert.IsNil(err)
+	assert.Equal(`{"name":null,"limit":0}`, string(data))
+
+	var r request
+	assert.IsNil(json.Unmarshal([]byte(`{"limit":5}`), &r))
+	assert.ShouldBeFalse(r.Name.IsPresent())
+	assert.Equal(5, r.Limit.OrElse(0))
+
+	r.Name = Some("y")
+	assert.IsNil(json.Unmarshal([]byte(`{"name":null}`), &r))
+	assert.ShouldBeFalse(r.Name.IsPresent())
+	assert.IsNotNil(json.Unmarshal([]byte(`{"limit":"five"}`), &r))
+
+	// A present nil encodes to null, which decodes as None.
+	data, err = json.Marshal(Some[*int](nil))
+	assert.IsNil(err)
+	assert.Equal("null", string(data))
+	decoded := Some(new(int))
+	assert.IsNil(json.Unmarshal(data, &decoded))
+	assert.ShouldBeFalse(decoded.IsPresent())
+}
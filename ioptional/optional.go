@@ -0,0 +1,79 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package ioptional implements a generic optional value, returned by the lookup functions
+// of the other packages of this module.
+package ioptional
+
+import "fmt"
+
+// Optional holds either a value (it is present) or nothing (it is empty).  The zero
+// Optional is empty.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some creates an Optional holding the given value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, present: true}
+}
+
+// None creates an empty Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Of creates an Optional from the usual (value, ok) pair: it holds value if ok is true,
+// and is empty otherwise.
+func Of[T any](value T, ok bool) Optional[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// IsPresent reports whether the Optional holds a value.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get returns the value and true, or the zero value of T and false if the Optional is
+// empty.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// OrElse returns the value, or fallback if the Optional is empty.
+func (o Optional[T]) OrElse(fallback T) T {
+	if !o.present {
+		return fallback
+	}
+	return o.value
+}
+
+// Filter returns the Optional itself if it holds a value that passes the predicate, and
+// an empty Optional otherwise.
+func (o Optional[T]) Filter(predicate func(value T) bool) Optional[T] {
+	if !o.present || !predicate(o.value) {
+		return None[T]()
+	}
+	return o
+}
+
+// String returns the value formatted as Some(value), or None.
+func (o Optional[T]) String() string {
+	if !o.present {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// Map applies the iteratee to the value of the Optional, if any.
+func Map[T any, U any](o Optional[T], iteratee func(value T) U) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return Some(iteratee(o.value))
+}
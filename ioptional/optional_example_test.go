@@ -0,0 +1,36 @@
+package ioptional
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ExampleOptional() {
+	name := Some("gopher")
+	missing := None[string]()
+
+	fmt.Println(name, missing)
+	fmt.Println(name.OrElse("anonymous"), missing.OrElse("anonymous"))
+	fmt.Println(Map(name, strings.ToUpper))
+	fmt.Println(name.Filter(func(s string) bool { return len(s) > 10 }))
+
+	// Output:
+	// Some(gopher) None
+	// gopher anonymous
+	// Some(GOPHER)
+	// None
+}
+
+func ExampleOf() {
+	m := map[string]int{"a": 1}
+
+	a, ok := m["a"]
+	fmt.Println(Of(a, ok))
+
+	b, ok := m["b"]
+	fmt.Println(Of(b, ok))
+
+	// Output:
+	// Some(1)
+	// None
+}
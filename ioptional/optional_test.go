@@ -0,0 +1,57 @@
+package ioptional
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestOptional(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestOptional")
+
+	some := Some(3)
+	assert.ShouldBeTrue(some.IsPresent())
+	v, ok := some.Get()
+	assert.Equal(3, v)
+	assert.ShouldBeTrue(ok)
+	assert.Equal(3, some.OrElse(7))
+	assert.Equal("Some(3)", some.String())
+
+	none := None[int]()
+	assert.ShouldBeFalse(none.IsPresent())
+	v, ok = none.Get()
+	assert.Equal(0, v)
+	assert.ShouldBeFalse(ok)
+	assert.Equal(7, none.OrElse(7))
+	assert.Equal("None", none.String())
+
+	var zero Optional[int]
+	assert.Equal(none, zero)
+
+	assert.Equal(some, Of(3, true))
+	assert.Equal(none, Of(3, false))
+}
+
+func TestOptionalFilter(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestOptionalFilter")
+
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	assert.Equal(Some(2), Some(2).Filter(isEven))
+	assert.Equal(None[int](), Some(3).Filter(isEven))
+	assert.Equal(None[int](), None[int]().Filter(isEven))
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMap")
+
+	assert.Equal(Some("42"), Map(Some(42), strconv.Itoa))
+	assert.Equal(None[string](), Map(None[int](), strconv.Itoa))
+}
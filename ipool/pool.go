@@ -0,0 +1,206 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package ipool implements a typed pool of reusable items.
+package ipool
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// PoolOptions configures a Pool.  The zero PoolOptions keeps every item put back, for ever.
+type PoolOptions[T any] struct {
+	// Reset prepares a value put back for its reuse, for example by truncating a buffer,
+	// and returns the value to keep.  Nil keeps the value as it is.
+	Reset func(value T) T
+	// MaxIdle caps the number of idle items: Put drops the items beyond it.  Zero means no
+	// limit.
+	MaxIdle int
+	// MaxIdleTime evicts the items idle for longer than it.  Zero means no limit.
+	MaxIdleTime time.Duration
+	// MaxLifetime drops the items created longer than it ago, when Get or Put meets them.
+	// Zero means no limit.
+	MaxLifetime time.Duration
+}
+
+// PoolStats are the usage statistics of a Pool.
+type PoolStats struct {
+	// Gets is the number of calls to Get.
+	Gets uint64
+	// Hits is the number of Gets served by an idle item, instead of a new one.
+	Hits uint64
+	// Puts is the number of calls to Put.
+	Puts uint64
+	// Dropped is the number of items dropped by Put, as the pool had MaxIdle items.
+	Dropped uint64
+	// Expired is the number of idle items evicted after MaxIdleTime.
+	Expired uint64
+	// Retired is the number of items dropped by Get or Put after MaxLifetime.
+	Retired uint64
+	// Idle is the current number of idle items.
+	Idle int
+}
+
+// Item is an item lent out by a Pool.  It carries the time its value was created, so the
+// value may be replaced while the item is lent out, for example by a buffer grown by
+// append, without losing track of its lifetime.
+type Item[T any] struct {
+	Value   T
+	created time.Time
+}
+
+// Pool is a pool of reusable items, such as buffers or decoded objects that are expensive
+// to create.  Get lends out an idle item, or else a new one; Put gives the item back for
+// reuse.  Unlike sync.Pool, a Pool is typed, never drops idle items behind the back of its
+// user, and may limit the number, the idle time and the lifetime of its items.  The most
+// recently put items are reused first, so that the others may expire.  A Pool is safe for
+// concurrent use.
+type Pool[T any] struct {
+	mu          sync.Mutex
+	create      func() T
+	reset       func(value T) T
+	maxIdle     int
+	maxIdleTime time.Duration
+	maxLifetime time.Duration
+	// idle holds the idle items, from the least to the most recently put.
+	idle  []idleItem[T]
+	stats PoolStats
+	now   func() time.Time
+}
+
+type idleItem[T any] struct {
+	item      *Item[T]
+	idleSince time.Time
+}
+
+// NewPool creates an empty Pool, whose Get creates the values with create when no item
+// is idle.  It panics if create is nil, or if the limits of opts are negative.
+func NewPool[T any](create func() T, opts ...PoolOptions[T]) *Pool[T] {
+	var o PoolOptions[T]
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	switch {
+	case create == nil:
+		panic("NewPool: create must not be nil")
+	case o.MaxIdle < 0:
+		panic("NewPool: MaxIdle must not be negative")
+	case o.MaxIdleTime < 0:
+		panic("NewPool: MaxIdleTime must not be negative")
+	case o.MaxLifetime < 0:
+		panic("NewPool: MaxLifetime must not be negative")
+	}
+	return &Pool[T]{
+		create:      create,
+		reset:       o.Reset,
+		maxIdle:     o.MaxIdle,
+		maxIdleTime: o.MaxIdleTime,
+		maxLifetime: o.MaxLifetime,
+		now:         time.Now,
+	}
+}
+
+// Get removes and returns the most recently put idle item that has not expired, or else
+// a new item.  The new value is created without holding the lock of the pool.
+func (p *Pool[T]) Get() *Item[T] {
+	p.mu.Lock()
+	p.stats.Gets++
+	p.evictExpired()
+	if n := len(p.idle); n > 0 {
+		item := p.idle[n-1].item
+		// Release the reference held by the backing array.
+		p.idle[n-1] = idleItem[T]{}
+		p.idle = p.idle[:n-1]
+		p.stats.Hits++
+		p.mu.Unlock()
+		return item
+	}
+	p.mu.Unlock()
+
+	created := p.now()
+	return &Item[T]{Value: p.create(), created: created}
+}
+
+// Put resets the value of item and makes item idle, for reuse by Get.  item must have
+// been returned by Get of the same pool.  It is dropped if it was created longer than
+// MaxLifetime ago, or if the pool already has MaxIdle idle items.  The caller must not use
+// item afterwards.
+func (p *Pool[T]) Put(item *Item[T]) {
+	if p.reset != nil {
+		item.Value = p.reset(item.Value)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stats.Puts++
+	p.evictExpired()
+	now := p.now()
+	switch {
+	case p.maxLifetime > 0 && now.Sub(item.created) >= p.maxLifetime:
+		p.stats.Retired++
+		return
+	case p.maxIdle > 0 && len(p.idle) >= p.maxIdle:
+		p.stats.Dropped++
+		return
+	}
+	p.idle = append(p.idle, idleItem[T]{item: item, idleSince: now})
+}
+
+// EvictExpired evicts the idle items that have outlived MaxIdleTime or MaxLifetime, and
+// returns their number.  Get and Put also evict them, so calling EvictExpired only
+// releases their memory earlier.
+func (p *Pool[T]) EvictExpired() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.evictExpired()
+}
+
+// Stats returns the usage statistics of the pool.
+func (p *Pool[T]) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := p.stats
+	stats.Idle = len(p.idle)
+	return stats
+}
+
+// evictExpired evicts the expired idle items, and returns their number.  The lock must be
+// held.
+func (p *Pool[T]) evictExpired() int {
+	if len(p.idle) == 0 || p.maxIdleTime == 0 && p.maxLifetime == 0 {
+		return 0
+	}
+	now := p.now()
+	evicted := 0
+	if p.maxIdleTime > 0 {
+		// The idle items are sorted by idleSince.
+		deadline := now.Add(-p.maxIdleTime)
+		n := sort.Search(len(p.idle), func(i int) bool {
+			return p.idle[i].idleSince.After(deadline)
+		})
+		if n > 0 {
+			kept := copy(p.idle, p.idle[n:])
+			clear(p.idle[kept:])
+			p.idle = p.idle[:kept]
+			p.stats.Expired += uint64(n)
+			evicted += n
+		}
+	}
+	if p.maxLifetime > 0 {
+		kept := p.idle[:0]
+		for _, idle := range p.idle {
+			if now.Sub(idle.item.created) < p.maxLifetime {
+				kept = append(kept, idle)
+			}
+		}
+		n := len(p.idle) - len(kept)
+		clear(p.idle[len(kept):])
+		p.idle = kept
+		p.stats.Retired += uint64(n)
+		evicted += n
+	}
+	return evicted
+}
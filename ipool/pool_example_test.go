@@ -0,0 +1,26 @@
+package ipool
+
+import "fmt"
+
+func ExamplePool() {
+	buffers := NewPool(func() []byte {
+		return make([]byte, 0, 1024)
+	}, PoolOptions[[]byte]{
+		Reset:   func(buf []byte) []byte { return buf[:0] },
+		MaxIdle: 16,
+	})
+
+	buf := buffers.Get()
+	buf.Value = append(buf.Value, "first use"...)
+	buffers.Put(buf)
+
+	buf = buffers.Get()
+	fmt.Println(len(buf.Value), cap(buf.Value))
+
+	stats := buffers.Stats()
+	fmt.Println(stats.Gets, stats.Hits)
+
+	// Output:
+	// 0 1024
+	// 2 1
+}
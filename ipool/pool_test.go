@@ -0,0 +1,150 @@
+package ipool
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPool")
+
+	created := 0
+	p := NewPool(func() []byte {
+		created++
+		return make([]byte, 0, 64)
+	}, PoolOptions[[]byte]{
+		Reset:   func(buf []byte) []byte { return buf[:0] },
+		MaxIdle: 2,
+	})
+
+	a, b, c := p.Get(), p.Get(), p.Get()
+	assert.Equal(3, created)
+	a.Value = append(a.Value, "hello"...)
+	p.Put(a)
+	p.Put(b)
+	p.Put(c)
+
+	reused := p.Get()
+	assert.Equal(0, len(reused.Value))
+	assert.Equal(64, cap(reused.Value))
+	p.Get()
+	p.Get()
+	assert.Equal(4, created)
+
+	assert.Equal(PoolStats{Gets: 6, Hits: 2, Puts: 3, Dropped: 1}, p.Stats())
+
+	for _, f := range []func(){
+		func() { NewPool[int](nil) },
+		func() { NewPool(func() int { return 0 }, PoolOptions[int]{MaxIdle: -1}) },
+		func() { NewPool(func() int { return 0 }, PoolOptions[int]{MaxIdleTime: -1}) },
+		func() { NewPool(func() int { return 0 }, PoolOptions[int]{MaxLifetime: -1}) },
+	} {
+		func() {
+			defer func() {
+				assert.IsNotNil(recover())
+			}()
+			f()
+		}()
+	}
+}
+
+func TestPoolMaxIdleTime(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPoolMaxIdleTime")
+
+	now := time.Unix(0, 0)
+	next := 0
+	p := NewPool(func() int {
+		next++
+		return next
+	}, PoolOptions[int]{MaxIdleTime: time.Minute})
+	p.now = func() time.Time { return now }
+
+	a, b := p.Get(), p.Get()
+	p.Put(a)
+	now = now.Add(40 * time.Second)
+	p.Put(b)
+	now = now.Add(30 * time.Second)
+	assert.Equal(1, p.EvictExpired())
+	assert.Equal(1, p.Stats().Idle)
+
+	now = now.Add(30 * time.Second)
+	assert.Equal(3, p.Get().Value)
+	assert.Equal(PoolStats{Gets: 3, Puts: 2, Expired: 2}, p.Stats())
+
+	// The most recently put items are reused first.
+	c, d := p.Get(), p.Get()
+	p.Put(c)
+	p.Put(d)
+	assert.Equal(d.Value, p.Get().Value)
+	assert.Equal(c.Value, p.Get().Value)
+}
+
+func TestPoolMaxLifetime(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPoolMaxLifetime")
+
+	now := time.Unix(0, 0)
+	p := NewPool(func() []byte {
+		return make([]byte, 0, 4)
+	}, PoolOptions[[]byte]{
+		Reset:       func(buf []byte) []byte { return buf[:0] },
+		MaxLifetime: time.Minute,
+	})
+	p.now = func() time.Time { return now }
+
+	a := p.Get()
+	now = now.Add(40 * time.Second)
+	b := p.Get()
+	p.Put(b)
+	p.Put(a)
+
+	// The lifetime counts from the creation, not from the Put.
+	now = now.Add(30 * time.Second)
+	assert.ShouldBeTrue(p.Get() == b)
+	assert.Equal(PoolStats{Gets: 3, Hits: 1, Puts: 2, Retired: 1}, p.Stats())
+
+	// A value replaced while lent out keeps the lifetime of its item.
+	b.Value = append(b.Value, "grown past its capacity"...)
+	p.Put(b)
+	assert.Equal(1, p.Stats().Idle)
+	assert.ShouldBeTrue(p.Get() == b)
+
+	now = now.Add(30 * time.Second)
+	p.Put(b)
+	assert.Equal(PoolStats{Gets: 4, Hits: 2, Puts: 4, Retired: 2}, p.Stats())
+}
+
+func TestPoolConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPoolConcurrentUse")
+
+	p := NewPool(func() []int { return nil }, PoolOptions[[]int]{MaxIdle: 4})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				item := p.Get()
+				item.Value = append(item.Value[:0], i)
+				p.Put(item)
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := p.Stats()
+	assert.Equal(uint64(800), stats.Gets)
+	assert.Equal(uint64(800), stats.Puts)
+	assert.ShouldBeTrue(stats.Idle <= 4)
+	assert.Equal(stats.Gets-stats.Hits, uint64(stats.Idle)+stats.Dropped)
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iqueues
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/idichekop/gods/internal/ibinary"
+	islice "github.com/idichekop/gods/islices"
+)
+
+// MarshalBinary encodes the elements of the queue, from the front to the back.
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(q.All())
+}
+
+// UnmarshalBinary replaces the contents of the queue with the elements encoded by
+// MarshalBinary.
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	*q = Queue[T]{}
+	q.Enqueue(items...)
+	return nil
+}
+
+// MarshalBinary encodes the elements of the buffer, from the oldest to the newest.
+func (r *RingBuffer[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(r.All())
+}
+
+// UnmarshalBinary replaces the contents of the buffer with the elements encoded by
+// MarshalBinary.  As UnmarshalJSON, it keeps the capacity and overflow policy of the
+// buffer, which must have been created by NewRingBuffer.
+func (r *RingBuffer[T]) UnmarshalBinary(data []byte) error {
+	if r.buf == nil {
+		return errors.New("RingBuffer.UnmarshalBinary: buffer has no capacity")
+	}
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	if r.policy == RejectWhenFull && len(items) > len(r.buf) {
+		return fmt.Errorf("RingBuffer.UnmarshalBinary: %d elements for a capacity of %d", len(items), len(r.buf))
+	}
+	clear(r.buf)
+	r.head, r.count = 0, 0
+	for _, item := range items {
+		r.Write(item)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the elements of the queue, in heap order.
+func (pq *PriorityQueue[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(pq.All())
+}
+
+// UnmarshalBinary replaces the contents of the queue with the elements encoded by
+// MarshalBinary.  The handles of the previous elements become invalid.  The queue keeps
+// its comparator, so it must have been created by NewPriorityQueue or
+// NewPriorityQueueFunc.
+func (pq *PriorityQueue[T]) UnmarshalBinary(data []byte) error {
+	if pq.compare == nil {
+		return errors.New("PriorityQueue.UnmarshalBinary: queue has no comparator")
+	}
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	for _, h := range pq.heap {
+		h.index = -1
+	}
+	pq.heap = nil
+	for _, item := range items {
+		pq.Push(item)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the elements of the heap, in heap order.
+func (h *Heap[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(h.All())
+}
+
+// UnmarshalBinary replaces the contents of the heap with the elements encoded by
+// MarshalBinary.  The heap keeps its comparator, so it must have been created by NewHeap,
+// NewMinHeap, NewMaxHeap or HeapFrom.
+func (h *Heap[T]) UnmarshalBinary(data []byte) error {
+	if h.compare == nil {
+		return errors.New("Heap.UnmarshalBinary: heap has no comparator")
+	}
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	islice.Heapify(items, h.compare)
+	h.items = items
+	return nil
+}
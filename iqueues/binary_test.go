@@ -0,0 +1,60 @@
+package iqueues
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestQueueBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestQueueBinary")
+
+	q := NewQueue[int]()
+	q.Enqueue(1, 2, 3)
+	q.Dequeue()
+	data, err := q.MarshalBinary()
+	assert.IsNil(err)
+
+	var decoded Queue[int]
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal([]int{2, 3}, slices.Collect(decoded.All()))
+	assert.IsNotNil(decoded.UnmarshalBinary([]byte{1}))
+
+	r := NewRingBuffer[int](2, RejectWhenFull)
+	assert.IsNotNil(r.UnmarshalBinary(nil))
+	assert.IsNil(r.UnmarshalBinary(data))
+	assert.Equal([]int{2, 3}, r.Snapshot())
+	q.Enqueue(4)
+	data, _ = q.MarshalBinary()
+	assert.IsNotNil(r.UnmarshalBinary(data))
+}
+
+func TestPriorityQueueBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPriorityQueueBinary")
+
+	pq := NewPriorityQueue[int]()
+	for _, v := range []int{5, 1, 3} {
+		pq.Push(v)
+	}
+	data, err := pq.MarshalBinary()
+	assert.IsNil(err)
+
+	decoded := NewPriorityQueue[int]()
+	stale := decoded.Push(0)
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.ShouldBeFalse(stale.InQueue())
+	v, _ := decoded.Pop()
+	assert.Equal(1, v)
+
+	h := NewMaxHeap[int]()
+	assert.IsNil(h.UnmarshalBinary(data))
+	assert.Equal([]int{5, 3, 1}, slices.Collect(h.Drain()))
+
+	var zero Heap[int]
+	assert.IsNotNil(zero.UnmarshalBinary(data))
+}
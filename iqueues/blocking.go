@@ -0,0 +1,167 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iqueues
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// BlockingQueue is a bounded FIFO queue safe for concurrent use.  Put blocks while the
+// queue is full and Take while it is empty, until the context is done; TryPut and
+// TryTake never block.  Unlike a buffered channel, it also allows to Peek, to inspect its
+// length, and to drain it in one call.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	items    Queue[T]
+	capacity int
+	// changed is closed, and replaced, whenever an element is added or removed, to wake
+	// up the blocked callers.
+	changed chan struct{}
+}
+
+// NewBlockingQueue creates an empty BlockingQueue holding at most capacity elements.  It
+// panics if capacity is not positive.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic("NewBlockingQueue: capacity must be positive")
+	}
+	return &BlockingQueue[T]{capacity: capacity, changed: make(chan struct{})}
+}
+
+// Put adds item at the back of the queue, waiting for room if the queue is full.  It
+// returns the error of the context if it is done first; use context.WithTimeout for a
+// timeout.
+func (q *BlockingQueue[T]) Put(ctx context.Context, item T) error {
+	for {
+		q.mu.Lock()
+		if q.items.Len() < q.capacity {
+			q.items.Enqueue(item)
+			q.broadcast()
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Take removes and returns the front element, waiting for one if the queue is empty.  It
+// returns the error of the context if it is done first.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if item, ok := q.items.Dequeue(); ok {
+			q.broadcast()
+			q.mu.Unlock()
+			return item, nil
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// TryPut adds item at the back of the queue if there is room, and reports whether it did.
+func (q *BlockingQueue[T]) TryPut(item T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.items.Len() >= q.capacity {
+		return false
+	}
+	q.items.Enqueue(item)
+	q.broadcast()
+	return true
+}
+
+// TryTake removes and returns the front element, or false if the queue is empty.
+func (q *BlockingQueue[T]) TryTake() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, ok := q.items.Dequeue()
+	if ok {
+		q.broadcast()
+	}
+	return item, ok
+}
+
+// Peek returns the front element without removing it, or false if the queue is empty.
+func (q *BlockingQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Peek()
+}
+
+// DrainTo removes up to n elements, or all of them if n is not positive, without
+// blocking, appends them to dst and returns the extended slice.
+func (q *BlockingQueue[T]) DrainTo(dst []T, n int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	before := len(dst)
+	dst = q.items.DrainTo(dst, n)
+	if len(dst) > before {
+		q.broadcast()
+	}
+	return dst
+}
+
+// All returns an iterator over the elements, from the front to the back, without
+// removing them.  It iterates over a snapshot taken when the iteration starts, so the
+// loop body may use the queue.
+func (q *BlockingQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		q.mu.Lock()
+		snapshot := slices.Collect(q.items.All())
+		q.mu.Unlock()
+
+		for _, item := range snapshot {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, from the front to the back, without
+// removing them.  It iterates over a snapshot taken when the iteration starts, so the
+// loop body may use the queue.
+func (q *BlockingQueue[T]) Values() iter.Seq[T] {
+	return q.All()
+}
+
+// Len returns the number of elements.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// Capacity returns the maximum number of elements.
+func (q *BlockingQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// broadcast wakes up all the blocked callers.  It must be called with the lock held.
+func (q *BlockingQueue[T]) broadcast() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
@@ -0,0 +1,134 @@
+package iqueues
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBlockingQueue")
+
+	q := NewBlockingQueue[int](2)
+	assert.Equal(2, q.Capacity())
+
+	assert.ShouldBeTrue(q.TryPut(1))
+	assert.IsNil(q.Put(context.Background(), 2))
+	assert.ShouldBeFalse(q.TryPut(3))
+	assert.Equal(2, q.Len())
+
+	front, _ := q.Peek()
+	assert.Equal(1, front)
+
+	v, ok := q.TryTake()
+	assert.Equal(1, v)
+	assert.ShouldBeTrue(ok)
+
+	v, err := q.Take(context.Background())
+	assert.Equal(2, v)
+	assert.IsNil(err)
+
+	_, ok = q.TryTake()
+	assert.ShouldBeFalse(ok)
+
+	q.TryPut(4)
+	q.TryPut(5)
+	assert.Equal([]int{4, 5}, q.DrainTo(nil, 0))
+	assert.Equal(0, q.Len())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewBlockingQueue[int](0)
+}
+
+func TestBlockingQueueAll(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBlockingQueueAll")
+
+	q := NewBlockingQueue[int](3)
+	q.TryPut(1)
+	q.TryPut(2)
+
+	// The loop body may use the queue: All iterates over a snapshot.
+	var seen []int
+	for v := range q.All() {
+		seen = append(seen, v)
+		q.TryPut(v * 10)
+	}
+	assert.Equal([]int{1, 2}, seen)
+	assert.Equal(3, q.Len())
+}
+
+func TestBlockingQueueCancellation(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBlockingQueueCancellation")
+
+	q := NewBlockingQueue[string](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := q.Take(ctx)
+	assert.Equal(true, errors.Is(err, context.DeadlineExceeded))
+
+	q.TryPut("a")
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	err = q.Put(ctx, "b")
+	assert.Equal(true, errors.Is(err, context.Canceled))
+	assert.Equal(1, q.Len())
+}
+
+func TestBlockingQueueProducersConsumers(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBlockingQueueProducersConsumers")
+
+	q := NewBlockingQueue[int](4)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for p := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 250 {
+				if err := q.Put(ctx, p*1000+i); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+
+	consumers, stop := context.WithCancel(ctx)
+	defer stop()
+
+	results := make(chan int, 1000)
+	for range 3 {
+		go func() {
+			for {
+				v, err := q.Take(consumers)
+				if err != nil {
+					return
+				}
+				results <- v
+			}
+		}()
+	}
+
+	wg.Wait()
+	seen := map[int]bool{}
+	for range 1000 {
+		seen[<-results] = true
+	}
+	assert.Equal(1000, len(seen))
+	assert.LessOrEqual(q.Len(), q.Capacity())
+}
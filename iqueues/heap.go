@@ -0,0 +1,110 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iqueues
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/idichekop/gods/icompare"
+	islice "github.com/idichekop/gods/islices"
+	"golang.org/x/exp/constraints"
+)
+
+// Heap is a binary heap ordered by a comparator: Pop returns the smallest element.  It is
+// the typed counterpart of container/heap, without the interface boilerplate.  Unlike
+// PriorityQueue, it hands out no handles, so it is lighter when elements never need to
+// be updated or removed once pushed.  A Heap is not safe for concurrent use.
+type Heap[T any] struct {
+	items   []T
+	compare icompare.Comparator[T]
+}
+
+// NewHeap creates an empty Heap popping the smallest element first, according to the
+// comparator.
+func NewHeap[T any](compare icompare.Comparator[T]) *Heap[T] {
+	return &Heap[T]{compare: compare}
+}
+
+// NewMinHeap creates an empty Heap popping the smallest element first, in the natural
+// order of T.
+func NewMinHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(icompare.Natural[T]())
+}
+
+// NewMaxHeap creates an empty Heap popping the greatest element first, in the natural
+// order of T.
+func NewMaxHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(icompare.Reversed(icompare.Natural[T]()))
+}
+
+// HeapFrom creates a Heap holding a copy of items, in O(n).
+func HeapFrom[T any](items []T, compare icompare.Comparator[T]) *Heap[T] {
+	h := &Heap[T]{items: append([]T(nil), items...), compare: compare}
+	islice.Heapify(h.items, compare)
+	return h
+}
+
+// Len returns the number of elements.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty reports whether the heap has no elements.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Push adds the items to the heap, in O(log n) each.
+func (h *Heap[T]) Push(items ...T) {
+	for _, item := range items {
+		h.items = islice.HeapPush(h.items, item, h.compare)
+	}
+}
+
+// Peek returns the smallest element without removing it, or false if the heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.items[0], true
+}
+
+// Pop removes and returns the smallest element, or false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	var top T
+	top, h.items = islice.HeapPop(h.items, h.compare)
+	return top, true
+}
+
+// All returns an iterator over the elements, in no particular order, without removing
+// them.  Use Drain to visit them in order.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return slices.Values(h.items)
+}
+
+// Values returns an iterator over the elements, in no particular order, without removing
+// them.
+func (h *Heap[T]) Values() iter.Seq[T] {
+	return h.All()
+}
+
+// Drain returns an iterator that pops the elements in order, smallest first.  Stopping
+// the iteration early leaves the remaining elements in the heap.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !h.IsEmpty() {
+			top, _ := h.Pop()
+			if !yield(top) {
+				return
+			}
+		}
+	}
+}
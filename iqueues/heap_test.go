@@ -0,0 +1,70 @@
+package iqueues
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestHeap(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHeap")
+
+	h := NewMinHeap[int]()
+	assert.ShouldBeTrue(h.IsEmpty())
+	_, ok := h.Pop()
+	assert.ShouldBeFalse(ok)
+	_, ok = h.Peek()
+	assert.ShouldBeFalse(ok)
+
+	h.Push(5, 1, 4)
+	h.Push(2)
+	assert.Equal(4, h.Len())
+	top, ok := h.Peek()
+	assert.Equal(1, top)
+	assert.ShouldBeTrue(ok)
+
+	var first []int
+	for v := range h.Drain() {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+	assert.Equal([]int{1, 2}, first)
+	assert.Equal(2, h.Len())
+	assert.Equal([]int{4, 5}, slices.Collect(h.Drain()))
+
+	maxHeap := NewMaxHeap[string]()
+	maxHeap.Push("b", "c", "a")
+	v, _ := maxHeap.Pop()
+	assert.Equal("c", v)
+
+	byLength := NewHeap(func(a, b string) int { return len(a) - len(b) })
+	byLength.Push("ccc", "a", "bb")
+	v, _ = byLength.Pop()
+	assert.Equal("a", v)
+}
+
+func TestHeapFrom(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHeapFrom")
+
+	rng := rand.New(rand.NewSource(10))
+	items := make([]int, 500)
+	for i := range items {
+		items[i] = rng.Intn(100)
+	}
+	original := slices.Clone(items)
+
+	h := HeapFrom(items, func(a, b int) int { return a - b })
+	assert.Equal(len(items), h.Len())
+	assert.Equal(original, items)
+
+	slices.Sort(original)
+	assert.Equal(original, slices.Collect(h.Drain()))
+}
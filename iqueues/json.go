@@ -0,0 +1,106 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iqueues
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/idichekop/gods/internal/ijson"
+	islice "github.com/idichekop/gods/islices"
+)
+
+// MarshalJSON encodes the queue as a JSON array, from the front to the back.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(q.All())
+}
+
+// UnmarshalJSON replaces the contents of the queue with the elements of a JSON array,
+// enqueued in order.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	*q = Queue[T]{}
+	q.Enqueue(items...)
+	return nil
+}
+
+// MarshalJSON encodes the buffer as a JSON array, from the oldest to the newest element.
+func (r *RingBuffer[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(r.All())
+}
+
+// UnmarshalJSON replaces the contents of the buffer with the elements of a JSON array,
+// written in order.  The buffer keeps its capacity and overflow policy, so it must have
+// been created by NewRingBuffer.  When the array is longer than the capacity, an
+// OverwriteOldest buffer keeps its last elements, and a RejectWhenFull one returns an
+// error.
+func (r *RingBuffer[T]) UnmarshalJSON(data []byte) error {
+	if r.buf == nil {
+		return errors.New("RingBuffer.UnmarshalJSON: buffer has no capacity")
+	}
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	if r.policy == RejectWhenFull && len(items) > len(r.buf) {
+		return fmt.Errorf("RingBuffer.UnmarshalJSON: %d elements for a capacity of %d", len(items), len(r.buf))
+	}
+	clear(r.buf)
+	r.head, r.count = 0, 0
+	for _, item := range items {
+		r.Write(item)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the queue as a JSON array of its elements, in no particular order.
+func (pq *PriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(pq.All())
+}
+
+// UnmarshalJSON replaces the contents of the queue with the elements of a JSON array.
+// The handles of the previous elements become invalid.  The queue keeps its comparator,
+// so it must have been created by NewPriorityQueue or NewPriorityQueueFunc.
+func (pq *PriorityQueue[T]) UnmarshalJSON(data []byte) error {
+	if pq.compare == nil {
+		return errors.New("PriorityQueue.UnmarshalJSON: queue has no comparator")
+	}
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	for _, h := range pq.heap {
+		h.index = -1
+	}
+	pq.heap = nil
+	for _, item := range items {
+		pq.Push(item)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the heap as a JSON array of its elements, in no particular order.
+func (h *Heap[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(h.All())
+}
+
+// UnmarshalJSON replaces the contents of the heap with the elements of a JSON array, in
+// O(n).  The heap keeps its comparator, so it must have been created by NewHeap,
+// NewMinHeap, NewMaxHeap or HeapFrom.
+func (h *Heap[T]) UnmarshalJSON(data []byte) error {
+	if h.compare == nil {
+		return errors.New("Heap.UnmarshalJSON: heap has no comparator")
+	}
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	islice.Heapify(items, h.compare)
+	h.items = items
+	return nil
+}
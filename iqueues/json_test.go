@@ -0,0 +1,96 @@
+package iqueues
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestQueueJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestQueueJSON")
+
+	q := NewQueue[int]()
+	q.Enqueue(1, 2, 3)
+	q.Dequeue()
+	data, err := json.Marshal(q)
+	assert.IsNil(err)
+	assert.Equal("[2,3]", string(data))
+
+	var decoded Queue[int]
+	assert.IsNil(json.Unmarshal([]byte("[5,6,7]"), &decoded))
+	v, _ := decoded.Dequeue()
+	assert.Equal(5, v)
+	assert.Equal(2, decoded.Len())
+
+	data, _ = json.Marshal(NewQueue[int]())
+	assert.Equal("[]", string(data))
+}
+
+func TestRingBufferJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRingBufferJSON")
+
+	r := NewRingBuffer[int](3, OverwriteOldest)
+	for i := range 5 {
+		r.Write(i)
+	}
+	data, err := json.Marshal(r)
+	assert.IsNil(err)
+	assert.Equal("[2,3,4]", string(data))
+
+	assert.IsNil(json.Unmarshal([]byte("[1,2,3,4,5,6]"), r))
+	assert.Equal([]int{4, 5, 6}, r.Snapshot())
+
+	strict := NewRingBuffer[int](2, RejectWhenFull)
+	assert.IsNotNil(json.Unmarshal([]byte("[1,2,3]"), strict))
+	assert.IsNil(json.Unmarshal([]byte("[1,2]"), strict))
+	assert.Equal([]int{1, 2}, strict.Snapshot())
+
+	var zero RingBuffer[int]
+	assert.IsNotNil(json.Unmarshal([]byte("[1]"), &zero))
+}
+
+func TestPriorityQueueJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPriorityQueueJSON")
+
+	pq := NewPriorityQueue[int]()
+	stale := pq.Push(10)
+	assert.IsNil(json.Unmarshal([]byte("[3,1,2]"), pq))
+	assert.ShouldBeFalse(stale.InQueue())
+
+	data, err := json.Marshal(pq)
+	assert.IsNil(err)
+	var items []int
+	assert.IsNil(json.Unmarshal(data, &items))
+	assert.Equal([]int{1, 2, 3}, slices.Sorted(slices.Values(items)))
+
+	v, _ := pq.Pop()
+	assert.Equal(1, v)
+
+	var zero PriorityQueue[int]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
+
+func TestHeapJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHeapJSON")
+
+	h := NewMaxHeap[int]()
+	assert.IsNil(json.Unmarshal([]byte("[3,9,1,4]"), h))
+	assert.Equal([]int{9, 4, 3, 1}, slices.Collect(h.Drain()))
+
+	data, err := json.Marshal(HeapFrom([]int{2}, h.compare))
+	assert.IsNil(err)
+	assert.Equal("[2]", string(data))
+
+	var zero Heap[int]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iqueues
+
+import (
+	"iter"
+
+	"github.com/idichekop/gods/icompare"
+	"golang.org/x/exp/constraints"
+)
+
+// PriorityQueue is a binary heap ordered by a comparator: Pop returns the smallest
+// element.  Push returns a Handle, which allows to update or remove the element later in
+// O(log n), e.g. for the decrease-key step of Dijkstra's algorithm.  A PriorityQueue is
+// not safe for concurrent use.
+type PriorityQueue[T any] struct {
+	compare icompare.Comparator[T]
+	heap    []*Handle[T]
+}
+
+// Handle refers to an element pushed in a PriorityQueue.  It stays valid until the
+// element is popped or removed.
+type Handle[T any] struct {
+	value T
+	// index is the position in the heap, or -1 once the element left the queue.
+	index int
+}
+
+// Value returns the value of the element.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// InQueue reports whether the element is still in the queue.
+func (h *Handle[T]) InQueue() bool {
+	return h.index >= 0
+}
+
+// NewPriorityQueue creates an empty PriorityQueue popping the smallest element first, in
+// the natural order of T.  Use icompare.Reversed with NewPriorityQueueFunc for a max-heap.
+func NewPriorityQueue[T constraints.Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueueFunc(icompare.Natural[T]())
+}
+
+// NewPriorityQueueFunc creates an empty PriorityQueue popping the smallest element first,
+// according to the comparator.
+func NewPriorityQueueFunc[T any](compare icompare.Comparator[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{compare: compare}
+}
+
+// Len returns the number of elements.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.heap)
+}
+
+// IsEmpty reports whether the queue has no elements.
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.heap) == 0
+}
+
+// Push adds the value to the queue, and returns its handle.
+func (pq *PriorityQueue[T]) Push(value T) *Handle[T] {
+	h := &Handle[T]{value: value, index: len(pq.heap)}
+	pq.heap = append(pq.heap, h)
+	pq.up(h.index)
+	return h
+}
+
+// All returns an iterator over the elements, in no particular order, without removing
+// them.
+func (pq *PriorityQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, h := range pq.heap {
+			if !yield(h.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, in no particular order, without removing
+// them.
+func (pq *PriorityQueue[T]) Values() iter.Seq[T] {
+	return pq.All()
+}
+
+// Peek returns the smallest element without removing it, or false if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.heap) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.heap[0].value, true
+}
+
+// Pop removes and returns the smallest element, or false if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.heap) == 0 {
+		var zero T
+		return zero, false
+	}
+	h := pq.heap[0]
+	pq.removeAt(0)
+	return h.value, true
+}
+
+// Update sets the value of the element of the handle, and restores its position in the
+// queue.  It reports false, and does nothing, if the element is no longer in the queue.
+func (pq *PriorityQueue[T]) Update(h *Handle[T], value T) bool {
+	if !pq.owns(h) {
+		return false
+	}
+	h.value = value
+	if !pq.down(h.index) {
+		pq.up(h.index)
+	}
+	return true
+}
+
+// Remove removes the element of the handle from the queue.  It reports false if the
+// element is no longer in the queue.
+func (pq *PriorityQueue[T]) Remove(h *Handle[T]) bool {
+	if !pq.owns(h) {
+		return false
+	}
+	pq.removeAt(h.index)
+	return true
+}
+
+// owns reports whether the handle refers to an element of this queue.
+func (pq *PriorityQueue[T]) owns(h *Handle[T]) bool {
+	return h.index >= 0 && h.index < len(pq.heap) && pq.heap[h.index] == h
+}
+
+// removeAt removes the element at index i of the heap.
+func (pq *PriorityQueue[T]) removeAt(i int) {
+	last := len(pq.heap) - 1
+	removed := pq.heap[i]
+	if i != last {
+		pq.swap(i, last)
+	}
+	pq.heap[last] = nil
+	pq.heap = pq.heap[:last]
+	removed.index = -1
+
+	if i != last && !pq.down(i) {
+		pq.up(i)
+	}
+}
+
+// up moves the element at index i towards the root, until its parent is not greater.
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if pq.compare(pq.heap[i].value, pq.heap[parent].value) >= 0 {
+			return
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at index i towards the leaves, until its children are not
+// smaller.  It reports whether the element moved.
+func (pq *PriorityQueue[T]) down(i int) bool {
+	start := i
+	n := len(pq.heap)
+	for {
+		smallest := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < n && pq.compare(pq.heap[child].value, pq.heap[smallest].value) < 0 {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return i != start
+		}
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// swap exchanges the elements at indexes i and j, and updates their handles.
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.heap[i], pq.heap[j] = pq.heap[j], pq.heap[i]
+	pq.heap[i].index = i
+	pq.heap[j].index = j
+}
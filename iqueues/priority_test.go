@@ -0,0 +1,120 @@
+package iqueues
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/internal"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPriorityQueue")
+
+	pq := NewPriorityQueue[int]()
+	_, ok := pq.Pop()
+	assert.ShouldBeFalse(ok)
+	_, ok = pq.Peek()
+	assert.ShouldBeFalse(ok)
+
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		pq.Push(v)
+	}
+	assert.Equal(5, pq.Len())
+
+	top, _ := pq.Peek()
+	assert.Equal(1, top)
+
+	var popped []int
+	for !pq.IsEmpty() {
+		v, _ := pq.Pop()
+		popped = append(popped, v)
+	}
+	assert.Equal([]int{1, 1, 3, 4, 5}, popped)
+
+	maxHeap := NewPriorityQueueFunc(icompare.Reversed(icompare.Natural[string]()))
+	maxHeap.Push("b")
+	maxHeap.Push("c")
+	maxHeap.Push("a")
+	v, _ := maxHeap.Pop()
+	assert.Equal("c", v)
+}
+
+func TestPriorityQueueHandles(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPriorityQueueHandles")
+
+	pq := NewPriorityQueue[int]()
+	h10 := pq.Push(10)
+	h20 := pq.Push(20)
+	h30 := pq.Push(30)
+
+	// decrease key
+	assert.ShouldBeTrue(pq.Update(h30, 5))
+	top, _ := pq.Peek()
+	assert.Equal(5, top)
+	assert.Equal(5, h30.Value())
+
+	// increase key
+	assert.ShouldBeTrue(pq.Update(h30, 25))
+	top, _ = pq.Peek()
+	assert.Equal(10, top)
+
+	assert.ShouldBeTrue(pq.Remove(h20))
+	assert.ShouldBeFalse(h20.InQueue())
+	assert.ShouldBeFalse(pq.Remove(h20))
+	assert.ShouldBeFalse(pq.Update(h20, 1))
+
+	v, _ := pq.Pop()
+	assert.Equal(10, v)
+	assert.ShouldBeFalse(h10.InQueue())
+	assert.ShouldBeTrue(h30.InQueue())
+
+	other := NewPriorityQueue[int]()
+	assert.ShouldBeFalse(other.Remove(h30))
+	assert.Equal(1, pq.Len())
+}
+
+func TestPriorityQueueRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPriorityQueueRandomized")
+
+	rng := rand.New(rand.NewSource(3))
+	pq := NewPriorityQueue[int]()
+	var handles []*Handle[int]
+
+	for range 2000 {
+		switch rng.Intn(4) {
+		case 0, 1:
+			handles = append(handles, pq.Push(rng.Intn(1000)))
+		case 2:
+			if len(handles) > 0 {
+				pq.Update(handles[rng.Intn(len(handles))], rng.Intn(1000))
+			}
+		case 3:
+			if len(handles) > 0 {
+				pq.Remove(handles[rng.Intn(len(handles))])
+			}
+		}
+	}
+
+	var expected []int
+	for _, h := range handles {
+		if h.InQueue() {
+			expected = append(expected, h.Value())
+		}
+	}
+	slices.Sort(expected)
+
+	var popped []int
+	for !pq.IsEmpty() {
+		v, _ := pq.Pop()
+		popped = append(popped, v)
+	}
+	assert.Equal(expected, popped)
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package iqueues implements generic queues.
+package iqueues
+
+import "iter"
+
+// minQueueCapacity is the smallest capacity of the ring buffer of a Queue.
+const minQueueCapacity = 8
+
+// Queue is a first-in first-out collection, backed by a circular buffer which grows when
+// full and shrinks when mostly empty.  Unlike the slice = slice[1:] idiom, dequeued
+// elements never stay reachable from the backing array.  A Queue is not safe for
+// concurrent use.
+type Queue[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// NewQueue creates an empty queue.
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// Enqueue adds the items at the back of the queue, in order.
+func (q *Queue[T]) Enqueue(items ...T) {
+	if q.count+len(items) > len(q.buf) {
+		q.resize(max(minQueueCapacity, 2*len(q.buf), q.count+len(items)))
+	}
+	for _, item := range items {
+		q.buf[(q.head+q.count)%len(q.buf)] = item
+		q.count++
+	}
+}
+
+// Dequeue removes and returns the front element, or false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.count == 0 {
+		return zero, false
+	}
+
+	item := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.count--
+
+	if len(q.buf) > minQueueCapacity && q.count <= len(q.buf)/4 {
+		q.resize(len(q.buf) / 2)
+	}
+	return item, true
+}
+
+// Peek returns the front element without removing it, or false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.count == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.buf[q.head], true
+}
+
+// DrainTo dequeues up to n elements, or all of them if n is not positive, appends them to
+// dst and returns the extended slice.
+func (q *Queue[T]) DrainTo(dst []T, n int) []T {
+	if n <= 0 || n > q.count {
+		n = q.count
+	}
+	for range n {
+		item, _ := q.Dequeue()
+		dst = append(dst, item)
+	}
+	return dst
+}
+
+// Len returns the number of elements.
+func (q *Queue[T]) Len() int {
+	return q.count
+}
+
+// IsEmpty reports whether the queue has no elements.
+func (q *Queue[T]) IsEmpty() bool {
+	return q.count == 0
+}
+
+// All returns an iterator over the elements, from the front to the back of the queue,
+// without removing them.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range q.count {
+			if !yield(q.buf[(q.head+i)%len(q.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, from the front to the back of the queue,
+// without removing them.
+func (q *Queue[T]) Values() iter.Seq[T] {
+	return q.All()
+}
+
+// resize moves the elements to a new buffer of the given capacity, starting at 0.
+func (q *Queue[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if q.count > 0 {
+		if q.head+q.count <= len(q.buf) {
+			copy(buf, q.buf[q.head:q.head+q.count])
+		} else {
+			n := copy(buf, q.buf[q.head:])
+			copy(buf[n:], q.buf[:q.count-n])
+		}
+	}
+	q.buf = buf
+	q.head = 0
+}
@@ -0,0 +1,101 @@
+package iqueues
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func ExampleQueue() {
+	jobs := NewQueue[string]()
+
+	jobs.Enqueue("resize", "upload", "notify", "cleanup")
+
+	first, _ := jobs.Dequeue()
+	fmt.Println(first)
+
+	batch := jobs.DrainTo(nil, 2)
+	fmt.Println(batch, jobs.Len())
+
+	// Output:
+	// resize
+	// [upload notify] 1
+}
+
+func ExamplePriorityQueue() {
+	type node struct {
+		name string
+		dist int
+	}
+
+	pq := NewPriorityQueueFunc(func(a, b node) int { return a.dist - b.dist })
+
+	pq.Push(node{"a", 7})
+	b := pq.Push(node{"b", 9})
+	pq.Push(node{"c", 4})
+
+	// a shorter path to b was found
+	pq.Update(b, node{"b", 2})
+
+	for !pq.IsEmpty() {
+		n, _ := pq.Pop()
+		fmt.Println(n.name, n.dist)
+	}
+
+	// Output:
+	// b 2
+	// c 4
+	// a 7
+}
+
+func ExampleBlockingQueue() {
+	q := NewBlockingQueue[string](1)
+
+	go func() {
+		q.Put(context.Background(), "ping")
+	}()
+
+	msg, err := q.Take(context.Background())
+	fmt.Println(msg, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err = q.Take(ctx)
+	fmt.Println(err)
+
+	// Output:
+	// ping <nil>
+	// context deadline exceeded
+}
+
+func ExampleRingBuffer() {
+	lastLines := NewRingBuffer[string](3, OverwriteOldest)
+
+	for _, line := range []string{"boot", "listen :8080", "GET /", "GET /health", "shutdown"} {
+		lastLines.Write(line)
+	}
+
+	for line := range lastLines.All() {
+		fmt.Println(line)
+	}
+
+	// Output:
+	// GET /
+	// GET /health
+	// shutdown
+}
+
+func ExampleHeap() {
+	tasks := NewMaxHeap[int]()
+	tasks.Push(3, 9, 1, 7)
+
+	for priority := range tasks.Drain() {
+		fmt.Println(priority)
+	}
+
+	// Output:
+	// 9
+	// 7
+	// 3
+	// 1
+}
@@ -0,0 +1,105 @@
+package iqueues
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestQueue")
+
+	q := NewQueue[int]()
+	assert.ShouldBeTrue(q.IsEmpty())
+	_, ok := q.Dequeue()
+	assert.ShouldBeFalse(ok)
+	_, ok = q.Peek()
+	assert.ShouldBeFalse(ok)
+
+	q.Enqueue(1, 2, 3)
+	front, _ := q.Peek()
+	assert.Equal(1, front)
+	assert.Equal(3, q.Len())
+
+	v, ok := q.Dequeue()
+	assert.Equal(1, v)
+	assert.ShouldBeTrue(ok)
+
+	q.Enqueue(4)
+	assert.Equal([]int{2, 3, 4}, slices.Collect(q.All()))
+}
+
+func TestQueueWrapAroundAndResize(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestQueueWrapAroundAndResize")
+
+	q := NewQueue[int]()
+	next, expected := 0, 0
+
+	// interleave enqueues and dequeues so that the elements wrap around the buffer
+	for round := range 50 {
+		for range round % 7 {
+			q.Enqueue(next)
+			next++
+		}
+		for range round % 5 {
+			if v, ok := q.Dequeue(); ok {
+				assert.Equal(expected, v)
+				expected++
+			}
+		}
+	}
+	assert.Equal(next-expected, q.Len())
+
+	bulk := make([]int, 1000)
+	for i := range bulk {
+		bulk[i] = next + i
+	}
+	q.Enqueue(bulk...)
+	next += len(bulk)
+
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		assert.Equal(expected, v)
+		expected++
+	}
+	assert.Equal(next, expected)
+	assert.Equal(minQueueCapacity, len(q.buf))
+}
+
+func TestQueueDrainTo(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestQueueDrainTo")
+
+	q := NewQueue[string]()
+	q.Enqueue("a", "b", "c", "d")
+
+	batch := q.DrainTo(nil, 3)
+	assert.Equal([]string{"a", "b", "c"}, batch)
+	assert.Equal(1, q.Len())
+
+	q.Enqueue("e")
+	batch = q.DrainTo(batch[:0], 0)
+	assert.Equal([]string{"d", "e"}, batch)
+	assert.ShouldBeTrue(q.IsEmpty())
+
+	assert.Equal(0, len(q.DrainTo(nil, 5)))
+}
+
+func TestQueueDequeueReleasesReferences(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestQueueDequeueReleasesReferences")
+
+	q := NewQueue[*int]()
+	v := 1
+	q.Enqueue(&v, &v)
+	q.Dequeue()
+
+	assert.IsNil(q.buf[0])
+}
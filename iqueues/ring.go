@@ -0,0 +1,109 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iqueues
+
+import "iter"
+
+// OverflowPolicy tells what a RingBuffer does with a write when it is full.
+type OverflowPolicy int
+
+const (
+	// RejectWhenFull drops the new element.
+	RejectWhenFull OverflowPolicy = iota
+	// OverwriteOldest drops the oldest element to make room for the new one.
+	OverwriteOldest
+)
+
+// RingBuffer is a FIFO buffer of fixed capacity, e.g. to retain the last N log lines or
+// metric samples.  Its storage is allocated once, by NewRingBuffer.  A RingBuffer is not
+// safe for concurrent use.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int
+	count  int
+	policy OverflowPolicy
+}
+
+// NewRingBuffer creates an empty RingBuffer of the given capacity and overflow policy.  It
+// panics if capacity is not positive.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity < 1 {
+		panic("NewRingBuffer: capacity must be positive")
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity), policy: policy}
+}
+
+// Write adds item as the newest element.  When the buffer is full, it applies the
+// overflow policy, and reports false if the item was rejected.
+func (r *RingBuffer[T]) Write(item T) bool {
+	if r.count == len(r.buf) {
+		if r.policy == RejectWhenFull {
+			return false
+		}
+		r.buf[r.head] = item
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+
+	r.buf[(r.head+r.count)%len(r.buf)] = item
+	r.count++
+	return true
+}
+
+// Read removes and returns the oldest element, or false if the buffer is empty.
+func (r *RingBuffer[T]) Read() (T, bool) {
+	var zero T
+	if r.count == 0 {
+		return zero, false
+	}
+
+	item := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.count--
+	return item, true
+}
+
+// Len returns the number of elements.
+func (r *RingBuffer[T]) Len() int {
+	return r.count
+}
+
+// Capacity returns the maximum number of elements.
+func (r *RingBuffer[T]) Capacity() int {
+	return len(r.buf)
+}
+
+// IsFull reports whether the buffer holds Capacity elements.
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.count == len(r.buf)
+}
+
+// Snapshot returns a copy of the elements, from the oldest to the newest.
+func (r *RingBuffer[T]) Snapshot() []T {
+	result := make([]T, 0, r.count)
+	for item := range r.All() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// All returns an iterator over the elements, from the oldest to the newest, without
+// removing them.
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range r.count {
+			if !yield(r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, from the oldest to the newest, without
+// removing them.
+func (r *RingBuffer[T]) Values() iter.Seq[T] {
+	return r.All()
+}
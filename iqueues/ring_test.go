@@ -0,0 +1,59 @@
+package iqueues
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRingBufferOverwrite")
+
+	r := NewRingBuffer[int](3, OverwriteOldest)
+	assert.Equal(3, r.Capacity())
+	assert.Equal([]int{}, r.Snapshot())
+
+	for i := 1; i <= 5; i++ {
+		assert.ShouldBeTrue(r.Write(i))
+	}
+	assert.ShouldBeTrue(r.IsFull())
+	assert.Equal([]int{3, 4, 5}, r.Snapshot())
+
+	v, ok := r.Read()
+	assert.Equal(3, v)
+	assert.ShouldBeTrue(ok)
+	assert.Equal(2, r.Len())
+
+	r.Write(6)
+	r.Write(7)
+	assert.Equal([]int{5, 6, 7}, r.Snapshot())
+
+	for range 3 {
+		r.Read()
+	}
+	_, ok = r.Read()
+	assert.ShouldBeFalse(ok)
+}
+
+func TestRingBufferReject(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRingBufferReject")
+
+	r := NewRingBuffer[string](2, RejectWhenFull)
+	assert.ShouldBeTrue(r.Write("a"))
+	assert.ShouldBeTrue(r.Write("b"))
+	assert.ShouldBeFalse(r.Write("c"))
+	assert.Equal([]string{"a", "b"}, r.Snapshot())
+
+	r.Read()
+	assert.ShouldBeTrue(r.Write("c"))
+	assert.Equal([]string{"b", "c"}, r.Snapshot())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewRingBuffer[string](0, RejectWhenFull)
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iseq
+
+import "github.com/idichekop/gods/internal/ibinary"
+
+// MarshalBinary encodes the elements of the vector.
+func (v PVector[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(v.Values())
+}
+
+// UnmarshalBinary sets v to a new PVector holding the elements encoded by MarshalBinary.
+// The previous versions are left untouched.
+func (v *PVector[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	*v = NewPVector(items...)
+	return nil
+}
+
+// MarshalBinary encodes the elements of the rope.
+func (r *Rope[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(r.Values())
+}
+
+// UnmarshalBinary replaces the contents of the rope with the elements encoded by
+// MarshalBinary.
+func (r *Rope[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	r.root = ropeBuild(items)
+	return nil
+}
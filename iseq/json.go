@@ -0,0 +1,38 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iseq
+
+import "github.com/idichekop/gods/internal/ijson"
+
+// MarshalJSON encodes the vector as a JSON array.
+func (v PVector[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(v.Values())
+}
+
+// UnmarshalJSON sets v to a new PVector holding the elements of a JSON array.  The
+// previous versions are left untouched.
+func (v *PVector[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	*v = NewPVector(items...)
+	return nil
+}
+
+// MarshalJSON encodes the rope as a JSON array of its elements.
+func (r *Rope[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(r.Values())
+}
+
+// UnmarshalJSON replaces the contents of the rope with the elements of a JSON array.
+func (r *Rope[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	r.root = ropeBuild(items)
+	return nil
+}
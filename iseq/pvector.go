@@ -0,0 +1,228 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package iseq implements generic sequences: a persistent vector, and a rope for
+// editing very large sequences.
+package iseq
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+const (
+	pvBits  = 5
+	pvWidth = 1 << pvBits
+	pvMask  = pvWidth - 1
+)
+
+// PVector is a persistent (immutable) vector.  Set and Append return a new version and
+// leave the receiver unchanged; the versions share all but the O(log32 n) nodes on the
+// path to the changed element, so keeping many snapshots of a large vector costs little
+// memory.  Get, Set and Append run in O(log32 n), effectively constant time.
+//
+// The elements are stored in a trie with 32-way branching, plus a tail holding the last
+// up to 32 elements, as the vectors of Clojure.  The zero PVector is empty and ready to
+// use.  A PVector is safe for concurrent use.
+type PVector[T any] struct {
+	length int
+	// shift is the number of index bits below the root: pvBits times the height of the
+	// trie.
+	shift int
+	root  *pvNode[T]
+	tail  []T
+}
+
+// pvNode is a node of the trie of a PVector: an inner node holds children, a leaf holds
+// pvWidth values.  Nodes are never modified once they are part of a PVector.
+type pvNode[T any] struct {
+	children []*pvNode[T]
+	values   []T
+}
+
+// NewPVector creates a PVector holding a copy of the given items, in O(n).
+func NewPVector[T any](items ...T) PVector[T] {
+	v := PVector[T]{length: len(items)}
+	if len(items) == 0 {
+		return v
+	}
+
+	offset := v.tailOffset()
+	v.tail = slices.Clone(items[offset:])
+	if offset == 0 {
+		return v
+	}
+
+	nodes := make([]*pvNode[T], 0, offset/pvWidth)
+	for i := 0; i < offset; i += pvWidth {
+		nodes = append(nodes, &pvNode[T]{values: slices.Clone(items[i : i+pvWidth])})
+	}
+	v.shift = pvBits
+	for len(nodes) > pvWidth {
+		parents := make([]*pvNode[T], 0, (len(nodes)+pvMask)/pvWidth)
+		for chunk := range slices.Chunk(nodes, pvWidth) {
+			parents = append(parents, &pvNode[T]{children: chunk})
+		}
+		nodes = parents
+		v.shift += pvBits
+	}
+	v.root = &pvNode[T]{children: nodes}
+	return v
+}
+
+// Len returns the number of elements.
+func (v PVector[T]) Len() int {
+	return v.length
+}
+
+// Get returns the element at index.  It panics if index is out of range.
+func (v PVector[T]) Get(index int) T {
+	v.checkIndex("Get", index)
+	if offset := v.tailOffset(); index >= offset {
+		return v.tail[index-offset]
+	}
+	return v.leaf(index).values[index&pvMask]
+}
+
+// Set returns a new version of the vector with the element at index set to value.  It
+// panics if index is out of range.
+func (v PVector[T]) Set(index int, value T) PVector[T] {
+	v.checkIndex("Set", index)
+	if offset := v.tailOffset(); index >= offset {
+		v.tail = slices.Clone(v.tail)
+		v.tail[index-offset] = value
+		return v
+	}
+	v.root = v.set(v.root, v.shift, index, value)
+	return v
+}
+
+func (v PVector[T]) set(n *pvNode[T], level int, index int, value T) *pvNode[T] {
+	if level == 0 {
+		values := slices.Clone(n.values)
+		values[index&pvMask] = value
+		return &pvNode[T]{values: values}
+	}
+	children := slices.Clone(n.children)
+	i := (index >> level) & pvMask
+	children[i] = v.set(children[i], level-pvBits, index, value)
+	return &pvNode[T]{children: children}
+}
+
+// Append returns a new version of the vector with value added at the end.
+func (v PVector[T]) Append(value T) PVector[T] {
+	if len(v.tail) < pvWidth {
+		tail := make([]T, len(v.tail)+1)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = value
+		v.tail = tail
+		v.length++
+		return v
+	}
+
+	// The tail is full: it becomes a leaf of the trie.
+	leaf := &pvNode[T]{values: v.tail}
+	switch {
+	case v.root == nil:
+		v.root = &pvNode[T]{children: []*pvNode[T]{leaf}}
+		v.shift = pvBits
+	case v.length>>pvBits > 1<<v.shift:
+		// The trie is full: it grows a level.
+		v.root = &pvNode[T]{children: []*pvNode[T]{v.root, pvPath(v.shift, leaf)}}
+		v.shift += pvBits
+	default:
+		v.root = v.pushLeaf(v.root, v.shift, leaf)
+	}
+	v.tail = []T{value}
+	v.length++
+	return v
+}
+
+// pushLeaf returns a copy of n, at the given level, with leaf added after its last leaf.
+func (v PVector[T]) pushLeaf(n *pvNode[T], level int, leaf *pvNode[T]) *pvNode[T] {
+	i := ((v.length - 1) >> level) & pvMask
+	children := make([]*pvNode[T], max(len(n.children), i+1))
+	copy(children, n.children)
+	switch {
+	case level == pvBits:
+		children[i] = leaf
+	case i < len(n.children):
+		children[i] = v.pushLeaf(n.children[i], level-pvBits, leaf)
+	default:
+		children[i] = pvPath(level-pvBits, leaf)
+	}
+	return &pvNode[T]{children: children}
+}
+
+// pvPath returns the chain of inner nodes leading from the given level down to leaf.
+func pvPath[T any](level int, leaf *pvNode[T]) *pvNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &pvNode[T]{children: []*pvNode[T]{pvPath(level-pvBits, leaf)}}
+}
+
+// All returns an iterator over the index and value of each element.
+func (v PVector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		offset := v.tailOffset()
+		for start := 0; start < offset; start += pvWidth {
+			for i, value := range v.leaf(start).values {
+				if !yield(start+i, value) {
+					return
+				}
+			}
+		}
+		for i, value := range v.tail {
+			if !yield(offset+i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the indexes, in ascending order.
+func (v PVector[T]) Keys() iter.Seq[int] {
+	return icontainer.Keys(v.All())
+}
+
+// Values returns an iterator over the elements, in index order.
+func (v PVector[T]) Values() iter.Seq[T] {
+	return icontainer.Values(v.All())
+}
+
+// ToSlice returns a copy of the elements.
+func (v PVector[T]) ToSlice() []T {
+	result := make([]T, 0, v.length)
+	for _, value := range v.All() {
+		result = append(result, value)
+	}
+	return result
+}
+
+// tailOffset returns the index of the first element of the tail.
+func (v PVector[T]) tailOffset() int {
+	if v.length < pvWidth {
+		return 0
+	}
+	return ((v.length - 1) >> pvBits) << pvBits
+}
+
+// leaf returns the leaf of the trie holding the element at index.
+func (v PVector[T]) leaf(index int) *pvNode[T] {
+	n := v.root
+	for level := v.shift; level > 0; level -= pvBits {
+		n = n.children[(index>>level)&pvMask]
+	}
+	return n
+}
+
+func (v PVector[T]) checkIndex(method string, index int) {
+	if index < 0 || index >= v.length {
+		panic(fmt.Sprintf("PVector.%s: index %d out of range [0, %d)", method, index, v.length))
+	}
+}
@@ -0,0 +1,112 @@
+package iseq
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestPVector(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPVector")
+
+	var zero PVector[int]
+	assert.Equal(0, zero.Len())
+	assert.Equal([]int{}, zero.ToSlice())
+	one := zero.Append(1)
+	assert.Equal(1, one.Get(0))
+	assert.Equal(0, zero.Len())
+
+	for _, n := range []int{0, 1, 31, 32, 33, 64, 1024, 1056, 1057, 32*32*32 + 33} {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = i * 3
+		}
+		built := NewPVector(items...)
+		var appended PVector[int]
+		for _, item := range items {
+			appended = appended.Append(item)
+		}
+		assert.Equal(items, built.ToSlice())
+		assert.Equal(items, appended.ToSlice())
+		assert.Equal(n, built.Len())
+
+		// Both vectors keep growing the same way.
+		built, appended = built.Append(-1), appended.Append(-1)
+		assert.Equal(built.ToSlice(), appended.ToSlice())
+		assert.Equal(-1, built.Get(n))
+	}
+
+	v := NewPVector(1, 2, 3)
+	for i, value := range v.All() {
+		if i == 1 {
+			assert.Equal(2, value)
+			break
+		}
+	}
+	assert.Equal([]int{0, 1, 2}, slices.Collect(v.Keys()))
+	assert.Equal([]int{1, 2, 3}, slices.Collect(v.Values()))
+
+	data, err := json.Marshal(v)
+	assert.IsNil(err)
+	assert.Equal("[1,2,3]", string(data))
+	var decoded PVector[int]
+	assert.IsNil(json.Unmarshal(data, &decoded))
+	assert.Equal([]int{1, 2, 3}, decoded.ToSlice())
+	data, err = v.Append(4).MarshalBinary()
+	assert.IsNil(err)
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal([]int{1, 2, 3, 4}, decoded.ToSlice())
+
+	defer func() {
+		assert.Equal("PVector.Get: index 3 out of range [0, 3)", recover())
+	}()
+	v.Get(3)
+}
+
+func TestPVectorVersions(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPVectorVersions")
+
+	rng := rand.New(rand.NewSource(7))
+	var versions []PVector[int]
+	var expected [][]int
+
+	var v PVector[int]
+	var reference []int
+	for range 5000 {
+		if len(reference) > 0 && rng.Intn(3) == 0 {
+			i := rng.Intn(len(reference))
+			value := rng.Int()
+			v = v.Set(i, value)
+			reference = slices.Clone(reference)
+			reference[i] = value
+		} else {
+			value := rng.Int()
+			v = v.Append(value)
+			reference = append(slices.Clip(reference), value)
+		}
+		if rng.Intn(50) == 0 {
+			versions = append(versions, v)
+			expected = append(expected, reference)
+		}
+	}
+
+	// Every snapshot still holds its own elements.
+	for i, version := range versions {
+		assert.Equal(expected[i], version.ToSlice())
+	}
+	for i := range reference {
+		assert.Equal(reference[i], v.Get(i))
+	}
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	v.Set(-1, 0)
+}
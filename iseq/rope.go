@@ -0,0 +1,307 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package iseq
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// ropeChunk is the largest number of elements held by a leaf of a Rope.
+const ropeChunk = 128
+
+// Rope is a sequence for editing very large sequences, such as texts or event logs.  Insert,
+// Delete, Split and Concat run in O(log n) plus the number of elements inserted, where
+// islice.InsertAt and islice.DeleteRange copy the whole slice.  Get runs in O(log n).
+//
+// The elements are stored in chunks of up to 128 at the leaves of an AVL tree.  The nodes
+// are never modified once built, so Clone is O(1) and the clones share all their nodes.
+// The zero Rope is empty and ready to use.  A Rope is not safe for concurrent use.
+type Rope[T any] struct {
+	root *ropeNode[T]
+}
+
+// ropeNode is a node of the tree of a Rope: a leaf holds elements, an inner node holds
+// two non-nil children.
+type ropeNode[T any] struct {
+	left, right *ropeNode[T]
+	items       []T
+	length      int
+	height      int
+}
+
+// NewRope creates a Rope holding a copy of the given items, in O(n).
+func NewRope[T any](items ...T) *Rope[T] {
+	return &Rope[T]{root: ropeBuild(items)}
+}
+
+// Len returns the number of elements.
+func (r *Rope[T]) Len() int {
+	return r.root.len()
+}
+
+// Get returns the element at index.  It panics if index is out of range.
+func (r *Rope[T]) Get(index int) T {
+	r.checkIndex("Get", index)
+	n := r.root
+	for n.left != nil {
+		if index < n.left.length {
+			n = n.left
+		} else {
+			index -= n.left.length
+			n = n.right
+		}
+	}
+	return n.items[index]
+}
+
+// Insert inserts items before the element at index, or at the end if index is Len().  It
+// panics if index is out of range.
+func (r *Rope[T]) Insert(index int, items ...T) {
+	if index < 0 || index > r.Len() {
+		panic(fmt.Sprintf("Rope.Insert: index %d out of range [0, %d]", index, r.Len()))
+	}
+	switch {
+	case len(items) == 0:
+	case r.root == nil:
+		r.root = ropeBuild(items)
+	case len(items) <= ropeChunk:
+		// Few items go into the leaf at index, which keeps the leaves large.
+		r.root = r.root.insert(index, items)
+	default:
+		left, right := r.root.split(index)
+		r.root = ropeJoin(ropeJoin(left, ropeBuild(items)), right)
+	}
+}
+
+// Append adds items at the end of the rope.
+func (r *Rope[T]) Append(items ...T) {
+	r.Insert(r.Len(), items...)
+}
+
+// Delete removes the elements from start to end (excluded).  It panics unless
+// 0 <= start <= end <= Len().
+func (r *Rope[T]) Delete(start, end int) {
+	r.checkRange("Delete", start, end)
+	left, rest := r.root.split(start)
+	_, right := rest.split(end - start)
+	r.root = ropeJoin(left, right)
+}
+
+// Split cuts the rope at index: the receiver keeps the elements before index, and the
+// returned rope holds the others.  It panics if index is out of range [0, Len()].
+func (r *Rope[T]) Split(index int) *Rope[T] {
+	r.checkRange("Split", index, r.Len())
+	left, right := r.root.split(index)
+	r.root = left
+	return &Rope[T]{root: right}
+}
+
+// Concat appends the elements of other to the rope, in O(log n).  other is unchanged: the
+// ropes share the nodes of other.
+func (r *Rope[T]) Concat(other *Rope[T]) {
+	r.root = ropeJoin(r.root, other.root)
+}
+
+// Slice returns a copy of the elements from start to end (excluded).  It panics unless
+// 0 <= start <= end <= Len().
+func (r *Rope[T]) Slice(start, end int) []T {
+	r.checkRange("Slice", start, end)
+	result := make([]T, 0, end-start)
+	r.root.collect(start, end, func(chunk []T) bool {
+		result = append(result, chunk...)
+		return true
+	})
+	return result
+}
+
+// Clone returns a copy of the rope, in O(1).
+func (r *Rope[T]) Clone() *Rope[T] {
+	return &Rope[T]{root: r.root}
+}
+
+// Chunks returns an iterator over the elements in order, by chunks of consecutive
+// elements.  The chunks are shared with the rope: they must not be modified.
+func (r *Rope[T]) Chunks() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		r.root.collect(0, r.Len(), yield)
+	}
+}
+
+// All returns an iterator over the index and value of each element.
+func (r *Rope[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for chunk := range r.Chunks() {
+			for _, item := range chunk {
+				if !yield(i, item) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the indexes, in ascending order.
+func (r *Rope[T]) Keys() iter.Seq[int] {
+	return icontainer.Keys(r.All())
+}
+
+// Values returns an iterator over the elements, in index order.
+func (r *Rope[T]) Values() iter.Seq[T] {
+	return icontainer.Values(r.All())
+}
+
+// ToSlice returns a copy of the elements.
+func (r *Rope[T]) ToSlice() []T {
+	return r.Slice(0, r.Len())
+}
+
+func (r *Rope[T]) checkIndex(method string, index int) {
+	if index < 0 || index >= r.Len() {
+		panic(fmt.Sprintf("Rope.%s: index %d out of range [0, %d)", method, index, r.Len()))
+	}
+}
+
+func (r *Rope[T]) checkRange(method string, start, end int) {
+	if start < 0 || start > end || end > r.Len() {
+		panic(fmt.Sprintf("Rope.%s: range [%d, %d) out of range [0, %d]", method, start, end, r.Len()))
+	}
+}
+
+func (n *ropeNode[T]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+func ropeLeaf[T any](items []T) *ropeNode[T] {
+	return &ropeNode[T]{items: items, length: len(items)}
+}
+
+func ropeInner[T any](left, right *ropeNode[T]) *ropeNode[T] {
+	return &ropeNode[T]{
+		left:   left,
+		right:  right,
+		length: left.length + right.length,
+		height: max(left.height, right.height) + 1,
+	}
+}
+
+// ropeBuild returns a balanced tree holding a copy of items, or nil if there are none.
+func ropeBuild[T any](items []T) *ropeNode[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	leaves := make([]*ropeNode[T], 0, (len(items)+ropeChunk-1)/ropeChunk)
+	for chunk := range slices.Chunk(slices.Clone(items), ropeChunk) {
+		leaves = append(leaves, ropeLeaf(chunk))
+	}
+	var build func(leaves []*ropeNode[T]) *ropeNode[T]
+	build = func(leaves []*ropeNode[T]) *ropeNode[T] {
+		if len(leaves) == 1 {
+			return leaves[0]
+		}
+		mid := len(leaves) / 2
+		return ropeInner(build(leaves[:mid]), build(leaves[mid:]))
+	}
+	return build(leaves)
+}
+
+// ropeJoin returns the concatenation of the trees l and r, either of which may be nil.  It
+// runs in O(|height(l) - height(r)|).
+func ropeJoin[T any](l, r *ropeNode[T]) *ropeNode[T] {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.height > r.height+1:
+		return ropeBalance(l.left, ropeJoin(l.right, r))
+	case r.height > l.height+1:
+		return ropeBalance(ropeJoin(l, r.left), r.right)
+	case l.left == nil && r.left == nil && l.length+r.length <= ropeChunk:
+		// Merge small leaves, which splits and deletions leave behind.
+		return ropeLeaf(slices.Concat(l.items, r.items))
+	}
+	return ropeInner(l, r)
+}
+
+// ropeBalance returns the concatenation of the trees l and r, whose heights differ by at
+// most 2, rotating them as an AVL tree.
+func ropeBalance[T any](l, r *ropeNode[T]) *ropeNode[T] {
+	switch {
+	case r.height > l.height+1:
+		if r.left.height > r.right.height {
+			return ropeInner(ropeInner(l, r.left.left), ropeInner(r.left.right, r.right))
+		}
+		return ropeInner(ropeInner(l, r.left), r.right)
+	case l.height > r.height+1:
+		if l.right.height > l.left.height {
+			return ropeInner(ropeInner(l.left, l.right.left), ropeInner(l.right.right, r))
+		}
+		return ropeInner(l.left, ropeInner(l.right, r))
+	}
+	return ropeInner(l, r)
+}
+
+// split returns the trees holding the elements of n before index, and the others.
+func (n *ropeNode[T]) split(index int) (*ropeNode[T], *ropeNode[T]) {
+	switch {
+	case n == nil:
+		return nil, nil
+	case index == 0:
+		return nil, n
+	case index == n.length:
+		return n, nil
+	case n.left == nil:
+		// The leaves are never modified, so the halves may share the elements.
+		return ropeLeaf(n.items[:index:index]), ropeLeaf(n.items[index:])
+	case index <= n.left.length:
+		l, r := n.left.split(index)
+		return l, ropeJoin(r, n.right)
+	default:
+		l, r := n.right.split(index - n.left.length)
+		return ropeJoin(n.left, l), r
+	}
+}
+
+// insert returns a copy of n with up to ropeChunk items inserted at index.
+func (n *ropeNode[T]) insert(index int, items []T) *ropeNode[T] {
+	if n.left == nil {
+		merged := slices.Concat(n.items[:index], items, n.items[index:])
+		if len(merged) <= ropeChunk {
+			return ropeLeaf(merged)
+		}
+		mid := len(merged) / 2
+		return ropeInner(ropeLeaf(merged[:mid:mid]), ropeLeaf(merged[mid:]))
+	}
+	if index <= n.left.length {
+		return ropeBalance(n.left.insert(index, items), n.right)
+	}
+	return ropeBalance(n.left, n.right.insert(index-n.left.length, items))
+}
+
+// collect passes the elements of n from start to end (excluded) to yield, by chunks, and
+// reports whether yield asked to go on.
+func (n *ropeNode[T]) collect(start, end int, yield func([]T) bool) bool {
+	switch {
+	case n == nil || start >= end:
+		return true
+	case n.left == nil:
+		return yield(n.items[start:end:end])
+	case end <= n.left.length:
+		return n.left.collect(start, end, yield)
+	case start >= n.left.length:
+		return n.right.collect(start-n.left.length, end-n.left.length, yield)
+	}
+	return n.left.collect(start, n.left.length, yield) &&
+		n.right.collect(0, end-n.left.length, yield)
+}
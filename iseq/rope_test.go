@@ -0,0 +1,152 @@
+package iseq
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestRope(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRope")
+
+	var r Rope[int]
+	assert.Equal(0, r.Len())
+	assert.Equal([]int{}, r.ToSlice())
+	r.Delete(0, 0)
+	r.Append(1, 2, 3)
+	r.Insert(0, 0)
+	assert.Equal([]int{0, 1, 2, 3}, r.ToSlice())
+	assert.Equal(2, r.Get(2))
+
+	right := r.Split(1)
+	assert.Equal([]int{0}, r.ToSlice())
+	assert.Equal([]int{1, 2, 3}, right.ToSlice())
+	r.Concat(right)
+	r.Concat(right)
+	assert.Equal([]int{0, 1, 2, 3, 1, 2, 3}, r.ToSlice())
+	assert.Equal([]int{1, 2, 3}, right.ToSlice())
+
+	clone := r.Clone()
+	r.Delete(1, 6)
+	assert.Equal([]int{0, 3}, r.ToSlice())
+	assert.Equal(7, clone.Len())
+	assert.Equal([]int{2, 3, 1}, clone.Slice(2, 5))
+	assert.Equal([]int{0, 1, 2}, slices.Collect(clone.Keys())[:3])
+	assert.Equal([]int{0, 3}, slices.Collect(r.Values()))
+
+	data, err := json.Marshal(&r)
+	assert.IsNil(err)
+	assert.Equal("[0,3]", string(data))
+	var decoded Rope[int]
+	assert.IsNil(json.Unmarshal(data, &decoded))
+	assert.Equal([]int{0, 3}, decoded.ToSlice())
+	data, err = clone.MarshalBinary()
+	assert.IsNil(err)
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	assert.Equal(clone.ToSlice(), decoded.ToSlice())
+
+	large := NewRope(slices.Collect(clone.Keys())...)
+	for range 8 {
+		large.Concat(large)
+	}
+	assert.Equal(7*256, large.Len())
+	n := 0
+	for chunk := range large.Chunks() {
+		assert.ShouldBeTrue(len(chunk) <= ropeChunk)
+		n += len(chunk)
+	}
+	assert.Equal(large.Len(), n)
+	for i, v := range large.All() {
+		if i == 500 {
+			assert.Equal(500%7, v)
+			break
+		}
+	}
+
+	for _, f := range []func(){
+		func() { r.Get(2) },
+		func() { r.Insert(3) },
+		func() { r.Delete(1, 3) },
+		func() { r.Split(-1) },
+		func() { r.Slice(2, 1) },
+	} {
+		func() {
+			defer func() {
+				assert.IsNotNil(recover())
+			}()
+			f()
+		}()
+	}
+}
+
+func TestRopeRandom(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRopeRandom")
+
+	// check verifies the lengths and the AVL balance of the tree of n, and returns its
+	// height.
+	var check func(n *ropeNode[int]) int
+	check = func(n *ropeNode[int]) int {
+		if n == nil {
+			return -1
+		}
+		if n.left == nil {
+			assert.Equal(len(n.items), n.length)
+			assert.ShouldBeTrue(n.length > 0 && n.length <= ropeChunk)
+			return 0
+		}
+		l, r := check(n.left), check(n.right)
+		assert.ShouldBeTrue(l-r <= 1 && r-l <= 1)
+		assert.Equal(n.left.length+n.right.length, n.length)
+		assert.Equal(max(l, r)+1, n.height)
+		return n.height
+	}
+
+	rng := rand.New(rand.NewSource(11))
+	var r Rope[int]
+	var reference []int
+	next := 0
+	items := func(n int) []int {
+		result := make([]int, n)
+		for i := range result {
+			result[i] = next
+			next++
+		}
+		return result
+	}
+	for range 2000 {
+		switch op := rng.Intn(10); {
+		case op < 5:
+			i := rng.Intn(len(reference) + 1)
+			added := items(rng.Intn(300))
+			r.Insert(i, added...)
+			reference = slices.Insert(reference, i, added...)
+		case op < 8:
+			start := rng.Intn(len(reference) + 1)
+			end := start + rng.Intn(len(reference)-start+1)/4
+			r.Delete(start, end)
+			reference = slices.Delete(reference, start, end)
+		default:
+			i := rng.Intn(len(reference) + 1)
+			right := r.Split(i)
+			check(r.root)
+			check(right.root)
+			added := NewRope(items(rng.Intn(1000))...)
+			reference = slices.Concat(reference[:i], added.ToSlice(), reference[i:])
+			r.Concat(added)
+			r.Concat(right)
+		}
+		check(r.root)
+		assert.Equal(len(reference), r.Len())
+	}
+	assert.Equal(reference, r.ToSlice())
+	for _, i := range []int{0, len(reference) / 2, len(reference) - 1} {
+		assert.Equal(reference[i], r.Get(i))
+	}
+}
@@ -0,0 +1,29 @@
+package iseq
+
+import "fmt"
+
+func ExamplePVector() {
+	v1 := NewPVector("a", "b", "c")
+	v2 := v1.Set(1, "B").Append("d")
+
+	fmt.Println(v1.ToSlice(), v1.Len())
+	fmt.Println(v2.ToSlice(), v2.Len())
+
+	// Output:
+	// [a b c] 3
+	// [a B c d] 4
+}
+
+func ExampleRope() {
+	r := NewRope([]rune("hello world")...)
+	r.Insert(5, []rune(",")...)
+	r.Delete(0, 1)
+	r.Insert(0, 'H')
+
+	fmt.Println(string(r.ToSlice()))
+	fmt.Println(string(r.Split(6).ToSlice()), string(r.ToSlice()))
+
+	// Output:
+	// Hello, world
+	//  world Hello,
+}
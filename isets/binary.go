@@ -0,0 +1,97 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package isets
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/idichekop/gods/internal/ibinary"
+)
+
+// MarshalBinary encodes the elements of the set.  It makes a Set usable with gob and
+// net/rpc.
+func (s *Set[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(s.All())
+}
+
+// UnmarshalBinary replaces the contents of the set with the elements encoded by
+// MarshalBinary.
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	*s = *New(items...)
+	return nil
+}
+
+// MarshalBinary encodes the elements of the set, in ascending order.
+func (s *SortedSet[T]) MarshalBinary() ([]byte, error) {
+	if s.store == nil {
+		return ibinary.EncodeSlice(slices.Values([]T(nil)))
+	}
+	return ibinary.EncodeSlice(s.All())
+}
+
+// UnmarshalBinary replaces the contents of the set with the elements encoded by
+// MarshalBinary.  The set keeps its comparator, so it must have been created by NewSorted,
+// NewSortedFunc or NewSortedWith.
+func (s *SortedSet[T]) UnmarshalBinary(data []byte) error {
+	if s.store == nil {
+		return errors.New("SortedSet.UnmarshalBinary: set has no comparator")
+	}
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	s.Remove(s.ToSlice()...)
+	s.Add(items...)
+	return nil
+}
+
+// MarshalBinary encodes a snapshot of the elements of the set.
+func (s *ConcurrentSet[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(s.All())
+}
+
+// UnmarshalBinary replaces the contents of the set with the elements encoded by
+// MarshalBinary, in a single atomic step.
+func (s *ConcurrentSet[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	set := New(items...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
+
+// MarshalBinary encodes the elements of the multiset and their counts.
+func (s *MultiSet[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(maps.All(s.counts))
+}
+
+// UnmarshalBinary replaces the contents of the multiset with the elements and counts
+// encoded by MarshalBinary.  Zero counts are skipped; negative ones are an error.
+func (s *MultiSet[T]) UnmarshalBinary(data []byte) error {
+	items, counts, err := ibinary.DecodePairs[T, int](data)
+	if err != nil {
+		return err
+	}
+	decoded := NewMultiSet[T]()
+	for i, item := range items {
+		if counts[i] < 0 {
+			return fmt.Errorf("MultiSet.UnmarshalBinary: negative count %d for %v", counts[i], item)
+		}
+		decoded.Add(item, counts[i])
+	}
+	*s = *decoded
+	return nil
+}
@@ -0,0 +1,64 @@
+package isets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/internal"
+)
+
+func TestSetBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSetBinary")
+
+	var buf bytes.Buffer
+	assert.IsNil(gob.NewEncoder(&buf).Encode(New("a", "b")))
+	var decoded Set[string]
+	assert.IsNil(gob.NewDecoder(&buf).Decode(&decoded))
+	assert.ShouldBeTrue(decoded.Equal(New("a", "b")))
+
+	data, err := NewConcurrent(1, 2).MarshalBinary()
+	assert.IsNil(err)
+	cs := NewConcurrent(9)
+	assert.IsNil(cs.UnmarshalBinary(data))
+	assert.ShouldBeTrue(cs.Snapshot().Equal(New(1, 2)))
+}
+
+func TestSortedSetBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortedSetBinary")
+
+	data, err := NewSorted(3, 1, 2).MarshalBinary()
+	assert.IsNil(err)
+
+	s := NewSortedFunc(icompare.Reversed(icompare.Natural[int]()), 7)
+	assert.IsNil(s.UnmarshalBinary(data))
+	assert.Equal([]int{3, 2, 1}, s.ToSlice())
+
+	var zero SortedSet[int]
+	data, err = zero.MarshalBinary()
+	assert.IsNil(err)
+	assert.IsNotNil(zero.UnmarshalBinary(data))
+}
+
+func TestMultiSetBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiSetBinary")
+
+	data, err := NewMultiSet("b", "a", "b").MarshalBinary()
+	assert.IsNil(err)
+
+	var s MultiSet[string]
+	assert.IsNil(s.UnmarshalBinary(data))
+	assert.Equal(3, s.Len())
+	assert.Equal(2, s.Count("b"))
+
+	data, _ = MultiSetFromFrequency(map[string]int{"a": 1}).MarshalBinary()
+	assert.IsNil(s.UnmarshalBinary(data))
+	assert.Equal(1, s.Len())
+}
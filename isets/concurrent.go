@@ -0,0 +1,121 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package isets
+
+import (
+	"iter"
+	"sync"
+)
+
+// ConcurrentSet is a Set safe for concurrent use.  Each operation, bulk ones included, is
+// atomic; iterations run over a snapshot, so they never block writers nor observe their
+// changes.
+type ConcurrentSet[T comparable] struct {
+	mu  sync.RWMutex
+	set *Set[T]
+}
+
+// NewConcurrent creates a ConcurrentSet holding the given items.
+func NewConcurrent[T comparable](items ...T) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: New(items...)}
+}
+
+// Add adds the items to the set, and returns the number of items that were not in it.
+func (s *ConcurrentSet[T]) Add(items ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.set.Len()
+	s.set.Add(items...)
+	return s.set.Len() - before
+}
+
+// Remove removes the items from the set, and returns the number of items that were in it.
+func (s *ConcurrentSet[T]) Remove(items ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.set.Len()
+	s.set.Remove(items...)
+	return before - s.set.Len()
+}
+
+// Contains reports whether item is in the set.
+func (s *ConcurrentSet[T]) Contains(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Contains(item)
+}
+
+// ContainsAll reports whether all the items are in the set.
+func (s *ConcurrentSet[T]) ContainsAll(items ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, item := range items {
+		if !s.set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny reports whether at least one of the items is in the set.
+func (s *ConcurrentSet[T]) ContainsAny(items ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, item := range items {
+		if s.set.Contains(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of elements.
+func (s *ConcurrentSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Len()
+}
+
+// Snapshot returns a copy of the set, as a Set.
+func (s *ConcurrentSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+// ToSlice returns the elements of the set, in no particular order.
+func (s *ConcurrentSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the elements, taken when the iteration
+// starts, in no particular order.
+func (s *ConcurrentSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.ToSlice() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over a snapshot of the elements, which are the keys of the set,
+// taken when the iteration starts, in no particular order.
+func (s *ConcurrentSet[T]) Keys() iter.Seq[T] {
+	return s.All()
+}
+
+// Values returns an iterator over a snapshot of the elements, taken when the iteration
+// starts, in no particular order.
+func (s *ConcurrentSet[T]) Values() iter.Seq[T] {
+	return s.All()
+}
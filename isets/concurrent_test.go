@@ -0,0 +1,72 @@
+package isets
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestConcurrentSet(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcurrentSet")
+
+	s := NewConcurrent(1, 2)
+	assert.Equal(2, s.Add(2, 3, 4))
+	assert.Equal(1, s.Remove(1, 9))
+	assert.Equal(3, s.Len())
+
+	assert.ShouldBeTrue(s.Contains(3))
+	assert.ShouldBeTrue(s.ContainsAll(2, 3))
+	assert.ShouldBeFalse(s.ContainsAll(2, 9))
+	assert.ShouldBeTrue(s.ContainsAny(9, 4))
+	assert.ShouldBeFalse(s.ContainsAny(8, 9))
+
+	snapshot := s.Snapshot()
+	s.Add(5)
+	assert.Equal(3, snapshot.Len())
+
+	items := s.ToSlice()
+	slices.Sort(items)
+	assert.Equal([]int{2, 3, 4, 5}, items)
+
+	// the iteration runs over a snapshot, so it may write to the set
+	visited := 0
+	for item := range s.All() {
+		s.Remove(item)
+		visited++
+	}
+	assert.Equal(4, visited)
+	assert.Equal(0, s.Len())
+}
+
+func TestConcurrentSetParallel(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcurrentSetParallel")
+
+	s := NewConcurrent[int]()
+
+	var wg sync.WaitGroup
+	added := make([]int, 8)
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				added[w] += s.Add(i)
+				s.Contains(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for _, n := range added {
+		total += n
+	}
+	assert.Equal(1000, total)
+	assert.Equal(1000, s.Len())
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package isets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/idichekop/gods/internal/ijson"
+)
+
+// MarshalJSON encodes the set as a JSON array of its elements, in no particular order.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(s.All())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON array.
+// Duplicate elements are merged.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	*s = *New(items...)
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array of its elements, in ascending order.
+func (s *SortedSet[T]) MarshalJSON() ([]byte, error) {
+	if s.store == nil {
+		return []byte("[]"), nil
+	}
+	return ijson.MarshalArray(s.All())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON array.  The
+// set keeps its comparator, so it must have been created by NewSorted, NewSortedFunc or
+// NewSortedWith.
+func (s *SortedSet[T]) UnmarshalJSON(data []byte) error {
+	if s.store == nil {
+		return errors.New("SortedSet.UnmarshalJSON: set has no comparator")
+	}
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	s.Remove(s.ToSlice()...)
+	s.Add(items...)
+	return nil
+}
+
+// MarshalJSON encodes a snapshot of the set as a JSON array of its elements, in no
+// particular order.
+func (s *ConcurrentSet[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(s.All())
+}
+
+// UnmarshalJSON replaces the contents of the set with the elements of a JSON array, in a
+// single atomic step.
+func (s *ConcurrentSet[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	set := New(items...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
+
+// MarshalJSON encodes the multiset as a JSON object mapping each element to its count.
+// As for Go maps, the members are sorted by element.
+func (s *MultiSet[T]) MarshalJSON() ([]byte, error) {
+	if s.counts == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(s.counts)
+}
+
+// UnmarshalJSON replaces the contents of the multiset with the members of a JSON object
+// mapping elements to counts.  Zero counts are skipped; negative ones are an error.
+func (s *MultiSet[T]) UnmarshalJSON(data []byte) error {
+	var counts map[T]int
+	if err := json.Unmarshal(data, &counts); err != nil || counts == nil {
+		return err
+	}
+	for item, n := range counts {
+		if n < 0 {
+			return fmt.Errorf("MultiSet.UnmarshalJSON: negative count %d for %v", n, item)
+		}
+	}
+	*s = *MultiSetFromFrequency(counts)
+	return nil
+}
@@ -0,0 +1,69 @@
+package isets
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/internal"
+)
+
+func TestSetJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSetJSON")
+
+	data, err := json.Marshal(New(3, 1, 2))
+	assert.IsNil(err)
+	var items []int
+	assert.IsNil(json.Unmarshal(data, &items))
+	assert.Equal([]int{1, 2, 3}, slices.Sorted(slices.Values(items)))
+
+	var s Set[int]
+	data, _ = json.Marshal(&s)
+	assert.Equal("[]", string(data))
+	assert.IsNil(json.Unmarshal([]byte("[4,4,5]"), &s))
+	assert.ShouldBeTrue(s.Equal(New(4, 5)))
+
+	cs := NewConcurrent(9)
+	assert.IsNil(json.Unmarshal([]byte("[1,2]"), cs))
+	assert.ShouldBeTrue(cs.Snapshot().Equal(New(1, 2)))
+	data, _ = json.Marshal(NewConcurrent("x"))
+	assert.Equal(`["x"]`, string(data))
+}
+
+func TestSortedSetJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortedSetJSON")
+
+	data, err := json.Marshal(NewSorted(3, 1, 2))
+	assert.IsNil(err)
+	assert.Equal("[1,2,3]", string(data))
+
+	s := NewSortedFunc(icompare.Reversed(icompare.Natural[int]()), 7)
+	assert.IsNil(json.Unmarshal(data, s))
+	assert.Equal([]int{3, 2, 1}, s.ToSlice())
+
+	var zero SortedSet[int]
+	data, _ = json.Marshal(&zero)
+	assert.Equal("[]", string(data))
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
+
+func TestMultiSetJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiSetJSON")
+
+	data, err := json.Marshal(NewMultiSet("b", "a", "b"))
+	assert.IsNil(err)
+	assert.Equal(`{"a":1,"b":2}`, string(data))
+
+	var s MultiSet[string]
+	assert.IsNil(json.Unmarshal([]byte(`{"a":1,"b":2,"c":0}`), &s))
+	assert.Equal(3, s.Len())
+	assert.Equal(2, s.Distinct())
+	assert.IsNotNil(json.Unmarshal([]byte(`{"a":-1}`), &s))
+}
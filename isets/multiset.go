@@ -0,0 +1,156 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package isets
+
+import (
+	"iter"
+	"maps"
+)
+
+// MultiSet (or bag) is a set whose elements may occur several times; it tracks the count
+// of each element.  The set operations follow multiset semantics and return new
+// multisets.  A MultiSet is not safe for concurrent use.
+type MultiSet[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// NewMultiSet creates a MultiSet holding the given items, each occurrence counting once.
+func NewMultiSet[T comparable](items ...T) *MultiSet[T] {
+	s := &MultiSet[T]{counts: make(map[T]int)}
+	for _, item := range items {
+		s.Add(item, 1)
+	}
+	return s
+}
+
+// MultiSetFromFrequency creates a MultiSet from a map of counts, such as the one returned
+// by islice.Frequency.  Non-positive counts are skipped.
+func MultiSetFromFrequency[T comparable](frequency map[T]int) *MultiSet[T] {
+	s := NewMultiSet[T]()
+	for item, n := range frequency {
+		if n > 0 {
+			s.Add(item, n)
+		}
+	}
+	return s
+}
+
+// Add adds n occurrences of item.  It panics if n is negative.
+func (s *MultiSet[T]) Add(item T, n int) {
+	if n < 0 {
+		panic("MultiSet.Add: negative count")
+	}
+	if n == 0 {
+		return
+	}
+	s.counts[item] += n
+	s.size += n
+}
+
+// Remove removes up to n occurrences of item, and returns the number removed.  It panics
+// if n is negative.
+func (s *MultiSet[T]) Remove(item T, n int) int {
+	if n < 0 {
+		panic("MultiSet.Remove: negative count")
+	}
+	count := s.counts[item]
+	removed := min(n, count)
+	if removed == count {
+		delete(s.counts, item)
+	} else {
+		s.counts[item] = count - removed
+	}
+	s.size -= removed
+	return removed
+}
+
+// Count returns the number of occurrences of item.
+func (s *MultiSet[T]) Count(item T) int {
+	return s.counts[item]
+}
+
+// Contains reports whether item occurs at least once.
+func (s *MultiSet[T]) Contains(item T) bool {
+	return s.counts[item] > 0
+}
+
+// Len returns the total number of occurrences, over all elements.
+func (s *MultiSet[T]) Len() int {
+	return s.size
+}
+
+// Distinct returns the number of distinct elements.
+func (s *MultiSet[T]) Distinct() int {
+	return len(s.counts)
+}
+
+// Frequency returns a copy of the counts, in the format of islice.Frequency.
+func (s *MultiSet[T]) Frequency() map[T]int {
+	return maps.Clone(s.counts)
+}
+
+// All returns an iterator over the distinct elements and their counts, in no particular
+// order.
+func (s *MultiSet[T]) All() iter.Seq2[T, int] {
+	return maps.All(s.counts)
+}
+
+// Keys returns an iterator over the distinct elements, in no particular order.
+func (s *MultiSet[T]) Keys() iter.Seq[T] {
+	return maps.Keys(s.counts)
+}
+
+// Values returns an iterator over the counts of the distinct elements, in the order of
+// All.
+func (s *MultiSet[T]) Values() iter.Seq[int] {
+	return maps.Values(s.counts)
+}
+
+// Union returns the multiset where each element occurs as many times as its greatest
+// count in s and other.
+func (s *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
+	result := MultiSetFromFrequency(s.counts)
+	for item, n := range other.counts {
+		if extra := n - result.counts[item]; extra > 0 {
+			result.Add(item, extra)
+		}
+	}
+	return result
+}
+
+// Intersection returns the multiset where each element occurs as many times as its
+// smallest count in s and other.
+func (s *MultiSet[T]) Intersection(other *MultiSet[T]) *MultiSet[T] {
+	result := NewMultiSet[T]()
+	for item, n := range s.counts {
+		result.Add(item, min(n, other.counts[item]))
+	}
+	return result
+}
+
+// Sum returns the multiset where the counts of s and other are added.
+func (s *MultiSet[T]) Sum(other *MultiSet[T]) *MultiSet[T] {
+	result := MultiSetFromFrequency(s.counts)
+	for item, n := range other.counts {
+		result.Add(item, n)
+	}
+	return result
+}
+
+// Difference returns the multiset where the counts of other are subtracted from those of
+// s, down to zero.
+func (s *MultiSet[T]) Difference(other *MultiSet[T]) *MultiSet[T] {
+	result := MultiSetFromFrequency(s.counts)
+	for item, n := range other.counts {
+		result.Remove(item, n)
+	}
+	return result
+}
+
+// Equal reports whether s and other hold the same elements with the same counts.
+func (s *MultiSet[T]) Equal(other *MultiSet[T]) bool {
+	return s.size == other.size && maps.Equal(s.counts, other.counts)
+}
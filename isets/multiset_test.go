@@ -0,0 +1,60 @@
+package isets
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestMultiSet(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiSet")
+
+	s := NewMultiSet("a", "b", "a")
+	assert.Equal(2, s.Count("a"))
+	assert.Equal(3, s.Len())
+	assert.Equal(2, s.Distinct())
+
+	s.Add("c", 3)
+	s.Add("c", 0)
+	assert.Equal(3, s.Count("c"))
+	assert.Equal(6, s.Len())
+
+	assert.Equal(2, s.Remove("c", 2))
+	assert.Equal(1, s.Remove("c", 5))
+	assert.Equal(0, s.Remove("z", 1))
+	assert.ShouldBeFalse(s.Contains("c"))
+	assert.Equal(map[string]int{"a": 2, "b": 1}, s.Frequency())
+	assert.Equal(3, s.Len())
+
+	total := 0
+	for _, n := range s.All() {
+		total += n
+	}
+	assert.Equal(3, total)
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	s.Add("a", -1)
+}
+
+func TestMultiSetOperations(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMultiSetOperations")
+
+	a := NewMultiSet(1, 1, 1, 2, 3)
+	b := MultiSetFromFrequency(map[int]int{1: 2, 2: 2, 4: 1, 5: 0})
+
+	assert.Equal(map[int]int{1: 3, 2: 2, 3: 1, 4: 1}, a.Union(b).Frequency())
+	assert.Equal(map[int]int{1: 2, 2: 1}, a.Intersection(b).Frequency())
+	assert.Equal(map[int]int{1: 5, 2: 3, 3: 1, 4: 1}, a.Sum(b).Frequency())
+	assert.Equal(map[int]int{1: 1, 3: 1}, a.Difference(b).Frequency())
+	assert.Equal(2, a.Difference(b).Len())
+
+	assert.ShouldBeTrue(a.Equal(NewMultiSet(3, 1, 2, 1, 1)))
+	assert.ShouldBeFalse(a.Equal(NewMultiSet(3, 1, 2, 1)))
+	assert.Equal(map[int]int{1: 3, 2: 1, 3: 1}, a.Frequency())
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package isets implements generic set types.  Unlike the set functions of package
+// islice, meant for one-off calls on slices, they hold long-lived membership state.
+package isets
+
+import (
+	"iter"
+	"maps"
+)
+
+// Set is an unordered collection of distinct comparable elements.  The set operations
+// (Union, Intersection, ...) return new sets and leave their operands unchanged.  A Set is
+// not safe for concurrent use; see ConcurrentSet.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// New creates a set holding the given items.
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	s.Add(items...)
+	return s
+}
+
+// Add adds the items to the set.
+func (s *Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+}
+
+// Remove removes the items from the set.
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.items, item)
+	}
+}
+
+// Contains reports whether item is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of elements.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Clone returns a copy of the set.
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{items: maps.Clone(s.items)}
+}
+
+// ToSlice returns the elements of the set, in no particular order.
+func (s *Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s.items))
+	for item := range s.items {
+		result = append(result, item)
+	}
+	return result
+}
+
+// All returns an iterator over the elements, in no particular order.
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.items)
+}
+
+// Keys returns an iterator over the elements, which are the keys of the set, in no
+// particular order.
+func (s *Set[T]) Keys() iter.Seq[T] {
+	return s.All()
+}
+
+// Values returns an iterator over the elements, in no particular order.
+func (s *Set[T]) Values() iter.Seq[T] {
+	return s.All()
+}
+
+// Union returns the elements that are in s, in other, or in both.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for item := range other.items {
+		result.items[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns the elements that are both in s and in other.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	result := New[T]()
+	for item := range small.items {
+		if large.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns the elements of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := New[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns the elements that are in exactly one of s and other.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for item := range other.items {
+		if !s.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Equal reports whether s and other hold the same elements.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubsetOf(other)
+}
+
+// IsSubsetOf reports whether every element of s is in other.
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf reports whether every element of other is in s.
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
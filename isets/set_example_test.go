@@ -0,0 +1,73 @@
+package isets
+
+import (
+	"fmt"
+	"slices"
+)
+
+func ExampleSet() {
+	backend := New("go", "sql", "docker")
+	frontend := New("js", "css", "docker")
+
+	both := backend.Intersection(frontend)
+	either := backend.Union(frontend).ToSlice()
+	slices.Sort(either)
+
+	fmt.Println(both.ToSlice())
+	fmt.Println(either)
+	fmt.Println(New("go").IsSubsetOf(backend))
+
+	// Output:
+	// [docker]
+	// [css docker go js sql]
+	// true
+}
+
+func ExampleSortedSet() {
+	seen := NewSorted(105, 101, 110, 103)
+
+	fmt.Println(seen.ToSlice())
+
+	next, _ := seen.Ceiling(104)
+	fmt.Println(next, seen.Rank(104))
+
+	for t := range seen.Range(102, 110) {
+		fmt.Println(t)
+	}
+
+	// Output:
+	// [101 103 105 110]
+	// 105 2
+	// 103
+	// 105
+}
+
+func ExampleConcurrentSet() {
+	online := NewConcurrent[string]()
+
+	fmt.Println(online.Add("alice", "bob"))
+	fmt.Println(online.Add("bob"))
+	fmt.Println(online.ContainsAll("alice", "bob"), online.Len())
+
+	// Output:
+	// 2
+	// 0
+	// true 2
+}
+
+func ExampleMultiSet() {
+	inventory := MultiSetFromFrequency(map[string]int{"apple": 3, "pear": 1})
+
+	inventory.Add("apple", 2)
+	inventory.Remove("pear", 5)
+
+	order := NewMultiSet("apple", "apple", "fig")
+	missing := order.Difference(inventory)
+
+	fmt.Println(inventory.Count("apple"), inventory.Len())
+	fmt.Println(missing.Frequency())
+
+	// Output:
+	// 5 5
+	// map[fig:1]
+}
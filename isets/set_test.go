@@ -0,0 +1,81 @@
+package isets
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+// sorted returns the elements of the set in ascending order, for comparisons.
+func sorted(s *Set[int]) []int {
+	result := s.ToSlice()
+	slices.Sort(result)
+	return result
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSet")
+
+	s := New(1, 2, 2, 3)
+	assert.Equal(3, s.Len())
+	assert.ShouldBeTrue(s.Contains(2))
+	assert.ShouldBeFalse(s.Contains(4))
+
+	s.Add(4, 5)
+	s.Remove(1, 9)
+	assert.Equal([]int{2, 3, 4, 5}, sorted(s))
+
+	clone := s.Clone()
+	clone.Add(6)
+	assert.Equal(4, s.Len())
+
+	sum := 0
+	for item := range s.All() {
+		sum += item
+	}
+	assert.Equal(14, sum)
+	assert.Equal([]int{2, 3, 4, 5}, slices.Sorted(s.Keys()))
+	assert.Equal([]int{2, 3, 4, 5}, slices.Sorted(s.Values()))
+
+	assert.Equal(0, New[string]().Len())
+}
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSetOperations")
+
+	a := New(1, 2, 3, 4)
+	b := New(3, 4, 5)
+
+	assert.Equal([]int{1, 2, 3, 4, 5}, sorted(a.Union(b)))
+	assert.Equal([]int{3, 4}, sorted(a.Intersection(b)))
+	assert.Equal([]int{3, 4}, sorted(b.Intersection(a)))
+	assert.Equal([]int{1, 2}, sorted(a.Difference(b)))
+	assert.Equal([]int{1, 2, 5}, sorted(a.SymmetricDifference(b)))
+
+	assert.Equal([]int{1, 2, 3, 4}, sorted(a))
+	assert.Equal([]int{3, 4, 5}, sorted(b))
+}
+
+func TestSetPredicates(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSetPredicates")
+
+	a := New(1, 2, 3)
+	b := New(1, 2)
+
+	assert.ShouldBeTrue(a.Equal(New(3, 2, 1)))
+	assert.ShouldBeFalse(a.Equal(b))
+	assert.ShouldBeFalse(a.Equal(New(1, 2, 4)))
+
+	assert.ShouldBeTrue(b.IsSubsetOf(a))
+	assert.ShouldBeFalse(a.IsSubsetOf(b))
+	assert.ShouldBeTrue(a.IsSupersetOf(b))
+	assert.ShouldBeTrue(New[int]().IsSubsetOf(b))
+	assert.ShouldBeTrue(a.IsSubsetOf(a))
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package isets
+
+import (
+	"iter"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/imaps"
+	"golang.org/x/exp/constraints"
+)
+
+// SortedSet is a set whose elements are kept in order, as the keys of a SortedStore: by
+// default a balanced tree (an imaps.TreeMap), where membership, the neighbor queries
+// (Floor, Ceiling) and the rank queries (Rank, Select) run in O(log n).  Iterations visit
+// the elements in ascending order.  A SortedSet is as safe for concurrent use as its
+// store: the default one is not, but a set stored in an imaps.SkipList is.
+type SortedSet[T any] struct {
+	store SortedStore[T]
+}
+
+// SortedStore is an ordered map that stores the elements of a SortedSet as its keys, such
+// as an imaps.TreeMap or an imaps.SkipList.
+type SortedStore[T any] interface {
+	Len() int
+	Contains(key T) bool
+	Put(key T, value struct{})
+	Delete(key T) bool
+	Min() (T, struct{}, bool)
+	Max() (T, struct{}, bool)
+	Floor(key T) (T, struct{}, bool)
+	Ceiling(key T) (T, struct{}, bool)
+	Rank(key T) int
+	Select(index int) (T, struct{}, bool)
+	All() iter.Seq2[T, struct{}]
+	Range(from, to T) iter.Seq2[T, struct{}]
+}
+
+// NewSorted creates a SortedSet holding the given items, ordered by their natural order.
+func NewSorted[T constraints.Ordered](items ...T) *SortedSet[T] {
+	return NewSortedFunc(icompare.Natural[T](), items...)
+}
+
+// NewSortedFunc creates a SortedSet holding the given items, ordered by the comparator.
+// Items the comparator finds equal are the same element.
+func NewSortedFunc[T any](compare icompare.Comparator[T], items ...T) *SortedSet[T] {
+	return NewSortedWith[T](imaps.NewTreeMapFunc[T, struct{}](compare), items...)
+}
+
+// NewSortedWith creates a SortedSet kept in store, to which it adds the given items.  The
+// keys already in store are elements of the set too.  The set owns store from then on.
+func NewSortedWith[T any](store SortedStore[T], items ...T) *SortedSet[T] {
+	s := &SortedSet[T]{store: store}
+	s.Add(items...)
+	return s
+}
+
+// Add adds the items to the set.
+func (s *SortedSet[T]) Add(items ...T) {
+	for _, item := range items {
+		s.store.Put(item, struct{}{})
+	}
+}
+
+// Remove removes the items from the set.
+func (s *SortedSet[T]) Remove(items ...T) {
+	for _, item := range items {
+		s.store.Delete(item)
+	}
+}
+
+// Contains reports whether item is in the set.
+func (s *SortedSet[T]) Contains(item T) bool {
+	return s.store.Contains(item)
+}
+
+// Len returns the number of elements.
+func (s *SortedSet[T]) Len() int {
+	return s.store.Len()
+}
+
+// Min returns the smallest element, or false if the set is empty.
+func (s *SortedSet[T]) Min() (T, bool) {
+	item, _, ok := s.store.Min()
+	return item, ok
+}
+
+// Max returns the greatest element, or false if the set is empty.
+func (s *SortedSet[T]) Max() (T, bool) {
+	item, _, ok := s.store.Max()
+	return item, ok
+}
+
+// Floor returns the greatest element less than or equal to item, or false if there is
+// none.
+func (s *SortedSet[T]) Floor(item T) (T, bool) {
+	found, _, ok := s.store.Floor(item)
+	return found, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to item, or false if there
+// is none.
+func (s *SortedSet[T]) Ceiling(item T) (T, bool) {
+	found, _, ok := s.store.Ceiling(item)
+	return found, ok
+}
+
+// Rank returns the number of elements strictly less than item.
+func (s *SortedSet[T]) Rank(item T) int {
+	return s.store.Rank(item)
+}
+
+// Select returns the element of the given rank (the index-th smallest, from 0), or false
+// if index is out of range.
+func (s *SortedSet[T]) Select(index int) (T, bool) {
+	item, _, ok := s.store.Select(index)
+	return item, ok
+}
+
+// All returns an iterator over the elements, in ascending order.
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return keysOf(s.store.All())
+}
+
+// Keys returns an iterator over the elements, which are the keys of the set, in ascending
+// order.
+func (s *SortedSet[T]) Keys() iter.Seq[T] {
+	return s.All()
+}
+
+// Values returns an iterator over the elements, in ascending order.
+func (s *SortedSet[T]) Values() iter.Seq[T] {
+	return s.All()
+}
+
+// Range returns an iterator over the elements in [from, to), in ascending order.
+func (s *SortedSet[T]) Range(from, to T) iter.Seq[T] {
+	return keysOf(s.store.Range(from, to))
+}
+
+// ToSlice returns the elements of the set, in ascending order.
+func (s *SortedSet[T]) ToSlice() []T {
+	result := make([]T, 0, s.Len())
+	for item := range s.All() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// keysOf drops the values of a key-value iterator.
+func keysOf[K any, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
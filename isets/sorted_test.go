@@ -0,0 +1,130 @@
+package isets
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/idichekop/gods/imaps"
+	"github.com/idichekop/gods/internal"
+)
+
+var (
+	_ SortedStore[int] = (*imaps.TreeMap[int, struct{}])(nil)
+	_ SortedStore[int] = (*imaps.SkipList[int, struct{}])(nil)
+)
+
+func TestSortedSet(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortedSet")
+
+	for _, s := range []*SortedSet[int]{
+		NewSorted(50, 10, 30, 10, 40),
+		NewSortedWith[int](imaps.NewSkipList[int, struct{}](), 50, 10, 30, 10, 40),
+	} {
+		assert.Equal(4, s.Len())
+		assert.Equal([]int{10, 30, 40, 50}, s.ToSlice())
+
+		s.Add(20)
+		s.Remove(40, 99)
+		assert.Equal([]int{10, 20, 30, 50}, s.ToSlice())
+		assert.ShouldBeTrue(s.Contains(20))
+		assert.ShouldBeFalse(s.Contains(40))
+
+		first, _ := s.Min()
+		last, _ := s.Max()
+		assert.Equal(10, first)
+		assert.Equal(50, last)
+
+		floor, ok := s.Floor(45)
+		assert.Equal(30, floor)
+		assert.ShouldBeTrue(ok)
+		_, ok = s.Floor(5)
+		assert.ShouldBeFalse(ok)
+		ceiling, _ := s.Ceiling(45)
+		assert.Equal(50, ceiling)
+		_, ok = s.Ceiling(51)
+		assert.ShouldBeFalse(ok)
+
+		assert.Equal(2, s.Rank(30))
+		assert.Equal(3, s.Rank(45))
+		second, _ := s.Select(1)
+		assert.Equal(20, second)
+		_, ok = s.Select(4)
+		assert.ShouldBeFalse(ok)
+
+		assert.Equal([]int{20, 30}, slices.Collect(s.Range(15, 50)))
+
+		var firstTwo []int
+		for item := range s.All() {
+			if len(firstTwo) == 2 {
+				break
+			}
+			firstTwo = append(firstTwo, item)
+		}
+		assert.Equal([]int{10, 20}, firstTwo)
+	}
+
+	empty := NewSorted[string]()
+	_, ok := empty.Min()
+	assert.ShouldBeFalse(ok)
+	assert.Equal([]string{}, empty.ToSlice())
+}
+
+func TestSortedSetFunc(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortedSetFunc")
+
+	type player struct {
+		name  string
+		score int
+	}
+
+	// a leaderboard: best score first, ties broken by name
+	board := NewSortedFunc(func(a, b player) int {
+		if a.score != b.score {
+			return b.score - a.score
+		}
+		switch {
+		case a.name < b.name:
+			return -1
+		case a.name > b.name:
+			return 1
+		}
+		return 0
+	}, player{"al", 10}, player{"bo", 30}, player{"cy", 20}, player{"di", 30})
+
+	top, _ := board.Select(0)
+	assert.Equal("bo", top.name)
+	assert.Equal(2, board.Rank(player{"cy", 20}))
+}
+
+func TestSortedSetOnSkipList(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortedSetOnSkipList")
+
+	store := imaps.NewSkipList[int, struct{}]()
+	store.Put(7, struct{}{})
+	s := NewSortedWith[int](store, 3, 5)
+	assert.Equal([]int{3, 5, 7}, s.ToSlice())
+
+	// A set on a SkipList may be read while another goroutine writes it.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range 1000 {
+			s.Add(100 + i)
+		}
+	}()
+	for range 100 {
+		assert.ShouldBeTrue(s.Contains(5))
+		first, _ := s.Min()
+		assert.Equal(3, first)
+	}
+	wg.Wait()
+	assert.Equal(1003, s.Len())
+}
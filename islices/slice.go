@@ -9,25 +9,49 @@
 package islice
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"iter"
+	"math"
 	"math/rand"
 	"reflect"
 	stdslices "slices"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/duke-git/lancet/v2/random"
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/ioptional"
+	"github.com/idichekop/gods/ituples"
 	"golang.org/x/exp/constraints"
 )
 
-// Create a static variable to store the hash table.
-// This variable has the same lifetime as the entire program and can be shared by functions that are called more than once.
-var (
-	memoryHashMap     = make(map[string]map[any]int)
-	memoryHashCounter = make(map[string]int)
-	muForMemoryHash   sync.RWMutex
+// ErrDuplicateKey is returned when a slice-to-map conversion produces the same key twice
+// and the caller asked for collisions to be reported.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// ErrIndexOutOfRange is returned when an index or a range of indexes falls outside of the
+// bounds of a slice.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// SmallSliceThreshold is the size under which the set operations (ContainsSubSlice,
+// Difference and Intersection) use nested linear scans instead of hash maps.  On small
+// inputs, the scans are faster and do not allocate a map on every call.
+const SmallSliceThreshold = 32
+
+// SetHint tells the set operations which algorithm to use, for the callers who know their
+// inputs better than SmallSliceThreshold does.
+type SetHint int
+
+const (
+	// HintAuto scans the inputs under SmallSliceThreshold, and hashes the others.
+	HintAuto SetHint = iota
+	// HintScan always uses nested linear scans: O(n*m), without allocating a map.
+	HintScan
+	// HintHash always uses hash maps: O(n+m).
+	HintHash
 )
 
 /**
@@ -42,12 +66,20 @@ the most likely explanation is that it is promptly available from the go std lib
 // ContainsSubSlice check if the slice contain a given subslice or not.
 // Play: https://go.dev/play/p/bcuQ3UT6Sev
 func ContainsSubSlice[T comparable](slice, subSlice []T) bool {
+	return ContainsSubSliceHint(slice, subSlice, HintAuto)
+}
+
+// ContainsSubSliceHint is like ContainsSubSlice, with the algorithm forced by hint.
+func ContainsSubSliceHint[T comparable](slice, subSlice []T, hint SetHint) bool {
 	if len(subSlice) == 0 {
 		return true
 	}
 	if len(slice) == 0 {
 		return false
 	}
+	if useScan(hint, len(slice) <= SmallSliceThreshold && len(subSlice) <= SmallSliceThreshold) {
+		return containsSubSliceScan(slice, subSlice)
+	}
 
 	elementCount := make(map[T]int, len(slice))
 	for _, item := range slice {
@@ -66,11 +98,19 @@ func ContainsSubSlice[T comparable](slice, subSlice []T) bool {
 // Difference creates a slice of whose element in slice but not in comparedSlice.
 // Play: https://go.dev/play/p/VXvadzLzhDa
 func Difference[T comparable](slice, comparedSlice []T) []T {
+	return DifferenceHint(slice, comparedSlice, HintAuto)
+}
+
+// DifferenceHint is like Difference, with the algorithm forced by hint.
+func DifferenceHint[T comparable](slice, comparedSlice []T, hint SetHint) []T {
 	result := []T{}
 
 	if len(slice) == 0 {
 		return result
 	}
+	if useScan(hint, len(slice) <= SmallSliceThreshold && len(comparedSlice) <= SmallSliceThreshold) {
+		return differenceScan(slice, comparedSlice)
+	}
 
 	comparedMap := make(map[T]struct{}, len(comparedSlice))
 	for _, v := range comparedSlice {
@@ -112,7 +152,7 @@ func DifferenceBy[T comparable](slice []T, comparedSlice []T, iteratee func(inde
 // The order and references of result values are determined by the first slice.
 // The comparator is invoked with two arguments: (arrVal, othVal).
 // Play: https://go.dev/play/p/v2U2deugKuV
-func DifferenceWith[T any](slice []T, comparedSlice []T, comparator func(item1, item2 T) bool) []T {
+func DifferenceWith[T any](slice []T, comparedSlice []T, comparator icompare.Equaler[T]) []T {
 	getIndex := func(arr []T, item T, comparison func(v1, v2 T) bool) int {
 		for i, v := range arr {
 			if comparison(item, v) {
@@ -168,6 +208,176 @@ func IsPermutation[T comparable](slice1, slice2 []T) bool {
 	return true
 }
 
+// IsPermutationBy is like IsPermutation, but elements are compared through the keys
+// produced by the key function.  It allows elements that are not comparable themselves,
+// or custom equality such as case-insensitive strings.
+func IsPermutationBy[T any, K comparable](slice1, slice2 []T, key func(item T) K) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+
+	seen := make(map[K]int, len(slice1))
+	for _, v := range slice1 {
+		seen[key(v)]++
+	}
+
+	for _, v := range slice2 {
+		k := key(v)
+		if seen[k] == 0 {
+			return false
+		}
+		seen[k]--
+	}
+
+	return true
+}
+
+// IsPermutationWith is like IsPermutation, but elements are compared with the eq function.
+// It supports equalities that cannot be expressed as a key (e.g. float tolerance), which
+// need not be transitive, at the cost of n*n calls to eq and a cubic running time in the
+// worst case.
+func IsPermutationWith[T any](slice1, slice2 []T, eq icompare.Equaler[T]) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+
+	candidates := make([][]int, len(slice1))
+	for i, v := range slice1 {
+		for j, w := range slice2 {
+			if eq(v, w) {
+				candidates[i] = append(candidates[i], j)
+			}
+		}
+		if len(candidates[i]) == 0 {
+			return false
+		}
+	}
+
+	// A first-fit matching fails when eq is not transitive: with a tolerance of 0.1,
+	// matching 1.0 to 1.05 leaves nothing for 1.1 in [1.05, 0.95].  Augmenting paths
+	// (Kuhn's algorithm) rematch the earlier elements instead.
+	matchedTo := make([]int, len(slice2))
+	for j := range matchedTo {
+		matchedTo[j] = -1
+	}
+	visited := make([]bool, len(slice2))
+	var augment func(i int) bool
+	augment = func(i int) bool {
+		for _, j := range candidates[i] {
+			if visited[j] {
+				continue
+			}
+			visited[j] = true
+			if matchedTo[j] == -1 || augment(matchedTo[j]) {
+				matchedTo[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range slice1 {
+		clear(visited)
+		if !augment(i) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualWith checks if two slices have the same length and pairwise equal elements, in
+// order, as decided by the eq function.  It is a convenience over slices.EqualFunc for
+// slices of the same element type.
+func EqualWith[T any](slice1, slice2 []T, eq icompare.Equaler[T]) bool {
+	return stdslices.EqualFunc(slice1, slice2, eq)
+}
+
+// IsRotation checks if slice2 is a cyclic rotation of slice1, e.g. [3, 1, 2] is a rotation
+// of [1, 2, 3].  It runs in O(n), searching slice2 in slice1+slice1 with the
+// Knuth-Morris-Pratt algorithm.
+func IsRotation[T comparable](slice1, slice2 []T) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	if len(slice1) == 0 {
+		return true
+	}
+
+	// failure[i] is the length of the longest proper prefix of slice2[:i+1] that is
+	// also a suffix of it.
+	failure := make([]int, len(slice2))
+	for i, k := 1, 0; i < len(slice2); i++ {
+		for k > 0 && slice2[i] != slice2[k] {
+			k = failure[k-1]
+		}
+		if slice2[i] == slice2[k] {
+			k++
+		}
+		failure[i] = k
+	}
+
+	n := len(slice1)
+	for i, k := 0, 0; i < 2*n-1; i++ {
+		v := slice1[i%n]
+		for k > 0 && v != slice2[k] {
+			k = failure[k-1]
+		}
+		if v == slice2[k] {
+			k++
+		}
+		if k == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRotationWith is like IsRotation, but elements are compared with the eq function.
+// It runs in O(n^2).
+func IsRotationWith[T any](slice1, slice2 []T, eq icompare.Equaler[T]) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	if len(slice1) == 0 {
+		return true
+	}
+
+	n := len(slice1)
+	for shift := 0; shift < n; shift++ {
+		matched := true
+		for i := 0; i < n; i++ {
+			if !eq(slice1[(shift+i)%n], slice2[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPalindrome checks if the slice reads the same forwards and backwards.
+func IsPalindrome[T comparable](slice []T) bool {
+	return IsPalindromeWith(slice, func(item1, item2 T) bool {
+		return item1 == item2
+	})
+}
+
+// IsPalindromeWith is like IsPalindrome, but elements are compared with the eq function.
+func IsPalindromeWith[T any](slice []T, eq icompare.Equaler[T]) bool {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		if !eq(slice[i], slice[j]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Every return true if all of the values in the slice pass the predicate function.
 // Functionality from lancet(tm)
 func Every[T any](slice []T, predicate func(index int, item T) bool) bool {
@@ -207,6 +417,28 @@ func Filter[T any](slice []T, predicate func(index int, item T) bool) []T {
 	return stdslices.Clip(result)
 }
 
+// Compact returns a new slice without the zero values of the given slice (empty strings,
+// nil pointers, 0, ...).  Note that this differs from the standard library's
+// slices.Compact, which collapses consecutive duplicates (see DedupeConsecutive).
+func Compact[T comparable](slice []T) []T {
+	var zero T
+	return CompactBy(slice, func(item T) bool {
+		return item != zero
+	})
+}
+
+// CompactBy returns a new slice with only the elements for which keep returns true.
+// It is a convenience for Filter when the index is irrelevant.
+func CompactBy[T any](slice []T, keep func(item T) bool) []T {
+	result := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if keep(v) {
+			result = append(result, v)
+		}
+	}
+	return stdslices.Clip(result)
+}
+
 // Count returns the number of occurrences of the given item in the slice.
 func Count[T comparable](slice []T, item T) int {
 	count := 0
@@ -250,6 +482,128 @@ func GroupBy[T any, U comparable](slice []T, category func(item T) U) map[U][]T
 	return result
 }
 
+// GroupByWith is like GroupBy, but each element is transformed by the provided function
+// `value` before being added to its category.
+func GroupByWith[T any, K comparable, V any](slice []T, category func(item T) K, value func(item T) V) map[K][]V {
+	result := make(map[K][]V)
+
+	for _, v := range slice {
+		key := category(v)
+		result[key] = append(result[key], value(v))
+	}
+
+	return result
+}
+
+// GroupByOrdered is like GroupBy, but it also returns the categories in the order they
+// were first seen in the slice, so that the groups can be traversed deterministically.
+func GroupByOrdered[T any, K comparable](slice []T, category func(item T) K) (map[K][]T, []K) {
+	result := make(map[K][]T)
+	keys := make([]K, 0)
+
+	for _, v := range slice {
+		key := category(v)
+		if _, ok := result[key]; !ok {
+			keys = append(keys, key)
+		}
+		result[key] = append(result[key], v)
+	}
+
+	return result, keys
+}
+
+// GroupConsecutiveBy splits the slice in runs of adjacent elements for which the key
+// function returns the same key.  Unlike GroupBy, the original order is preserved and
+// equal keys that are not adjacent form separate groups.  The groups share memory with
+// the given slice.
+func GroupConsecutiveBy[T any, K comparable](slice []T, key func(item T) K) [][]T {
+	result := [][]T{}
+	if len(slice) == 0 {
+		return result
+	}
+
+	start := 0
+	last := key(slice[0])
+	for i := 1; i < len(slice); i++ {
+		k := key(slice[i])
+		if k != last {
+			result = append(result, slice[start:i:i])
+			start, last = i, k
+		}
+	}
+
+	return append(result, slice[start:len(slice):len(slice)])
+}
+
+// GroupByPairs is like GroupByOrdered, but the groups are returned as a slice of
+// (category, elements) pairs, in the order the categories were first seen.
+func GroupByPairs[T any, K comparable](slice []T, category func(item T) K) []ituples.Pair[K, []T] {
+	groups, keys := GroupByOrdered(slice, category)
+
+	result := make([]ituples.Pair[K, []T], len(keys))
+	for i, key := range keys {
+		result[i] = ituples.NewPair(key, groups[key])
+	}
+
+	return result
+}
+
+// FirstNonZero returns the first of the given items that is not the zero value of its
+// type, like SQL's COALESCE.  It returns the zero value and false if all items are zero.
+func FirstNonZero[T comparable](items ...T) (T, bool) {
+	var zero T
+	for _, v := range items {
+		if v != zero {
+			return v, true
+		}
+	}
+
+	return zero, false
+}
+
+// FirstOrDefault returns the first element of the slice, or fallback if the slice is empty.
+func FirstOrDefault[T any](slice []T, fallback T) T {
+	if len(slice) == 0 {
+		return fallback
+	}
+
+	return slice[0]
+}
+
+// FirstBy iterates over elements of slice, returning the first one that passes a truth
+// test on predicate function.  It returns the zero value and false if no item matched.
+func FirstBy[T any](slice []T, predicate func(index int, item T) bool) (v T, ok bool) {
+	index, ok := FindIndexBy(slice, predicate)
+	if !ok {
+		return v, false
+	}
+
+	return slice[index], true
+}
+
+// FindOpt returns the first element of the slice that passes the predicate function, as an
+// Optional which is empty if no element matched.  It is the Optional-returning alternative
+// to the (*T, bool) and (T, bool) conventions of FindLast and FirstBy.
+func FindOpt[T any](slice []T, predicate func(index int, item T) bool) ioptional.Optional[T] {
+	return ioptional.Of(FirstBy(slice, predicate))
+}
+
+// FirstOpt returns the first element of the slice, or an empty Optional if the slice is empty.
+func FirstOpt[T any](slice []T) ioptional.Optional[T] {
+	if len(slice) == 0 {
+		return ioptional.None[T]()
+	}
+	return ioptional.Some(slice[0])
+}
+
+// LastOpt returns the last element of the slice, or an empty Optional if the slice is empty.
+func LastOpt[T any](slice []T) ioptional.Optional[T] {
+	if len(slice) == 0 {
+		return ioptional.None[T]()
+	}
+	return ioptional.Some(slice[len(slice)-1])
+}
+
 // FindLast iterates over elements of slice from end to begin,
 // return the first one that passes a truth test on predicate function.
 // If return T is nil then no items matched the predicate func.
@@ -265,20 +619,36 @@ func FindLast[T any](slice []T, predicate func(index int, item T) bool) (*T, boo
 // In contrast to Find or FindLast, its return value no longer requires dereferencing
 // Play: https://go.dev/play/p/8iqomzyCl_s
 func FindLastBy[T any](slice []T, predicate func(index int, item T) bool) (v T, ok bool) {
-	index := -1
+	index, ok := FindLastIndexBy(slice, predicate)
+	if !ok {
+		return v, false
+	}
 
-	for i := len(slice) - 1; i >= 0; i-- {
-		if predicate(i, slice[i]) {
-			index = i
-			break
+	return slice[index], true
+}
+
+// FindIndexBy iterates over elements of slice, returning the index of the first one that
+// passes a truth test on predicate function.  If no item matches, it returns -1 and false.
+func FindIndexBy[T any](slice []T, predicate func(index int, item T) bool) (int, bool) {
+	for i, v := range slice {
+		if predicate(i, v) {
+			return i, true
 		}
 	}
 
-	if index == -1 {
-		return v, false
+	return -1, false
+}
+
+// FindLastIndexBy is like FindIndexBy, but it iterates over elements of slice from end to
+// begin, returning the index of the last element that passes the predicate function.
+func FindLastIndexBy[T any](slice []T, predicate func(index int, item T) bool) (int, bool) {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if predicate(i, slice[i]) {
+			return i, true
+		}
 	}
 
-	return slice[index], true
+	return -1, false
 }
 
 // Flatten flattens slice with one level.
@@ -323,6 +693,44 @@ func FlattenDeep(slice any) any {
 	return result.Interface()
 }
 
+// FlattenN flattens depth levels of nesting of the slice: 1 is like Flatten, and a depth
+// greater than or equal to the nesting of the slice is like FlattenDeep.  Unlike those,
+// it does not panic: it returns an error if slice is not a slice or depth is negative.
+// For slices of slices of T, slices.Concat is the type-safe way to flatten one level.
+func FlattenN(slice any, depth int) (any, error) {
+	sv := reflect.ValueOf(slice)
+	if sv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("FlattenN: input of type %T is not a slice", slice)
+	}
+	if depth < 0 {
+		return nil, fmt.Errorf("FlattenN: invalid depth %d, must not be negative", depth)
+	}
+
+	elemType := sv.Type().Elem()
+	levels := 0
+	for levels < depth && elemType.Kind() == reflect.Slice {
+		elemType = elemType.Elem()
+		levels++
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(elemType), 0, sv.Len())
+
+	return flattenLevels(sv, result, levels).Interface(), nil
+}
+
+// flattenLevels appends to result the elements of value, after flattening levels levels.
+func flattenLevels(value reflect.Value, result reflect.Value, levels int) reflect.Value {
+	for i := 0; i < value.Len(); i++ {
+		if levels == 0 {
+			result = reflect.Append(result, value.Index(i))
+		} else {
+			result = flattenLevels(value.Index(i), result, levels-1)
+		}
+	}
+
+	return result
+}
+
 func flattenRecursive(value reflect.Value, result reflect.Value) reflect.Value {
 	for i := 0; i < value.Len(); i++ {
 		item := value.Index(i)
@@ -422,6 +830,23 @@ func ReduceBy[T any, U any](slice []T, initial U, reducer func(index int, item T
 	return accumulator
 }
 
+// ReduceWhile is like ReduceBy, but the reducer also reports whether the reduction should
+// go on.  When it returns false, the reduction stops and the accumulator it returned is
+// the result; the remaining elements are not visited.
+func ReduceWhile[T any, U any](slice []T, initial U, reducer func(index int, item T, agg U) (U, bool)) U {
+	accumulator := initial
+
+	for i, v := range slice {
+		var more bool
+		accumulator, more = reducer(i, v, accumulator)
+		if !more {
+			break
+		}
+	}
+
+	return accumulator
+}
+
 // ReduceRight is like ReduceBy, but it iterates over elements of slice from right to left.
 // Play: https://go.dev/play/p/qT9dZC03A1K
 func ReduceRight[T any, U any](slice []T, initial U, reducer func(index int, item T, agg U) U) U {
@@ -468,57 +893,169 @@ func Repeat[T any](item T, n int) []T {
 	return result
 }
 
-// InterfaceSlice convert param to slice of interface.
-// deprecated: use generics feature of go1.18+ for replacement.
-// Play: https://go.dev/play/p/FdQXF0Vvqs-
-func InterfaceSlice(slice any) []any {
-	sv := sliceValue(slice)
-	if sv.IsNil() {
-		return nil
-	}
+// Generate creates a slice with length n whose elements are produced by the function f,
+// called with the index of each element.
+func Generate[T any](n int, f func(index int) T) []T {
+	result := make([]T, max(n, 0))
 
-	result := make([]any, sv.Len())
-	for i := 0; i < sv.Len(); i++ {
-		result[i] = sv.Index(i).Interface()
+	for i := range result {
+		result[i] = f(i)
 	}
 
 	return result
 }
 
-// StringSlice convert param to slice of string.
-// deprecated: use generics feature of go1.18+ for replacement.
-// Play: https://go.dev/play/p/W0TZDWCPFcI
-func StringSlice(slice any) []string {
-	v := sliceValue(slice)
+// Unfold builds a slice from a seed, as the constructive counterpart of ReduceBy.  The step
+// function receives the current seed and returns the next element, the next seed, and
+// whether the element should be added; the first false ends the unfolding (its element is
+// discarded).  Note that step must eventually return false for Unfold to terminate.
+func Unfold[S any, T any](seed S, step func(seed S) (T, S, bool)) []T {
+	result := []T{}
 
-	result := make([]string, v.Len())
-	for i := 0; i < v.Len(); i++ {
-		v, ok := v.Index(i).Interface().(string)
+	for {
+		item, next, ok := step(seed)
 		if !ok {
-			panic("invalid element type")
+			return result
 		}
-		result[i] = v
+		result = append(result, item)
+		seed = next
 	}
-
-	return result
 }
 
-// IntSlice convert param to slice of int.
-// deprecated: use generics feature of go1.18+ for replacement.
-// Play: https://go.dev/play/p/UQDj-on9TGN
-func IntSlice(slice any) []int {
-	sv := sliceValue(slice)
+// RangeOf creates a slice of the numbers from start (included) to end (excluded), spaced by
+// step.  A negative step produces a descending range.  If step is 0, or its sign does not
+// lead from start towards end, the result is empty.  For floating point types, every
+// element is computed as start + i*step, so rounding errors do not accumulate; the result
+// is also empty if an argument is infinite or NaN, or if step is too small to change start,
+// and RangeOf panics if the count of elements overflows int.  For integer types, the
+// range stops before overflowing.
+func RangeOf[T constraints.Integer | constraints.Float](start, end, step T) []T {
+	result := []T{}
 
-	result := make([]int, sv.Len())
-	for i := 0; i < sv.Len(); i++ {
-		v, ok := sv.Index(i).Interface().(int)
-		if !ok {
-			panic("invalid element type")
-		}
-		result[i] = v
+	// step != step detects NaN for floating point types.
+	if step == 0 || start != start || end != end || step != step {
+		return result
 	}
 
-	return result
+	ascending := step > 0
+	inRange := func(v T) bool {
+		if ascending {
+			return v < end
+		}
+		return v > end
+	}
+
+	if isFloat := T(1)/2 != 0; isFloat {
+		// Count the elements up front: with an infinite bound, or a step lost to rounding,
+		// the elements would never reach end.
+		count := math.Ceil((float64(end) - float64(start)) / float64(step))
+		switch {
+		case math.IsInf(float64(start), 0) || math.IsInf(float64(end), 0) || math.IsInf(float64(step), 0),
+			start+step == start, !(count > 0):
+			return result
+		case count >= math.MaxInt:
+			panic(fmt.Sprintf("RangeOf: %v elements do not fit in a slice", count))
+		}
+		result = make([]T, 0, int(count))
+		for i := range int(count) {
+			v := start + T(i)*step
+			if !inRange(v) {
+				break
+			}
+			result = append(result, v)
+		}
+		return result
+	}
+
+	for v := start; inRange(v); {
+		result = append(result, v)
+		next := v + step
+		if ascending == (next < v) {
+			break
+		}
+		v = next
+	}
+
+	return result
+}
+
+// Linspace creates a slice of n evenly spaced numbers over the closed interval [start, end].
+// It returns an empty slice when n is less than or equal to 0, and [start] when n is 1.
+func Linspace(start, end float64, n int) []float64 {
+	if n <= 0 {
+		return []float64{}
+	}
+	if n == 1 {
+		return []float64{start}
+	}
+
+	result := make([]float64, n)
+	for i := range result {
+		result[i] = start + (end-start)*float64(i)/float64(n-1)
+	}
+	result[n-1] = end
+
+	return result
+}
+
+// RepeatBy is like Repeat, but each element is built by calling factory with its index,
+// so elements do not alias each other (e.g. maps, slices or pointers).
+// It is Generate, named after Repeat.
+func RepeatBy[T any](n int, factory func(index int) T) []T {
+	return Generate(n, factory)
+}
+
+// InterfaceSlice convert param to slice of interface.
+// deprecated: use generics feature of go1.18+ for replacement.
+// Play: https://go.dev/play/p/FdQXF0Vvqs-
+func InterfaceSlice(slice any) []any {
+	sv := sliceValue(slice)
+	if sv.IsNil() {
+		return nil
+	}
+
+	result := make([]any, sv.Len())
+	for i := 0; i < sv.Len(); i++ {
+		result[i] = sv.Index(i).Interface()
+	}
+
+	return result
+}
+
+// StringSlice convert param to slice of string.
+// Deprecated: use generics feature of go1.18+ (e.g. Map) for replacement.
+// Play: https://go.dev/play/p/W0TZDWCPFcI
+func StringSlice(slice any) []string {
+	v := sliceValue(slice)
+
+	result := make([]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		v, ok := v.Index(i).Interface().(string)
+		if !ok {
+			panic("invalid element type")
+		}
+		result[i] = v
+	}
+
+	return result
+}
+
+// IntSlice convert param to slice of int.
+// Deprecated: use ConvertNumeric for replacement.
+// Play: https://go.dev/play/p/UQDj-on9TGN
+func IntSlice(slice any) []int {
+	sv := sliceValue(slice)
+
+	result := make([]int, sv.Len())
+	for i := 0; i < sv.Len(); i++ {
+		v, ok := sv.Index(i).Interface().(int)
+		if !ok {
+			panic("invalid element type")
+		}
+		result[i] = v
+	}
+
+	return result
 }
 
 // DeleteAt delete the element of slice at index.
@@ -627,6 +1164,8 @@ func DropRightWhile[T any](slice []T, predicate func(item T) bool) []T {
 }
 
 // InsertAt insert the value or other slice into slice at index.
+// If the index is out of range or the value has the wrong type, the slice is returned
+// unchanged; see InsertValueAt and InsertSliceAt for type-safe alternatives.
 // Play: https://go.dev/play/p/hMLNxPEGJVE
 func InsertAt[T any](slice []T, index int, value any) []T {
 	size := len(slice)
@@ -653,6 +1192,51 @@ func InsertAt[T any](slice []T, index int, value any) []T {
 	}
 }
 
+// InsertValueAt is like InsertAt, but it only accepts a value of the slice's element type.
+// It returns a new slice, or ErrIndexOutOfRange if index is not in [0, len(slice)].
+func InsertValueAt[T any](slice []T, index int, v T) ([]T, error) {
+	return InsertSliceAt(slice, index, []T{v})
+}
+
+// InsertSliceAt is like InsertAt, but it only accepts a slice of the slice's element type.
+// It returns a new slice, or ErrIndexOutOfRange if index is not in [0, len(slice)].
+func InsertSliceAt[T any](slice []T, index int, vs []T) ([]T, error) {
+	size := len(slice)
+
+	if index < 0 || index > size {
+		return nil, fmt.Errorf("insert at %d in slice of length %d: %w", index, size, ErrIndexOutOfRange)
+	}
+
+	result := make([]T, size+len(vs))
+	copy(result, slice[:index])
+	copy(result[index:], vs)
+	copy(result[index+len(vs):], slice[index:])
+
+	return result, nil
+}
+
+// Splice returns a copy of the slice where deleteCount elements starting at start are
+// replaced by items, with the semantics of JavaScript's Array.prototype.splice:
+// a negative start counts back from the end of the slice, start is clamped to
+// [0, len(slice)], and deleteCount is clamped to the number of elements after start.
+// Unlike its JavaScript counterpart, Splice does not modify the given slice.
+func Splice[T any](slice []T, start, deleteCount int, items ...T) []T {
+	size := len(slice)
+
+	if start < 0 {
+		start = max(size+start, 0)
+	}
+	start = min(start, size)
+	deleteCount = min(max(deleteCount, 0), size-start)
+
+	result := make([]T, 0, size-deleteCount+len(items))
+	result = append(result, slice[:start]...)
+	result = append(result, items...)
+	result = append(result, slice[start+deleteCount:]...)
+
+	return result
+}
+
 // UpdateAt update the slice element at index.
 // Play: https://go.dev/play/p/f3mh2KloWVm
 func UpdateAt[T any](slice []T, index int, value T) []T {
@@ -669,6 +1253,8 @@ func UpdateAt[T any](slice []T, index int, value T) []T {
 }
 
 // Unique remove duplicate elements in slice.
+// It returns a new slice; the given slice is not modified (see UniqueSorted for an
+// in-place alternative on sorted slices).
 // Play: https://go.dev/play/p/AXw0R3ZTE6a
 func Unique[T comparable](slice []T) []T {
 	if len(slice) == 0 {
@@ -676,7 +1262,7 @@ func Unique[T comparable](slice []T) []T {
 	}
 
 	seen := make(map[T]struct{}, len(slice))
-	result := slice[:0]
+	result := make([]T, 0, len(slice))
 
 	for _, item := range slice {
 		if _, exists := seen[item]; !exists {
@@ -689,7 +1275,7 @@ func Unique[T comparable](slice []T) []T {
 }
 
 // UniqueBy removes duplicate elements from the input slice based on the values returned by the iteratee function.
-// The function maintains the order of the elements.
+// The function maintains the order of the elements, and returns a new slice.
 // Play: https://go.dev/play/p/GY7JE4yikrl
 func UniqueBy[T any, U comparable](slice []T, iteratee func(item T) U) []T {
 	if len(slice) == 0 {
@@ -697,7 +1283,7 @@ func UniqueBy[T any, U comparable](slice []T, iteratee func(item T) U) []T {
 	}
 
 	seen := make(map[U]struct{}, len(slice))
-	result := slice[:0]
+	result := make([]T, 0, len(slice))
 
 	for _, item := range slice {
 		key := iteratee(item)
@@ -710,6 +1296,33 @@ func UniqueBy[T any, U comparable](slice []T, iteratee func(item T) U) []T {
 	return result
 }
 
+// DedupeConsecutive returns a new slice where runs of adjacent equal elements are collapsed
+// into a single element, like the Unix `uniq` command.  Unlike Unique, equal elements that
+// are not adjacent are all kept.  It is the non-mutating counterpart of slices.Compact.
+func DedupeConsecutive[T comparable](slice []T) []T {
+	return DedupeConsecutiveBy(slice, func(item T) T {
+		return item
+	})
+}
+
+// DedupeConsecutiveBy is like DedupeConsecutive, but adjacent elements are considered
+// duplicates when the iteratee function returns the same key for them.  The first element
+// of each run is kept.
+func DedupeConsecutiveBy[T any, U comparable](slice []T, iteratee func(item T) U) []T {
+	result := make([]T, 0, len(slice))
+
+	var last U
+	for i, item := range slice {
+		key := iteratee(item)
+		if i == 0 || key != last {
+			result = append(result, item)
+			last = key
+		}
+	}
+
+	return result
+}
+
 // UniqueByComparator removes duplicate elements from the input slice using the provided comparator function.
 // The function maintains the order of the elements.
 // Play: https://go.dev/play/p/rwSacr-ZHsR
@@ -812,9 +1425,52 @@ func UnionBy[T any, V comparable](predicate func(item T) V, slices ...[]T) []T {
 	return result
 }
 
+// Interleave creates a slice alternating the elements of the given slices: the first
+// element of each slice, then the second element of each slice, and so on.  Slices that
+// run out of elements are skipped, so inputs of unequal lengths are fully consumed.
+func Interleave[T any](slices ...[]T) []T {
+	return RoundRobin(1, slices...)
+}
+
+// RoundRobin is like Interleave, but it takes up to n consecutive elements from each
+// slice per turn.  If n is less than or equal to 0, it will be set to 1.
+func RoundRobin[T any](n int, slices ...[]T) []T {
+	if n <= 0 {
+		n = 1
+	}
+
+	size, longest := 0, 0
+	for _, slice := range slices {
+		size += len(slice)
+		longest = max(longest, len(slice))
+	}
+
+	result := make([]T, 0, size)
+
+	for offset := 0; offset < longest; offset += n {
+		for _, slice := range slices {
+			if offset >= len(slice) {
+				continue
+			}
+			result = append(result, slice[offset:min(offset+n, len(slice))]...)
+		}
+	}
+
+	return result
+}
+
 // Intersection creates a slice of unique elements that included by all slices.
 // Play: https://go.dev/play/p/anJXfB5wq_t
 func Intersection[T comparable](slices ...[]T) []T {
+	return IntersectionHint(slices, HintAuto)
+}
+
+// IntersectionHint is like Intersection, with the algorithm forced by hint.
+func IntersectionHint[T comparable](slices [][]T, hint SetHint) []T {
+	if useScan(hint, isSmall(slices)) {
+		return intersectionScan(slices)
+	}
+
 	result := []T{}
 	elementCount := make(map[T]int)
 
@@ -839,6 +1495,53 @@ func Intersection[T comparable](slices ...[]T) []T {
 	return result
 }
 
+// IntersectionCounting is like Intersection, but it respects multiplicities (multiset
+// semantics): an element appears in the result as many times as its smallest number of
+// occurrences among all slices.  The order follows the first slice.
+// E.g. [1, 1, 2] and [1, 1, 1] intersect to [1, 1].
+func IntersectionCounting[T comparable](slices ...[]T) []T {
+	result := []T{}
+	if len(slices) == 0 {
+		return result
+	}
+
+	remaining := Frequency(slices[0])
+	for _, slice := range slices[1:] {
+		counts := Frequency(slice)
+		for item, count := range remaining {
+			remaining[item] = min(count, counts[item])
+		}
+	}
+
+	for _, item := range slices[0] {
+		if remaining[item] > 0 {
+			result = append(result, item)
+			remaining[item]--
+		}
+	}
+
+	return result
+}
+
+// DifferenceCounting is like Difference, but it respects multiplicities (multiset
+// semantics): each occurrence of an element in comparedSlice removes a single occurrence
+// from slice.  The order follows slice.
+// E.g. [1, 1, 1, 2] minus [1, 2, 2] is [1, 1].
+func DifferenceCounting[T comparable](slice, comparedSlice []T) []T {
+	result := []T{}
+
+	toRemove := Frequency(comparedSlice)
+	for _, item := range slice {
+		if toRemove[item] > 0 {
+			toRemove[item]--
+			continue
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
+
 // SymmetricDifference oppoiste operation of intersection function.
 // Play: https://go.dev/play/p/h42nJX5xMln
 func SymmetricDifference[T comparable](slices ...[]T) []T {
@@ -866,6 +1569,89 @@ func SymmetricDifference[T comparable](slices ...[]T) []T {
 	return Unique(result)
 }
 
+// SymmetricDifferenceBy is like SymmetricDifference, but elements are compared through the
+// keys produced by the key function, so that slices of non-comparable elements (e.g.
+// structs) can be compared by an ID.  For each key not shared by all the slices, the
+// first element with that key is kept.  For two slices, it is equivalent to lodash's xorBy.
+func SymmetricDifferenceBy[T any, K comparable](key func(item T) K, slices ...[]T) []T {
+	result := []T{}
+	if len(slices) == 0 {
+		return result
+	}
+
+	sliceCount := make(map[K]int)
+	for _, slice := range slices {
+		seen := make(map[K]struct{}, len(slice))
+		for _, item := range slice {
+			k := key(item)
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				sliceCount[k]++
+			}
+		}
+	}
+
+	added := make(map[K]struct{})
+	for _, slice := range slices {
+		for _, item := range slice {
+			k := key(item)
+			if _, ok := added[k]; ok || (len(slices) > 1 && sliceCount[k] == len(slices)) {
+				continue
+			}
+			added[k] = struct{}{}
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// PowerSet returns a sequence lazily yielding all the subsets of the slice, as slices
+// preserving the order of the elements: first the empty subset, then all subsets of one
+// element, then of two elements, and so on.  The optional maxSize bounds the size of the
+// yielded subsets.  Every yielded slice is newly allocated and can be retained.
+// Note that a slice of n elements has 2^n subsets.
+func PowerSet[T any](slice []T, maxSize ...int) iter.Seq[[]T] {
+	limit := len(slice)
+	if len(maxSize) > 0 && maxSize[0] >= 0 {
+		limit = min(limit, maxSize[0])
+	}
+
+	return func(yield func([]T) bool) {
+		n := len(slice)
+		for k := 0; k <= limit; k++ {
+			// indexes holds the positions of the current combination of k elements.
+			indexes := make([]int, k)
+			for i := range indexes {
+				indexes[i] = i
+			}
+
+			for {
+				subset := make([]T, k)
+				for i, idx := range indexes {
+					subset[i] = slice[idx]
+				}
+				if !yield(subset) {
+					return
+				}
+
+				// advance to the next combination, in lexicographic order of indexes.
+				i := k - 1
+				for i >= 0 && indexes[i] == n-k+i {
+					i--
+				}
+				if i < 0 {
+					break
+				}
+				indexes[i]++
+				for j := i + 1; j < k; j++ {
+					indexes[j] = indexes[j-1] + 1
+				}
+			}
+		}
+	}
+}
+
 // Reverse return slice of element order is reversed to the given slice.
 // Play: https://go.dev/play/p/8uI8f1lwNrQ
 func Reverse[T any](slice []T) {
@@ -912,6 +1698,21 @@ func ShuffleCopy[T any](slice []T) []T {
 	return result
 }
 
+// ShuffleSecure returns a new slice with the elements of the given slice shuffled with a
+// Fisher-Yates shuffle drawing from crypto/rand.  Unlike Shuffle, the permutation is not
+// predictable, which makes it suitable for lotteries and draws.
+func ShuffleSecure[T any](slice []T) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+
+	for i := len(result) - 1; i > 0; i-- {
+		j := secureIntn(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result
+}
+
 // IsAscending checks if a slice is ascending order.
 // Play: https://go.dev/play/p/9CtsFjet4SH
 func IsAscending[T constraints.Ordered](slice []T) bool {
@@ -970,29 +1771,101 @@ func IsSortedByKey[T any, K constraints.Ordered](slice []T, iteratee func(item T
 	return isAscending(slice) || isDescending(slice)
 }
 
-// Sort sorts a slice of any ordered type(number or string), use quick sort algrithm.
+// Sort sorts a slice of any ordered type(number or string).
 // default sort order is ascending (asc), if want descending order, set param `sortOrder` to `desc`.
+// Deprecated: use SortOrdered for replacement.
 // Play: https://go.dev/play/p/V9AVjzf_4Fk
 func Sort[T constraints.Ordered](slice []T, sortOrder ...string) {
 	if len(sortOrder) > 0 && sortOrder[0] == "desc" {
-		quickSort(slice, 0, len(slice)-1, "desc")
+		SortOrdered(slice, Desc)
 	} else {
-		quickSort(slice, 0, len(slice)-1, "asc")
+		SortOrdered(slice, Asc)
+	}
+}
+
+// SortOrdered sorts a slice of any ordered type (number or string) in the given order.
+// It uses the pattern-defeating quicksort of the standard library, so it keeps its
+// O(n log n) bound on adversarial inputs.  This sort is not guaranteed to be stable.
+func SortOrdered[T constraints.Ordered](slice []T, order SortOrder) {
+	if order == Desc {
+		stdslices.SortFunc(slice, func(a, b T) int {
+			return cmp.Compare(b, a)
+		})
+		return
 	}
+	stdslices.Sort(slice)
 }
 
 // SortBy sorts the slice in ascending order as determined by the less function.
 // This sort is not guaranteed to be stable.
 // Play: https://go.dev/play/p/DAhLQSZEumm
 func SortBy[T any](slice []T, less func(a, b T) bool) {
-	quickSortBy(slice, 0, len(slice)-1, less)
+	stdslices.SortFunc(slice, func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// SortStableBy sorts the slice in ascending order as determined by the less function.
+// The sort is stable: elements that are equal under less keep their original order, which
+// allows multi-pass sorting (sort by the secondary key first, then by the primary key).
+func SortStableBy[T any](slice []T, less func(a, b T) bool) {
+	stdslices.SortStableFunc(slice, func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// SortStableByKey sorts the slice in ascending order of the keys produced by the key
+// function.  The sort is stable, and key is invoked once per comparison operand.
+func SortStableByKey[T any, K constraints.Ordered](slice []T, key func(item T) K) {
+	stdslices.SortStableFunc(slice, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// SortOrder is the direction in which a sort arranges its elements.
+type SortOrder int
+
+const (
+	// Asc sorts elements from the smallest to the greatest.
+	Asc SortOrder = iota
+	// Desc sorts elements from the greatest to the smallest.
+	Desc
+)
+
+// SortByKey sorts the slice by the keys produced by the key function, in the given order.
+// It is the type-safe replacement of SortByField: any ordered key works, including values
+// derived from non-ordered types (e.g. `t.UnixNano()` for a time.Time field).
+// This sort is not guaranteed to be stable.
+func SortByKey[T any, K constraints.Ordered](slice []T, key func(item T) K, order SortOrder) {
+	if order == Desc {
+		stdslices.SortFunc(slice, func(a, b T) int {
+			return cmp.Compare(key(b), key(a))
+		})
+		return
+	}
+
+	stdslices.SortFunc(slice, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
 }
 
 // SortByField return sorted slice by field
 // slice element should be struct, field type should be int, uint, string, or bool
 // default sortType is ascending (asc), if descending order, set sortType to desc
-// This function is deprecated, use Sort and SortBy for replacement.
 // Play: https://go.dev/play/p/fU1prOBP9p1
+// Deprecated: use SortByKey for replacement.
 func SortByField[T any](slice []T, field string, sortType ...string) error {
 	sv := sliceValue(slice)
 	t := sv.Type().Elem()
@@ -1062,6 +1935,110 @@ func SortByField[T any](slice []T, field string, sortType ...string) error {
 	return nil
 }
 
+// Transpose returns the transposition of a 2D slice: the element at [i][j] is moved to
+// [j][i].  All the rows must have the same length, otherwise an error is returned; see
+// TransposePadded for ragged rows.
+func Transpose[T any](matrix [][]T) ([][]T, error) {
+	for i, row := range matrix {
+		if len(row) != len(matrix[0]) {
+			return nil, fmt.Errorf("ragged rows: row %d has length %d, row 0 has length %d", i, len(row), len(matrix[0]))
+		}
+	}
+
+	var zero T
+	return TransposePadded(matrix, zero), nil
+}
+
+// TransposePadded is like Transpose, but it accepts ragged rows: missing elements are
+// filled with pad, as if every row had the length of the longest one.
+func TransposePadded[T any](matrix [][]T, pad T) [][]T {
+	cols := 0
+	for _, row := range matrix {
+		cols = max(cols, len(row))
+	}
+
+	result := make([][]T, cols)
+	for j := range result {
+		result[j] = make([]T, len(matrix))
+		for i, row := range matrix {
+			if j < len(row) {
+				result[j][i] = row[j]
+			} else {
+				result[j][i] = pad
+			}
+		}
+	}
+
+	return result
+}
+
+// LongestCommonPrefix returns the longest slice that is a prefix of all the given slices.
+// It returns an empty slice when no slices are given.
+func LongestCommonPrefix[T comparable](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return []T{}
+	}
+
+	n := len(slices[0])
+	for _, slice := range slices[1:] {
+		n = min(n, len(slice))
+		for i := 0; i < n; i++ {
+			if slice[i] != slices[0][i] {
+				n = i
+				break
+			}
+		}
+	}
+
+	return append([]T{}, slices[0][:n]...)
+}
+
+// LongestCommonSuffix returns the longest slice that is a suffix of all the given slices.
+// It returns an empty slice when no slices are given.
+func LongestCommonSuffix[T comparable](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return []T{}
+	}
+
+	first := slices[0]
+	n := len(first)
+	for _, slice := range slices[1:] {
+		n = min(n, len(slice))
+		for i := 1; i <= n; i++ {
+			if slice[len(slice)-i] != first[len(first)-i] {
+				n = i - 1
+				break
+			}
+		}
+	}
+
+	return append([]T{}, first[len(first)-n:]...)
+}
+
+// LongestCommonSubsequence returns the longest sequence of elements that appear, in the
+// same order but not necessarily contiguously, in both slices.  When several exist, only
+// one of them is returned.  The running time and memory are O(len(slice1) * len(slice2)).
+func LongestCommonSubsequence[T comparable](slice1, slice2 []T) []T {
+	table := lcsTable(slice1, slice2)
+	result := make([]T, 0, table[0][0])
+
+	i, j := 0, 0
+	for i < len(slice1) && j < len(slice2) {
+		switch {
+		case slice1[i] == slice2[j]:
+			result = append(result, slice1[i])
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return result
+}
+
 // Without creates a slice excluding all given items.
 // Play: https://go.dev/play/p/bwhEXEypThg
 func Without[T comparable](slice []T, items ...T) []T {
@@ -1080,69 +2057,10 @@ func Without[T comparable](slice []T, items ...T) []T {
 }
 
 // IndexOf returns the index at which the first occurrence of an item is found in a slice or return -1 if the item cannot be found.
+// The lookup is linear. For repeated lookups over the same slice, see Index.
 // Play: https://go.dev/play/p/MRN1f0FpABb
 func IndexOf[T comparable](arr []T, val T) int {
-	limit := 10
-	// gets the hash value of the array as the key of the hash table.
-	key := fmt.Sprintf("%p", arr)
-
-	muForMemoryHash.RLock()
-	// determines whether the hash table is empty. If so, the hash table is created.
-	if memoryHashMap[key] == nil {
-
-		muForMemoryHash.RUnlock()
-		muForMemoryHash.Lock()
-
-		if memoryHashMap[key] == nil {
-			memoryHashMap[key] = make(map[any]int)
-			// iterate through the array, adding the value and index of each element to the hash table.
-			for i := len(arr) - 1; i >= 0; i-- {
-				memoryHashMap[key][arr[i]] = i
-			}
-		}
-
-		muForMemoryHash.Unlock()
-	} else {
-		muForMemoryHash.RUnlock()
-	}
-
-	muForMemoryHash.Lock()
-	// update the hash table counter.
-	memoryHashCounter[key]++
-	muForMemoryHash.Unlock()
-
-	// use the hash table to find the specified value. If found, the index is returned.
-	muForMemoryHash.RLock()
-	index, ok := memoryHashMap[key][val]
-	muForMemoryHash.RUnlock()
-
-	if ok {
-		muForMemoryHash.RLock()
-		// calculate the memory usage of the hash table.
-		size := len(memoryHashMap)
-		muForMemoryHash.RUnlock()
-
-		// If the memory usage of the hash table exceeds the memory limit, the hash table with the lowest counter is cleared.
-		if size > limit {
-			muForMemoryHash.Lock()
-			var minKey string
-			var minVal int
-			for k, v := range memoryHashCounter {
-				if k == key {
-					continue
-				}
-				if minVal == 0 || v < minVal {
-					minKey = k
-					minVal = v
-				}
-			}
-			delete(memoryHashMap, minKey)
-			delete(memoryHashCounter, minKey)
-			muForMemoryHash.Unlock()
-		}
-		return index
-	}
-	return -1
+	return stdslices.Index(arr, val)
 }
 
 // LastIndexOf returns the index at which the last occurrence of the item is found in a slice or return -1 if the then cannot be found.
@@ -1157,6 +2075,64 @@ func LastIndexOf[T comparable](slice []T, item T) int {
 	return -1
 }
 
+// IndicesOf returns the indexes of all the occurrences of item in the slice, in ascending
+// order.  It returns an empty slice if the item cannot be found.
+func IndicesOf[T comparable](slice []T, item T) []int {
+	return IndicesBy(slice, func(_ int, v T) bool {
+		return v == item
+	})
+}
+
+// IndicesBy returns the indexes of all the elements that pass the predicate function, in
+// ascending order.
+func IndicesBy[T any](slice []T, predicate func(index int, item T) bool) []int {
+	result := []int{}
+
+	for i, v := range slice {
+		if predicate(i, v) {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+// Zip pairs up the elements of two slices by position.  The result is as long as the
+// shortest slice; extra elements are ignored.
+func Zip[A any, B any](slice1 []A, slice2 []B) []ituples.Pair[A, B] {
+	result := make([]ituples.Pair[A, B], min(len(slice1), len(slice2)))
+
+	for i := range result {
+		result[i] = ituples.NewPair(slice1[i], slice2[i])
+	}
+
+	return result
+}
+
+// Zip3 is like Zip, for three slices.
+func Zip3[A any, B any, C any](slice1 []A, slice2 []B, slice3 []C) []ituples.Triple[A, B, C] {
+	result := make([]ituples.Triple[A, B, C], min(len(slice1), len(slice2), len(slice3)))
+
+	for i := range result {
+		result[i] = ituples.NewTriple(slice1[i], slice2[i], slice3[i])
+	}
+
+	return result
+}
+
+// Unzip splits a slice of pairs into the slice of their first values and the slice of
+// their second values.  It is the inverse of Zip.
+func Unzip[A any, B any](pairs []ituples.Pair[A, B]) ([]A, []B) {
+	result1 := make([]A, len(pairs))
+	result2 := make([]B, len(pairs))
+
+	for i, p := range pairs {
+		result1[i], result2[i] = p.Unpack()
+	}
+
+	return result1, result2
+}
+
 // ToSlicePointer returns a pointer to the slices of a variable parameter transformation.
 // Play: https://go.dev/play/p/gx4tr6_VXSF
 func ToSlicePointer[T any](items ...T) []*T {
@@ -1215,6 +2191,189 @@ func KeyBy[T any, U comparable](slice []T, iteratee func(item T) U) map[U]T {
 	return result
 }
 
+// Associate converts a slice to a map, using the key-value pairs produced by the transform
+// function.  On key collision, the last value wins (like KeyBy).
+func Associate[T any, K comparable, V any](slice []T, transform func(item T) (K, V)) map[K]V {
+	result := make(map[K]V, len(slice))
+
+	for _, item := range slice {
+		k, v := transform(item)
+		result[k] = v
+	}
+
+	return result
+}
+
+// AssociateKeepFirst is like Associate, but on key collision the first value wins.
+func AssociateKeepFirst[T any, K comparable, V any](slice []T, transform func(item T) (K, V)) map[K]V {
+	result := make(map[K]V, len(slice))
+
+	for _, item := range slice {
+		k, v := transform(item)
+		if _, ok := result[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// AssociateMerge is like Associate, but on key collision the stored value is replaced by
+// the result of merge, called with the stored value and the colliding one.
+func AssociateMerge[T any, K comparable, V any](slice []T, transform func(item T) (K, V), merge func(existing, incoming V) V) map[K]V {
+	result := make(map[K]V, len(slice))
+
+	for _, item := range slice {
+		k, v := transform(item)
+		if existing, ok := result[k]; ok {
+			v = merge(existing, v)
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// AssociateStrict is like Associate, but a key collision is an error.  The returned error
+// wraps ErrDuplicateKey.
+func AssociateStrict[T any, K comparable, V any](slice []T, transform func(item T) (K, V)) (map[K]V, error) {
+	result := make(map[K]V, len(slice))
+
+	for i, item := range slice {
+		k, v := transform(item)
+		if _, ok := result[k]; ok {
+			return nil, fmt.Errorf("key %v at index %d: %w", k, i, ErrDuplicateKey)
+		}
+		result[k] = v
+	}
+
+	return result, nil
+}
+
+// ProcessInBatches splits the slice into consecutive batches of batchSize elements (the
+// last batch may be shorter) and calls fn on each of them, in order.  It stops on the
+// first error returned by fn and returns it.  The batches share memory with slice.
+func ProcessInBatches[T any](slice []T, batchSize int, fn func(batch []T) error) error {
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d, must be positive", batchSize)
+	}
+
+	for start := 0; start < len(slice); start += batchSize {
+		end := min(start+batchSize, len(slice))
+		if err := fn(slice[start:end:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Page returns the pageNum-th page of the slice, when split in pages of pageSize elements.
+// Pages are numbered from 1, and the last page may be shorter.  A pageNum less than 1 is
+// treated as 1; a page past the end of the slice, or a pageSize less than or equal to 0,
+// gives an empty slice.  The page shares memory with the given slice.
+func Page[T any](slice []T, pageNum, pageSize int) []T {
+	if pageSize <= 0 {
+		return []T{}
+	}
+	pageNum = max(pageNum, 1)
+
+	if (pageNum - 1) >= PageCount(len(slice), pageSize) {
+		return []T{}
+	}
+
+	start := (pageNum - 1) * pageSize
+	end := min(start+pageSize, len(slice))
+
+	return slice[start:end:end]
+}
+
+// PageCount returns the number of pages of pageSize elements needed to hold total
+// elements.  It returns 0 when pageSize is less than or equal to 0.
+func PageCount(total, pageSize int) int {
+	if pageSize <= 0 || total <= 0 {
+		return 0
+	}
+
+	return (total + pageSize - 1) / pageSize
+}
+
+// Paginate splits the slice in all of its pages of pageSize elements; the last page may
+// be shorter.  It returns an empty slice when pageSize is less than or equal to 0.
+// The pages share memory with the given slice.
+func Paginate[T any](slice []T, pageSize int) [][]T {
+	result := make([][]T, PageCount(len(slice), pageSize))
+
+	for i := range result {
+		result[i] = Page(slice, i+1, pageSize)
+	}
+
+	return result
+}
+
+// PackBy groups consecutive elements of the slice in chunks whose total weight, as given
+// by the weight function, does not exceed maxWeight (e.g. messages under a byte budget).
+// A new chunk is started whenever the next element does not fit in the current one; an
+// element heavier than maxWeight is placed alone in its own chunk.  The order of the
+// elements is preserved.
+func PackBy[T any](slice []T, maxWeight int, weight func(item T) int) [][]T {
+	result := [][]T{}
+
+	var chunk []T
+	chunkWeight := 0
+	for _, item := range slice {
+		w := weight(item)
+		if len(chunk) > 0 && chunkWeight+w > maxWeight {
+			result = append(result, chunk)
+			chunk, chunkWeight = nil, 0
+		}
+		chunk = append(chunk, item)
+		chunkWeight += w
+	}
+
+	if len(chunk) > 0 {
+		result = append(result, chunk)
+	}
+
+	return result
+}
+
+// PackByFirstFitDecreasing is like PackBy, but elements are not required to be consecutive
+// in a chunk: they are considered from the heaviest to the lightest, and each one is put
+// in the first chunk with enough room left (first-fit decreasing bin packing).  This
+// usually produces fewer chunks than PackBy, at the cost of the original order.
+func PackByFirstFitDecreasing[T any](slice []T, maxWeight int, weight func(item T) int) [][]T {
+	weights := make([]int, len(slice))
+	order := make([]int, len(slice))
+	for i, item := range slice {
+		weights[i] = weight(item)
+		order[i] = i
+	}
+	stdslices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(weights[b], weights[a])
+	})
+
+	result := [][]T{}
+	room := []int{}
+	for _, i := range order {
+		placed := false
+		for b := range result {
+			if weights[i] <= room[b] {
+				result[b] = append(result[b], slice[i])
+				room[b] -= weights[i]
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			result = append(result, []T{slice[i]})
+			room = append(room, maxWeight-weights[i])
+		}
+	}
+
+	return result
+}
+
 // Join the slice item with specify separator.
 // Play: https://go.dev/play/p/huKzqwNDD7V
 func Join[T any](slice []T, separator string) string {
@@ -1255,6 +2414,59 @@ func Partition[T any](slice []T, predicates ...func(item T) bool) [][]T {
 	return result
 }
 
+// Split slices the slice into all sub-slices separated by sep, and returns a slice of the
+// sub-slices between those separators, like strings.Split does for strings.
+// Consecutive separators produce empty sub-slices, and a slice with n separators always
+// produces n+1 sub-slices.  The sub-slices share memory with the given slice.
+func Split[T comparable](slice []T, sep T) [][]T {
+	return SplitWhen(slice, func(item T) bool {
+		return item == sep
+	})
+}
+
+// SplitWhen is like Split, but every element for which isSep returns true is a separator.
+func SplitWhen[T any](slice []T, isSep func(item T) bool) [][]T {
+	result := [][]T{}
+
+	start := 0
+	for i, v := range slice {
+		if isSep(v) {
+			result = append(result, slice[start:i:i])
+			start = i + 1
+		}
+	}
+
+	return append(result, slice[start:len(slice):len(slice)])
+}
+
+// PartitionInPlace stably rearranges the slice so that the elements that pass the
+// predicate come first, and returns the index of the first element that does not.
+// The relative order of the elements is preserved within both parts.
+// Unlike Partition, and as an exception to the purity of this package, it modifies the
+// given slice; in exchange it does not allocate.  It runs in O(n log n) time, and calls
+// predicate exactly once per element.
+func PartitionInPlace[T any](slice []T, predicate func(item T) bool) int {
+	switch len(slice) {
+	case 0:
+		return 0
+	case 1:
+		if predicate(slice[0]) {
+			return 1
+		}
+		return 0
+	}
+
+	mid := len(slice) / 2
+	left := PartitionInPlace(slice[:mid], predicate)
+	right := PartitionInPlace(slice[mid:], predicate)
+
+	// slice is now [left matches | left rejects | right matches | right rejects]:
+	// rotate the middle two blocks to bring the right matches forward.
+	rotateLeft(slice[left:mid+right], mid-left)
+
+	return left + right
+}
+
 // Breaks a list into two parts at the point where the predicate for the first time is true.
 // Play: https://go.dev/play/p/yLYcBTyeQIz
 func Break[T any](values []T, predicate func(T) bool) ([]T, []T) {
@@ -1290,6 +2502,17 @@ func Random[T any](slice []T) (val T, idx int) {
 	return slice[idx], idx
 }
 
+// RandomSecure is like Random, but the item is selected with crypto/rand.
+// It returns idx=-1 when slice is empty.
+func RandomSecure[T any](slice []T) (val T, idx int) {
+	if len(slice) == 0 {
+		return val, -1
+	}
+
+	idx = secureIntn(len(slice))
+	return slice[idx], idx
+}
+
 // RightPadding adds padding to the right end of a slice.
 // Play: https://go.dev/play/p/0_2rlLEMBXL
 func RightPadding[T any](slice []T, paddingValue T, paddingLength int) []T {
@@ -1334,6 +2557,95 @@ func Frequency[T comparable](slice []T) map[T]int {
 	return result
 }
 
+// Histogram counts the elements of the slice falling in each of the bins delimited by the
+// given boundaries, which must be sorted in ascending order.  With n boundaries, there are
+// n+1 bins: (-inf, b[0]), [b[0], b[1]), ..., [b[n-1], +inf).  The returned slice holds the
+// count of each bin, in that order.
+func Histogram[T constraints.Ordered](slice []T, boundaries []T) []int {
+	return HistogramBy(slice, len(boundaries)+1, func(item T) int {
+		return sort.Search(len(boundaries), func(i int) bool {
+			return boundaries[i] > item
+		})
+	})
+}
+
+// HistogramBy counts the elements of the slice falling in each of numBins bins, as
+// assigned by the bin function.  Elements assigned to a bin outside of [0, numBins) are
+// not counted.
+func HistogramBy[T any](slice []T, numBins int, bin func(item T) int) []int {
+	result := make([]int, max(numBins, 0))
+
+	for _, v := range slice {
+		if b := bin(v); b >= 0 && b < len(result) {
+			result[b]++
+		}
+	}
+
+	return result
+}
+
+// Counted is a value along with its number of occurrences, as returned by MostCommon.
+type Counted[T any] struct {
+	Value T
+	Count int
+}
+
+// MostCommon returns the n most frequent elements of the slice with their number of
+// occurrences, sorted by decreasing count; ties are broken by first occurrence in the
+// slice.  A negative n returns all the distinct elements.
+func MostCommon[T comparable](slice []T, n int) []Counted[T] {
+	counts := make(map[T]int)
+	result := []Counted[T]{}
+
+	for _, v := range slice {
+		if counts[v] == 0 {
+			result = append(result, Counted[T]{Value: v})
+		}
+		counts[v]++
+	}
+
+	for i := range result {
+		result[i].Count = counts[result[i].Value]
+	}
+
+	// result is in first-occurrence order, which the stable sort keeps for ties.
+	stdslices.SortStableFunc(result, func(a, b Counted[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	if n >= 0 && n < len(result) {
+		result = result[:n:n]
+	}
+
+	return result
+}
+
+// Majority returns the element occurring in strictly more than half of the slice, if any.
+// It uses the Boyer-Moore voting algorithm: two passes, O(n) time and O(1) space.
+// It returns the zero value and false when no majority element exists.
+func Majority[T comparable](slice []T) (T, bool) {
+	var candidate T
+	votes := 0
+
+	for _, v := range slice {
+		switch {
+		case votes == 0:
+			candidate, votes = v, 1
+		case v == candidate:
+			votes++
+		default:
+			votes--
+		}
+	}
+
+	if votes > 0 && Count(slice, candidate)*2 > len(slice) {
+		return candidate, true
+	}
+
+	var zero T
+	return zero, false
+}
+
 // JoinFunc joins the slice elements into a single string with the given separator.
 // Play: https://go.dev/play/p/55ib3SB5fM2
 func JoinFunc[T any](slice []T, sep string, transform func(T) T) string {
@@ -0,0 +1,44 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	stdslices "slices"
+
+	"github.com/idichekop/gods/internal/ibinary"
+)
+
+// MarshalBinary encodes the elements of the slice.
+func (s ImmutableSlice[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(s.Values())
+}
+
+// UnmarshalBinary sets s to a new ImmutableSlice holding the elements encoded by
+// MarshalBinary.  The previous backing array is left untouched.
+func (s *ImmutableSlice[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	s.items = items
+	return nil
+}
+
+// MarshalBinary encodes the elements of the slice, in ascending order.
+func (s *SortedSlice[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(s.All())
+}
+
+// UnmarshalBinary replaces the contents of the slice with the elements encoded by
+// MarshalBinary, which need not be sorted.
+func (s *SortedSlice[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	stdslices.Sort(items)
+	s.items = items
+	return nil
+}
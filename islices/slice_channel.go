@@ -0,0 +1,128 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// ToChannel returns a channel receiving the elements of the slice, in order, sent by a
+// new goroutine.  The channel is closed after the last element, or as soon as ctx is
+// done, in which case the goroutine exits without sending the remaining elements.
+func ToChannel[T any](ctx context.Context, slice []T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, v := range slice {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Collect receives values from ch into a new slice until ch is closed or max values
+// were received; a non-positive max means no limit.  When ctx is done first, it returns
+// the values received so far and ctx.Err().
+func Collect[T any](ctx context.Context, ch <-chan T, max int) ([]T, error) {
+	result := []T{}
+	for max <= 0 || len(result) < max {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return result, nil
+			}
+			result = append(result, v)
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+	return result, nil
+}
+
+// FanOut distributes the values received from in over n channels, for n workers to
+// consume concurrently: each value is sent to exactly one output, whichever is ready
+// first.  A single goroutine dispatches the values, so a slow or absent consumer never
+// holds one back.  All the outputs are closed when in is closed or ctx is done.
+// FanOut panics if n is less than 1.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic("FanOut: number of outputs must be positive")
+	}
+	outs := make([]<-chan T, n)
+	// cases offers a value to every output at once, the last case waiting for ctx.
+	cases := make([]reflect.SelectCase, n+1)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(out)}
+	}
+	cases[n] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+
+	go func() {
+		defer func() {
+			for _, c := range cases[:n] {
+				c.Chan.Close()
+			}
+		}()
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				value := reflect.ValueOf(&v).Elem()
+				for i := range n {
+					cases[i].Send = value
+				}
+				if chosen, _, _ := reflect.Select(cases); chosen == n {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return outs
+}
+
+// FanIn merges the values received from all the channels into one channel.  The order
+// of values is kept per input channel only.  The output is closed when all the inputs
+// are closed or ctx is done.
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range ins {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case v, ok := <-in:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
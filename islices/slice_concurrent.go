@@ -4,8 +4,13 @@
 package islice
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"runtime"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 )
 
 // ForEachConcurrent applies the iteratee function to each item in the slice concurrently.
@@ -76,6 +81,174 @@ func MapConcurrent[T any, U any](slice []T, iteratee func(index int, item T) U,
 	return result
 }
 
+// MapConcurrentCtx applies the iteratee function to each item in the slice concurrently,
+// with at most concurrency calls in flight at any time.  It is intended for I/O-bound
+// iteratees, such as one network call per element.
+// The result is index-aligned with the slice.  On the first error, the context passed to
+// the iteratees is cancelled, no further calls are started, and that error is returned.
+// If ctx is done before all the elements were processed, ctx.Err() is returned.
+// If concurrency is less than or equal to 0, it will be set to 1.
+func MapConcurrentCtx[T any, U any](ctx context.Context, slice []T, concurrency int, iteratee func(ctx context.Context, item T) (U, error)) ([]U, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result := make([]U, len(slice))
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	workerChan := make(chan struct{}, concurrency)
+
+	for index, item := range slice {
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case workerChan <- struct{}{}:
+		case <-ctx.Done():
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, v T) {
+			defer wg.Done()
+			defer func() { <-workerChan }()
+
+			u, err := iteratee(ctx, v)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			result[i] = u
+		}(index, item)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ErrorMode selects how ForEachParallel reports the errors of its iteratee.
+type ErrorMode int
+
+const (
+	// FirstError stops at the first error: the context passed to the iteratees is
+	// cancelled, no further calls are started, and that error is returned.
+	FirstError ErrorMode = iota
+	// AllErrors calls the iteratee for every element, and returns the errors combined
+	// with errors.Join, in index order.
+	AllErrors
+)
+
+// PanicError is the error a panic of the iteratee of ForEachParallel is turned into.
+type PanicError struct {
+	// Index is the index of the element the iteratee panicked on.
+	Index int
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+// Error returns the index and the panic value.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic at index %d: %v", e.Index, e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, and nil otherwise.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// ForEachParallel calls the iteratee for each item in the slice on a pool of concurrency
+// goroutines, the errgroup way.  A panic of the iteratee is recovered into a *PanicError.
+// The optional mode, FirstError by default, tells whether the first error stops the
+// processing or all the errors are collected.  If ctx is done before all the elements
+// were processed, ctx.Err() is returned too.
+// If concurrency is less than or equal to 0, it will be set to 1.
+func ForEachParallel[T any](ctx context.Context, slice []T, concurrency int, iteratee func(ctx context.Context, index int, item T) error, mode ...ErrorMode) error {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	failFast := len(mode) == 0 || mode[0] == FirstError
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make([]error, len(slice))
+	var next atomic.Int64
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+
+	for range min(concurrency, len(slice)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= len(slice) {
+					return
+				}
+				err := callRecovered(ctx, i, slice[i], iteratee)
+				if err == nil {
+					continue
+				}
+				errs[i] = err
+				if failFast {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	var ctxErr error
+	if int(next.Load()) < len(slice) {
+		ctxErr = parent.Err()
+	}
+	if failFast {
+		if firstErr != nil {
+			return firstErr
+		}
+		return ctxErr
+	}
+	return errors.Join(append(errs, ctxErr)...)
+}
+
+// callRecovered calls the iteratee, and turns its panic, if any, into a *PanicError.
+func callRecovered[T any](ctx context.Context, index int, item T, iteratee func(ctx context.Context, index int, item T) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Index: index, Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return iteratee(ctx, index, item)
+}
+
 // ReduceConcurrent reduces the slice to a single value by applying the reducer function to each item in the slice concurrently.
 // Play: https://go.dev/play/p/Tjwe6OtaG07
 func ReduceConcurrent[T any](slice []T, initial T, reducer func(index int, item T, agg T) T, numThreads int) T {
@@ -234,3 +407,49 @@ func UniqueByConcurrent[T comparable](slice []T, comparator func(item T, other T
 
 	return result
 }
+
+// ProcessInBatchesConcurrent is like ProcessInBatches, but the batches are processed by up
+// to numThreads goroutines.  All batches are processed even when some fail; the returned
+// error is the one of the earliest failing batch.
+// If numThreads is less than or equal to 0, it will be set to 1.
+func ProcessInBatchesConcurrent[T any](slice []T, batchSize int, fn func(batch []T) error, numThreads int) error {
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d, must be positive", batchSize)
+	}
+
+	if numThreads <= 0 {
+		numThreads = 1
+	}
+
+	numBatches := (len(slice) + batchSize - 1) / batchSize
+	errs := make([]error, numBatches)
+
+	var wg sync.WaitGroup
+	workerChan := make(chan struct{}, numThreads)
+
+	for i := 0; i < numBatches; i++ {
+		start := i * batchSize
+		end := min(start+batchSize, len(slice))
+
+		wg.Add(1)
+		workerChan <- struct{}{}
+
+		go func(i int, batch []T) {
+			defer wg.Done()
+
+			errs[i] = fn(batch)
+
+			<-workerChan
+		}(i, slice[start:end:end])
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"context"
+	stdslices "slices"
+)
+
+// ForEachCtx is like ForEach, but it checks ctx before each element and returns ctx.Err()
+// as soon as the context is done.  It returns nil when all the elements were visited.
+func ForEachCtx[T any](ctx context.Context, slice []T, iteratee func(index int, item T)) error {
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		iteratee(i, v)
+	}
+
+	return nil
+}
+
+// MapCtx is like Map, but it checks ctx before each element.  When the context is done,
+// it returns a nil slice and ctx.Err().
+func MapCtx[T any, U any](ctx context.Context, slice []T, iteratee func(index int, item T) U) ([]U, error) {
+	result := make([]U, len(slice))
+
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result[i] = iteratee(i, v)
+	}
+
+	return result, nil
+}
+
+// FilterCtx is like Filter, but it checks ctx before each element.  When the context is
+// done, it returns a nil slice and ctx.Err().
+func FilterCtx[T any](ctx context.Context, slice []T, predicate func(index int, item T) bool) ([]T, error) {
+	result := make([]T, 0, len(slice))
+
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if predicate(i, v) {
+			result = append(result, v)
+		}
+	}
+
+	return stdslices.Clip(result), nil
+}
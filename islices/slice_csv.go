@@ -0,0 +1,328 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// CSVOptions configures ToCSV and FromCSV.  The zero CSVOptions writes and reads
+// comma-separated records preceded by a header row.
+type CSVOptions struct {
+	// Comma is the field delimiter.  Zero means ','.
+	Comma rune
+	// NoHeader omits the header row: the columns are then the fields in declaration
+	// order.
+	NoHeader bool
+	// Formatters convert the values of some columns to text, by column name, instead of
+	// the default conversion.  A formatter receives the value of the field.
+	Formatters map[string]func(value any) (string, error)
+	// Parsers convert the text of some columns back to values, by column name, instead of
+	// the default conversion.  A parser must return a value assignable to the field.
+	Parsers map[string]func(text string) (any, error)
+}
+
+// csvColumn is a struct field mapped to a column.
+type csvColumn struct {
+	name  string
+	index []int
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// ToCSV writes the slice to w as CSV records, one per element, preceded by a header row
+// unless opts says otherwise.  T must be a struct, or a pointer to one; nil pointers are
+// skipped.  Each exported field is a column, named after its csv tag or else after the
+// field; a field tagged csv:"-" is skipped.  The fields of embedded structs are columns
+// of their own, unless the struct is embedded by pointer.
+//
+// Fields are converted to text by the Formatters of opts, else by their MarshalText
+// method, else according to their kind: strings, booleans and numbers.  A nil pointer
+// field writes an empty cell.
+func ToCSV[T any](w io.Writer, slice []T, opts ...CSVOptions) error {
+	o := csvOptions(opts)
+	columns, err := csvColumns(reflect.TypeFor[T]())
+	if err != nil {
+		return fmt.Errorf("ToCSV: %w", err)
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = o.Comma
+	if !o.NoHeader {
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = c.name
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(columns))
+	for n, item := range slice {
+		v := reflect.ValueOf(&item).Elem()
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				continue
+			}
+			v = v.Elem()
+		}
+		for i, c := range columns {
+			cell, err := formatCSVField(v.FieldByIndex(c.index), o.Formatters[c.name])
+			if err != nil {
+				return fmt.Errorf("ToCSV: element %d, column %q: %w", n, c.name, err)
+			}
+			record[i] = cell
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// FromCSV reads CSV records from r and decodes each into an element, the reverse of
+// ToCSV with the same options.  With a header row, columns are matched to fields by name,
+// in any order: unknown columns are ignored, and fields without a column keep their zero
+// value.  An empty cell sets a pointer field to nil.  Errors tell the line of the
+// offending cell.
+func FromCSV[T any](r io.Reader, opts ...CSVOptions) ([]T, error) {
+	o := csvOptions(opts)
+	t := reflect.TypeFor[T]()
+	columns, err := csvColumns(t)
+	if err != nil {
+		return nil, fmt.Errorf("FromCSV: %w", err)
+	}
+
+	cr := csv.NewReader(r)
+	cr.Comma = o.Comma
+	cr.ReuseRecord = true
+
+	// order maps each column of the input to a struct column, or to nil if it is ignored.
+	order := make([]*csvColumn, len(columns))
+	for i := range columns {
+		order[i] = &columns[i]
+	}
+	if o.NoHeader {
+		cr.FieldsPerRecord = len(columns)
+	} else {
+		header, err := cr.Read()
+		if err == io.EOF {
+			return []T{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("FromCSV: %w", err)
+		}
+		order = make([]*csvColumn, len(header))
+		for i, name := range header {
+			for j := range columns {
+				if columns[j].name == name {
+					order[i] = &columns[j]
+				}
+			}
+		}
+	}
+
+	result := []T{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("FromCSV: %w", err)
+		}
+
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+		if v.Kind() == reflect.Pointer {
+			v.Set(reflect.New(t.Elem()))
+			v = v.Elem()
+		}
+		for i, cell := range record {
+			c := order[i]
+			if c == nil {
+				continue
+			}
+			if err := parseCSVField(v.FieldByIndex(c.index), cell, o.Parsers[c.name]); err != nil {
+				line, _ := cr.FieldPos(i)
+				return nil, fmt.Errorf("FromCSV: line %d, column %q: %w", line, c.name, err)
+			}
+		}
+		result = append(result, item)
+	}
+}
+
+// ToTSV is ToCSV with tab-separated fields.
+func ToTSV[T any](w io.Writer, slice []T, opts ...CSVOptions) error {
+	o := csvOptions(opts)
+	o.Comma = '\t'
+	return ToCSV(w, slice, o)
+}
+
+// FromTSV is FromCSV with tab-separated fields.
+func FromTSV[T any](r io.Reader, opts ...CSVOptions) ([]T, error) {
+	o := csvOptions(opts)
+	o.Comma = '\t'
+	return FromCSV[T](r, o)
+}
+
+func csvOptions(opts []CSVOptions) CSVOptions {
+	var o CSVOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.Comma == 0 {
+		o.Comma = ','
+	}
+	return o
+}
+
+// csvColumns returns the columns of the struct type t, or of the struct t points to.
+func csvColumns(t reflect.Type) ([]csvColumn, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("type %s is not a struct", t)
+	}
+
+	var columns []csvColumn
+	seen := map[string]bool{}
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous || throughPointer(t, f.Index) {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		seen[name] = true
+		columns = append(columns, csvColumn{name: name, index: f.Index})
+	}
+	return columns, nil
+}
+
+// throughPointer reports whether the field at index is promoted from a struct embedded by
+// pointer.  Such fields are skipped, as the pointer may be nil.
+func throughPointer(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() == reflect.Pointer {
+			return true
+		}
+	}
+	return false
+}
+
+func formatCSVField(v reflect.Value, formatter func(value any) (string, error)) (string, error) {
+	if formatter != nil {
+		return formatter(v.Interface())
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(textMarshalerType) {
+		text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+func parseCSVField(v reflect.Value, text string, parser func(text string) (any, error)) error {
+	if parser != nil {
+		value, err := parser(text)
+		if err != nil {
+			return err
+		}
+		parsed := reflect.ValueOf(value)
+		if !parsed.IsValid() || !parsed.Type().AssignableTo(v.Type()) {
+			return fmt.Errorf("parser returned %T, want %s", value, v.Type())
+		}
+		v.Set(parsed)
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		if text == "" {
+			v.SetZero()
+			return nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+	}
+
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(text)
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		n, err = strconv.ParseInt(text, 10, v.Type().Bits())
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		n, err = strconv.ParseUint(text, 10, v.Type().Bits())
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(text, v.Type().Bits())
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	if err != nil {
+		// The strconv errors repeat the function name and the text: keep their cause.
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = fmt.Errorf("invalid value %q: %w", text, numErr.Err)
+		}
+	}
+	return err
+}
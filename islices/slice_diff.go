@@ -0,0 +1,111 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import "fmt"
+
+// EditOp is the kind of operation of an Edit.
+type EditOp int
+
+const (
+	// EditKeep keeps an element of the old slice.
+	EditKeep EditOp = iota
+	// EditDelete removes an element of the old slice.
+	EditDelete
+	// EditInsert adds an element of the new slice.
+	EditInsert
+)
+
+// String returns the name of the operation.
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	default:
+		return fmt.Sprintf("EditOp(%d)", int(op))
+	}
+}
+
+// Edit is a single step of an edit script produced by Diff.
+// OldIndex and NewIndex are the positions in the old and new slices at which the edit
+// applies; Value is the element kept, deleted, or inserted.
+type Edit[T any] struct {
+	Op       EditOp
+	OldIndex int
+	NewIndex int
+	Value    T
+}
+
+// Diff computes a minimal edit script turning oldSlice into newSlice, based on their longest
+// common subsequence.  The script lists every element of both slices, in order, as kept,
+// deleted, or inserted.  When both a deletion and an insertion are possible, deletions come
+// first.  The running time and memory are O(len(oldSlice) * len(newSlice)).
+func Diff[T comparable](oldSlice, newSlice []T) []Edit[T] {
+	table := lcsTable(oldSlice, newSlice)
+	result := make([]Edit[T], 0, len(oldSlice)+len(newSlice)-table[0][0])
+
+	i, j := 0, 0
+	for i < len(oldSlice) && j < len(newSlice) {
+		switch {
+		case oldSlice[i] == newSlice[j]:
+			result = append(result, Edit[T]{EditKeep, i, j, oldSlice[i]})
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			result = append(result, Edit[T]{EditDelete, i, j, oldSlice[i]})
+			i++
+		default:
+			result = append(result, Edit[T]{EditInsert, i, j, newSlice[j]})
+			j++
+		}
+	}
+	for ; i < len(oldSlice); i++ {
+		result = append(result, Edit[T]{EditDelete, i, j, oldSlice[i]})
+	}
+	for ; j < len(newSlice); j++ {
+		result = append(result, Edit[T]{EditInsert, i, j, newSlice[j]})
+	}
+
+	return result
+}
+
+// ApplyPatch applies an edit script, as produced by Diff, to the given slice and returns
+// the resulting new slice.  Edits are applied in order; their indexes are ignored, but the
+// values of kept and deleted elements must match the slice, and the script must consume
+// the whole slice.  Otherwise an error is returned.
+func ApplyPatch[T comparable](slice []T, edits []Edit[T]) ([]T, error) {
+	result := make([]T, 0, len(slice))
+
+	i := 0
+	for n, edit := range edits {
+		switch edit.Op {
+		case EditKeep, EditDelete:
+			if i >= len(slice) {
+				return nil, fmt.Errorf("edit %d: %s past the end of the slice", n, edit.Op)
+			}
+			if slice[i] != edit.Value {
+				return nil, fmt.Errorf("edit %d: %s of %v does not match element %v at index %d", n, edit.Op, edit.Value, slice[i], i)
+			}
+			if edit.Op == EditKeep {
+				result = append(result, slice[i])
+			}
+			i++
+		case EditInsert:
+			result = append(result, edit.Value)
+		default:
+			return nil, fmt.Errorf("edit %d: unknown operation %s", n, edit.Op)
+		}
+	}
+
+	if i != len(slice) {
+		return nil, fmt.Errorf("patch consumed %d of %d elements", i, len(slice))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"errors"
+	"fmt"
+	stdslices "slices"
+)
+
+// MapErr is like Map, but the iteratee may fail.  It stops at the first error and returns
+// a nil slice and that error.
+func MapErr[T any, U any](slice []T, iteratee func(index int, item T) (U, error)) ([]U, error) {
+	result := make([]U, len(slice))
+
+	for i, v := range slice {
+		u, err := iteratee(i, v)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = u
+	}
+
+	return result, nil
+}
+
+// MapErrAll is like MapErr, but it visits every element.  Elements whose iteratee failed
+// hold the zero value of U in the result, and the errors are combined with errors.Join.
+func MapErrAll[T any, U any](slice []T, iteratee func(index int, item T) (U, error)) ([]U, error) {
+	result := make([]U, len(slice))
+	var errs []error
+
+	for i, v := range slice {
+		u, err := iteratee(i, v)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result[i] = u
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// FilterErr is like Filter, but the predicate may fail.  It stops at the first error and
+// returns a nil slice and that error.
+func FilterErr[T any](slice []T, predicate func(index int, item T) (bool, error)) ([]T, error) {
+	result := make([]T, 0, len(slice))
+
+	for i, v := range slice {
+		ok, err := predicate(i, v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+
+	return stdslices.Clip(result), nil
+}
+
+// FilterErrAll is like FilterErr, but it visits every element.  Elements whose predicate
+// failed are left out of the result, and the errors are combined with errors.Join.
+func FilterErrAll[T any](slice []T, predicate func(index int, item T) (bool, error)) ([]T, error) {
+	result := make([]T, 0, len(slice))
+	var errs []error
+
+	for i, v := range slice {
+		ok, err := predicate(i, v)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+
+	return stdslices.Clip(result), errors.Join(errs...)
+}
+
+// ForEachErr is like ForEach, but the iteratee may fail.  It stops at the first error and
+// returns it.
+func ForEachErr[T any](slice []T, iteratee func(index int, item T) error) error {
+	for i, v := range slice {
+		if err := iteratee(i, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ForEachErrAll is like ForEachErr, but it visits every element and combines the errors
+// with errors.Join.
+func ForEachErrAll[T any](slice []T, iteratee func(index int, item T) error) error {
+	var errs []error
+
+	for i, v := range slice {
+		if err := iteratee(i, v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DeleteAtE is like DeleteAt, but it returns ErrIndexOutOfRange instead of dropping the
+// last element when index is not in [0, len(slice)).
+func DeleteAtE[T any](slice []T, index int) ([]T, error) {
+	if index < 0 || index >= len(slice) {
+		return nil, fmt.Errorf("delete at %d in slice of length %d: %w", index, len(slice), ErrIndexOutOfRange)
+	}
+
+	return DeleteAt(slice, index), nil
+}
+
+// UpdateAtE is like UpdateAt, but it returns ErrIndexOutOfRange instead of the unchanged
+// slice when index is not in [0, len(slice)).
+func UpdateAtE[T any](slice []T, index int, value T) ([]T, error) {
+	if index < 0 || index >= len(slice) {
+		return nil, fmt.Errorf("update at %d in slice of length %d: %w", index, len(slice), ErrIndexOutOfRange)
+	}
+
+	return UpdateAt(slice, index, value), nil
+}
+
+// DeleteRangeE is like DeleteRange, but it returns ErrIndexOutOfRange instead of panicking
+// unless 0 <= start <= end <= len(slice).
+func DeleteRangeE[T any](slice []T, start, end int) ([]T, error) {
+	if start < 0 || start > end || end > len(slice) {
+		return nil, fmt.Errorf("delete range [%d, %d) in slice of length %d: %w", start, end, len(slice), ErrIndexOutOfRange)
+	}
+
+	return DeleteRange(slice, start, end), nil
+}
+
+// InsertAtE is like InsertAt, but it returns ErrIndexOutOfRange when index is not in
+// [0, len(slice)], and an error when value is neither a T nor a []T.  A []T is always
+// spread, even when T is an interface type such as any.  A nil value, which has no type,
+// inserts the zero T, such as a nil pointer or interface.
+func InsertAtE[T any](slice []T, index int, value any) ([]T, error) {
+	switch v := value.(type) {
+	case nil:
+		return InsertValueAt(slice, index, *new(T))
+	case []T:
+		return InsertSliceAt(slice, index, v)
+	case T:
+		return InsertValueAt(slice, index, v)
+	default:
+		return nil, fmt.Errorf("insert at %d: value of type %T is neither %T nor %T", index, value, *new(T), []T(nil))
+	}
+}
@@ -1,6 +1,8 @@
 package islice
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -184,6 +186,143 @@ func ExampleGroupBy() {
 	// map[4:[4.2] 6:[6.1 6.3]]
 }
 
+func ExampleGroupByWith() {
+	words := []string{"apple", "avocado", "banana"}
+
+	firstLetter := func(s string) string {
+		return s[:1]
+	}
+	length := func(s string) int {
+		return len(s)
+	}
+
+	result := GroupByWith(words, firstLetter, length)
+
+	fmt.Println(result)
+
+	// Output:
+	// map[a:[5 7] b:[6]]
+}
+
+func ExampleGroupByOrdered() {
+	nums := []int{5, 2, 8, 3}
+
+	parity := func(n int) string {
+		if n%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	groups, keys := GroupByOrdered(nums, parity)
+
+	for _, key := range keys {
+		fmt.Println(key, groups[key])
+	}
+
+	// Output:
+	// odd [5 3]
+	// even [2 8]
+}
+
+func ExampleCompact() {
+	result := Compact([]string{"", "a", "", "b"})
+
+	fmt.Println(result)
+
+	// Output:
+	// [a b]
+}
+
+func ExampleCompactBy() {
+	result := CompactBy([]string{"a", " ", "b", ""}, func(s string) bool {
+		return strings.TrimSpace(s) != ""
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [a b]
+}
+
+func ExampleFirstNonZero() {
+	name, ok := FirstNonZero("", "nickname", "fullname")
+
+	fmt.Println(name, ok)
+
+	// Output:
+	// nickname true
+}
+
+func ExampleFirstOrDefault() {
+	fmt.Println(FirstOrDefault([]string{"a", "b"}, "none"))
+	fmt.Println(FirstOrDefault([]string{}, "none"))
+
+	// Output:
+	// a
+	// none
+}
+
+func ExampleFirstBy() {
+	result, ok := FirstBy([]int{1, 4, 6}, func(_ int, n int) bool {
+		return n%2 == 0
+	})
+
+	fmt.Println(result, ok)
+
+	// Output:
+	// 4 true
+}
+
+func ExampleFindIndexBy() {
+	nums := []int{1, 2, 3, 4, 5}
+
+	isEven := func(_ int, num int) bool {
+		return num%2 == 0
+	}
+
+	first, ok1 := FindIndexBy(nums, isEven)
+	last, ok2 := FindLastIndexBy(nums, isEven)
+
+	fmt.Println(first, ok1)
+	fmt.Println(last, ok2)
+
+	// Output:
+	// 1 true
+	// 3 true
+}
+
+func ExampleGroupConsecutiveBy() {
+	nums := []int{1, 3, 2, 4, 6, 5}
+
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+
+	result := GroupConsecutiveBy(nums, isEven)
+
+	fmt.Println(result)
+
+	// Output:
+	// [[1 3] [2 4 6] [5]]
+}
+
+func ExampleFindOpt() {
+	nums := []int{1, 2, 3, 4, 5}
+
+	result1 := FindOpt(nums, func(_ int, n int) bool { return n > 3 })
+	result2 := FindOpt(nums, func(_ int, n int) bool { return n > 5 })
+
+	fmt.Println(result1, result1.OrElse(-1))
+	fmt.Println(result2, result2.OrElse(-1))
+	fmt.Println(FirstOpt(nums), LastOpt(nums))
+
+	// Output:
+	// Some(4) 4
+	// None -1
+	// Some(1) Some(5)
+}
+
 func ExampleFindLast() {
 	nums := []int{1, 2, 3, 4, 5}
 
@@ -240,6 +379,23 @@ func ExampleFlattenDeep() {
 	// [a b c d]
 }
 
+func ExampleFlattenN() {
+	arrs := [][][]string{{{"a", "b"}}, {{"c"}, {"d"}}}
+
+	result1, _ := FlattenN(arrs, 1)
+	result2, _ := FlattenN(arrs, 2)
+	_, err := FlattenN(arrs, -1)
+
+	fmt.Println(result1)
+	fmt.Println(result2)
+	fmt.Println(err)
+
+	// Output:
+	// [[a b] [c] [d]]
+	// [a b c d]
+	// FlattenN: invalid depth -1, must not be negative
+}
+
 func ExampleForEach() {
 	nums := []int{1, 2, 3}
 
@@ -383,6 +539,23 @@ func ExampleReduceBy() {
 	// 1234
 }
 
+func ExampleReduceWhile() {
+	costs := []int{3, 4, 2, 5, 1}
+	budget := 8
+
+	spent := ReduceWhile(costs, 0, func(_ int, cost int, spent int) (int, bool) {
+		if spent+cost > budget {
+			return spent, false
+		}
+		return spent + cost, true
+	})
+
+	fmt.Println(spent)
+
+	// Output:
+	// 7
+}
+
 func ExampleReduceRight() {
 	result := ReduceRight([]int{1, 2, 3, 4}, "", func(_ int, item int, agg string) string {
 		return agg + fmt.Sprintf("%v", item)
@@ -611,6 +784,62 @@ func ExampleInsertAt() {
 	// [1 2 3 a b c]
 }
 
+func ExampleInsertValueAt() {
+	result, err := InsertValueAt([]string{"a", "b", "c"}, 1, "1")
+	fmt.Println(result, err)
+
+	_, err = InsertValueAt([]string{"a", "b", "c"}, 4, "1")
+	fmt.Println(err)
+
+	// Output:
+	// [a 1 b c] <nil>
+	// insert at 4 in slice of length 3: index out of range
+}
+
+func ExampleInsertSliceAt() {
+	result, err := InsertSliceAt([]string{"a", "b", "c"}, 0, []string{"1", "2"})
+
+	fmt.Println(result, err)
+
+	// Output:
+	// [1 2 a b c] <nil>
+}
+
+func ExampleDeleteAtE() {
+	result, err := DeleteAtE([]string{"a", "b", "c"}, 1)
+	fmt.Println(result, err)
+
+	_, err = DeleteAtE([]string{"a", "b", "c"}, 3)
+	fmt.Println(err)
+
+	// Output:
+	// [a c] <nil>
+	// delete at 3 in slice of length 3: index out of range
+}
+
+func ExampleInsertAtE() {
+	_, err := InsertAtE([]string{"a", "b", "c"}, 0, 1)
+
+	fmt.Println(err)
+
+	// Output:
+	// insert at 0: value of type int is neither string nor []string
+}
+
+func ExampleSplice() {
+	slice := []string{"a", "b", "c", "d"}
+
+	result1 := Splice(slice, 1, 2, "x", "y", "z")
+	result2 := Splice(slice, -1, 1)
+
+	fmt.Println(result1)
+	fmt.Println(result2)
+
+	// Output:
+	// [a x y z d]
+	// [a b c]
+}
+
 func ExampleUpdateAt() {
 	result1 := UpdateAt([]string{"a", "b", "c"}, -1, "1")
 	result2 := UpdateAt([]string{"a", "b", "c"}, 0, "1")
@@ -640,6 +869,54 @@ func ExampleUnique() {
 	// [a b]
 }
 
+func ExampleFilterInPlace() {
+	nums := []int{1, 2, 3, 4, 5, 6}
+
+	n := FilterInPlace(nums, func(_ int, v int) bool {
+		return v%2 == 0
+	})
+
+	fmt.Println(nums[:n])
+
+	// Output:
+	// [2 4 6]
+}
+
+func ExampleMapInPlace() {
+	nums := []int{1, 2, 3}
+
+	MapInPlace(nums, func(_ int, v int) int {
+		return v * v
+	})
+
+	fmt.Println(nums)
+
+	// Output:
+	// [1 4 9]
+}
+
+func ExampleReverseRange() {
+	nums := []int{1, 2, 3, 4, 5}
+
+	ReverseRange(nums, 1, 4)
+
+	fmt.Println(nums)
+
+	// Output:
+	// [1 4 3 2 5]
+}
+
+func ExampleUniqueSorted() {
+	nums := []int{1, 1, 2, 3, 3, 3, 4}
+
+	n := UniqueSorted(nums)
+
+	fmt.Println(nums[:n])
+
+	// Output:
+	// [1 2 3 4]
+}
+
 func ExampleUniqueBy() {
 	nums := []int{1, 2, 3, 4, 5, 6}
 	result := UniqueBy(nums, func(val int) int {
@@ -652,6 +929,24 @@ func ExampleUniqueBy() {
 	// [1 2 3]
 }
 
+func ExampleDedupeConsecutive() {
+	result := DedupeConsecutive([]int{1, 1, 2, 2, 2, 1, 3, 3})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 2 1 3]
+}
+
+func ExampleDedupeConsecutiveBy() {
+	result := DedupeConsecutiveBy([]string{"Go", "GO", "rust", "go"}, strings.ToLower)
+
+	fmt.Println(result)
+
+	// Output:
+	// [Go rust go]
+}
+
 func ExampleUniqueByComparator() {
 	uniqueNums := UniqueByComparator([]int{1, 2, 3, 1, 2, 4, 5, 6, 4}, func(item int, other int) bool {
 		return item == other
@@ -717,6 +1012,24 @@ func ExampleUnionBy() {
 	// [1 2 4]
 }
 
+func ExampleInterleave() {
+	result := Interleave([]int{1, 2, 3}, []int{4, 5}, []int{6})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 4 6 2 5 3]
+}
+
+func ExampleRoundRobin() {
+	result := RoundRobin(2, []int{1, 2, 3, 4}, []int{5, 6, 7})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 2 5 6 3 4 7]
+}
+
 func ExampleIntersection() {
 	nums1 := []int{1, 2, 3}
 	nums2 := []int{2, 3, 4}
@@ -729,6 +1042,24 @@ func ExampleIntersection() {
 	// [2 3]
 }
 
+func ExampleIntersectionCounting() {
+	result := IntersectionCounting([]int{1, 1, 2}, []int{1, 1, 1})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 1]
+}
+
+func ExampleDifferenceCounting() {
+	result := DifferenceCounting([]int{1, 1, 1, 2}, []int{1, 2, 2})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 1]
+}
+
 func ExampleSymmetricDifference() {
 	nums1 := []int{1, 2, 3}
 	nums2 := []int{1, 2, 4}
@@ -741,6 +1072,38 @@ func ExampleSymmetricDifference() {
 	// [3 4]
 }
 
+func ExampleSymmetricDifferenceBy() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	slice1 := []User{{1, "a"}, {2, "b"}}
+	slice2 := []User{{2, "b"}, {3, "c"}}
+
+	result := SymmetricDifferenceBy(func(u User) int { return u.ID }, slice1, slice2)
+
+	fmt.Println(result)
+
+	// Output:
+	// [{1 a} {3 c}]
+}
+
+func ExamplePowerSet() {
+	for subset := range PowerSet([]string{"a", "b", "c"}, 2) {
+		fmt.Println(subset)
+	}
+
+	// Output:
+	// []
+	// [a]
+	// [b]
+	// [c]
+	// [a b]
+	// [a c]
+	// [b c]
+}
+
 func ExampleReverse() {
 	strs := []string{"a", "b", "c", "d"}
 
@@ -867,6 +1230,17 @@ func ExampleSort() {
 	// [1 2 3 4 5]
 }
 
+func ExampleSortOrdered() {
+	nums := []int{1, 4, 3, 2, 5}
+
+	SortOrdered(nums, Desc)
+
+	fmt.Println(nums)
+
+	// Output:
+	// [5 4 3 2 1]
+}
+
 func ExampleSortBy() {
 	type User struct {
 		Name string
@@ -888,7 +1262,7 @@ func ExampleSortBy() {
 	// [{b 15} {a 21} {c 100}]
 }
 
-func ExampleSortByField() {
+func ExampleSortStableBy() {
 	type User struct {
 		Name string
 		Age  uint
@@ -897,44 +1271,212 @@ func ExampleSortByField() {
 	users := []User{
 		{Name: "a", Age: 21},
 		{Name: "b", Age: 15},
-		{Name: "c", Age: 100}}
-
-	err := SortByField(users, "Age", "desc")
-	if err != nil {
-		return
+		{Name: "c", Age: 21},
+		{Name: "d", Age: 15},
 	}
 
+	SortStableBy(users, func(a, b User) bool {
+		return a.Age < b.Age
+	})
+
 	fmt.Println(users)
 
 	// Output:
-	// [{c 100} {a 21} {b 15}]
+	// [{b 15} {d 15} {a 21} {c 21}]
 }
 
-func ExampleWithout() {
-	result := Without([]int{1, 2, 3, 4}, 1, 2)
+func ExampleSortStableByKey() {
+	words := []string{"ccc", "a", "bb", "b"}
 
-	fmt.Println(result)
+	SortStableByKey(words, func(s string) int {
+		return len(s)
+	})
+
+	fmt.Println(words)
 
 	// Output:
-	// [3 4]
+	// [a b bb ccc]
 }
 
-func ExampleIndexOf() {
-	strs := []string{"a", "a", "b", "c"}
+func ExampleSortByKey() {
+	type User struct {
+		Name string
+		Age  uint
+	}
 
-	result1 := IndexOf(strs, "a")
-	result2 := IndexOf(strs, "d")
+	users := []User{
+		{Name: "a", Age: 21},
+		{Name: "b", Age: 15},
+		{Name: "c", Age: 100}}
 
-	fmt.Println(result1)
-	fmt.Println(result2)
+	SortByKey(users, func(u User) uint {
+		return u.Age
+	}, Desc)
+
+	fmt.Println(users)
 
 	// Output:
-	// 0
-	// -1
+	// [{c 100} {a 21} {b 15}]
 }
 
-func ExampleLastIndexOf() {
-	strs := []string{"a", "a", "b", "c"}
+func ExampleOrderBy() {
+	type Employee struct {
+		Dept   string
+		Salary int
+	}
+
+	employees := []Employee{
+		{"sales", 100},
+		{"dev", 200},
+		{"sales", 300},
+		{"dev", 150},
+	}
+
+	OrderBy(func(e Employee) string { return e.Dept }).
+		ThenBy(CompareBy(func(e Employee) int { return e.Salary })).Desc().
+		Sort(employees)
+
+	fmt.Println(employees)
+
+	// Output:
+	// [{dev 200} {dev 150} {sales 300} {sales 100}]
+}
+
+func ExampleSortByField() {
+	type User struct {
+		Name string
+		Age  uint
+	}
+
+	users := []User{
+		{Name: "a", Age: 21},
+		{Name: "b", Age: 15},
+		{Name: "c", Age: 100}}
+
+	err := SortByField(users, "Age", "desc")
+	if err != nil {
+		return
+	}
+
+	fmt.Println(users)
+
+	// Output:
+	// [{c 100} {a 21} {b 15}]
+}
+
+func ExampleTranspose() {
+	result, err := Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	fmt.Println(result, err)
+
+	// Output:
+	// [[1 4] [2 5] [3 6]] <nil>
+}
+
+func ExampleTransposePadded() {
+	result := TransposePadded([][]int{{1, 2}, {3}, {4, 5, 6}}, 0)
+
+	fmt.Println(result)
+
+	// Output:
+	// [[1 3 4] [2 0 5] [0 0 6]]
+}
+
+func ExampleDiff() {
+	oldSlice := []string{"a", "b", "c", "d"}
+	newSlice := []string{"a", "c", "e", "d"}
+
+	edits := Diff(oldSlice, newSlice)
+	for _, edit := range edits {
+		fmt.Println(edit.Op, edit.Value)
+	}
+
+	result, err := ApplyPatch(oldSlice, edits)
+	fmt.Println(result, err)
+
+	// Output:
+	// keep a
+	// delete b
+	// keep c
+	// insert e
+	// keep d
+	// [a c e d] <nil>
+}
+
+func ExampleLongestCommonPrefix() {
+	result := LongestCommonPrefix(
+		[]string{"usr", "local", "bin"},
+		[]string{"usr", "local", "lib"},
+	)
+
+	fmt.Println(result)
+
+	// Output:
+	// [usr local]
+}
+
+func ExampleLongestCommonSuffix() {
+	result := LongestCommonSuffix([]int{1, 2, 3, 4}, []int{9, 3, 4})
+
+	fmt.Println(result)
+
+	// Output:
+	// [3 4]
+}
+
+func ExampleLongestCommonSubsequence() {
+	result := LongestCommonSubsequence([]int{1, 2, 3, 4, 5}, []int{2, 9, 4, 5, 1})
+
+	fmt.Println(result)
+
+	// Output:
+	// [2 4 5]
+}
+
+func ExampleWithout() {
+	result := Without([]int{1, 2, 3, 4}, 1, 2)
+
+	fmt.Println(result)
+
+	// Output:
+	// [3 4]
+}
+
+func ExampleIndexOf() {
+	strs := []string{"a", "a", "b", "c"}
+
+	result1 := IndexOf(strs, "a")
+	result2 := IndexOf(strs, "d")
+
+	fmt.Println(result1)
+	fmt.Println(result2)
+
+	// Output:
+	// 0
+	// -1
+}
+
+func ExampleNewIndex() {
+	strs := []string{"a", "a", "b", "c"}
+
+	idx := NewIndex(strs)
+
+	fmt.Println(idx.Lookup("b"))
+	fmt.Println(idx.Lookup("d"))
+
+	strs[0] = "d"
+	idx.Invalidate()
+
+	fmt.Println(idx.Lookup("d"))
+
+	// Output:
+	// 2
+	// -1
+	// 0
+}
+
+func ExampleLastIndexOf() {
+	strs := []string{"a", "a", "b", "c"}
 
 	result1 := LastIndexOf(strs, "a")
 	result2 := LastIndexOf(strs, "d")
@@ -947,6 +1489,40 @@ func ExampleLastIndexOf() {
 	// -1
 }
 
+func ExampleIndicesOf() {
+	result := IndicesOf([]string{"a", "a", "b", "c", "a"}, "a")
+
+	fmt.Println(result)
+
+	// Output:
+	// [0 1 4]
+}
+
+func ExampleIndicesBy() {
+	result := IndicesBy([]int{1, 2, 3, 4}, func(_ int, n int) bool {
+		return n%2 == 0
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 3]
+}
+
+func ExampleZip() {
+	pairs := Zip([]string{"a", "b", "c"}, []int{1, 2, 3})
+
+	fmt.Println(pairs)
+
+	letters, numbers := Unzip(pairs)
+
+	fmt.Println(letters, numbers)
+
+	// Output:
+	// [(a, 1) (b, 2) (c, 3)]
+	// [a b c] [1 2 3]
+}
+
 func ExampleToSlice() {
 	result := ToSlice("a", "b", "c")
 
@@ -995,6 +1571,136 @@ func ExampleKeyBy() {
 	// map[1:a 2:ab 3:abc]
 }
 
+func ExampleAssociate() {
+	words := []string{"a", "ab", "abc"}
+
+	result := Associate(words, func(str string) (string, int) {
+		return str, len(str)
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// map[a:1 ab:2 abc:3]
+}
+
+func ExampleAssociateStrict() {
+	words := []string{"a", "b", "ab"}
+
+	_, err := AssociateStrict(words, func(str string) (int, string) {
+		return len(str), str
+	})
+
+	fmt.Println(err)
+
+	// Output:
+	// key 1 at index 1: duplicate key
+}
+
+func ExamplePage() {
+	nums := []int{1, 2, 3, 4, 5, 6, 7}
+
+	fmt.Println(Page(nums, 1, 3))
+	fmt.Println(Page(nums, 3, 3))
+	fmt.Println(PageCount(len(nums), 3))
+
+	// Output:
+	// [1 2 3]
+	// [7]
+	// 3
+}
+
+func ExamplePaginate() {
+	result := Paginate([]int{1, 2, 3, 4, 5}, 2)
+
+	fmt.Println(result)
+
+	// Output:
+	// [[1 2] [3 4] [5]]
+}
+
+func ExampleGenerate() {
+	result := Generate(5, func(i int) int {
+		return i * i
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [0 1 4 9 16]
+}
+
+func ExampleUnfold() {
+	// powers of 2 below 100
+	result := Unfold(1, func(n int) (int, int, bool) {
+		return n, n * 2, n < 100
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [1 2 4 8 16 32 64]
+}
+
+func ExampleRangeOf() {
+	fmt.Println(RangeOf(0, 10, 3))
+	fmt.Println(RangeOf(5, 0, -2))
+	fmt.Println(RangeOf(0, 1, 0.25))
+
+	// Output:
+	// [0 3 6 9]
+	// [5 3 1]
+	// [0 0.25 0.5 0.75]
+}
+
+func ExampleLinspace() {
+	result := Linspace(0, 1, 5)
+
+	fmt.Println(result)
+
+	// Output:
+	// [0 0.25 0.5 0.75 1]
+}
+
+func ExampleRepeatBy() {
+	buffers := RepeatBy(3, func(_ int) []int {
+		return make([]int, 0, 4)
+	})
+
+	buffers[0] = append(buffers[0], 1)
+
+	fmt.Println(buffers)
+
+	// Output:
+	// [[1] [] []]
+}
+
+func ExamplePackBy() {
+	messages := []string{"aaa", "bb", "c", "dddd", "ee"}
+
+	result := PackBy(messages, 5, func(s string) int {
+		return len(s)
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [[aaa bb] [c dddd] [ee]]
+}
+
+func ExamplePackByFirstFitDecreasing() {
+	weights := []int{2, 5, 4, 7, 1, 3, 8}
+
+	result := PackByFirstFitDecreasing(weights, 10, func(n int) int {
+		return n
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [[8 2] [7 3] [5 4 1]]
+}
+
 func ExampleJoin() {
 	nums := []int{1, 2, 3, 4, 5}
 
@@ -1026,6 +1732,19 @@ func ExamplePartition() {
 	// [[1 2] [3 4] [5]]
 }
 
+func ExamplePartitionInPlace() {
+	nums := []int{1, 2, 3, 4, 5, 6}
+
+	split := PartitionInPlace(nums, func(n int) bool {
+		return n%2 == 0
+	})
+
+	fmt.Println(nums[:split], nums[split:])
+
+	// Output:
+	// [2 4 6] [1 3 5]
+}
+
 func ExampleRandom() {
 	nums := []int{1, 2, 3, 4, 5}
 
@@ -1092,6 +1811,60 @@ func ExampleUniqueByConcurrent() {
 	// [1 2 3 4 5 6 7]
 }
 
+func ExampleProcessInBatches() {
+	nums := []int{1, 2, 3, 4, 5}
+
+	err := ProcessInBatches(nums, 2, func(batch []int) error {
+		fmt.Println(batch)
+		return nil
+	})
+
+	fmt.Println(err)
+
+	// Output:
+	// [1 2]
+	// [3 4]
+	// [5]
+	// <nil>
+}
+
+func ExampleMapCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	nums := []int{1, 2, 3}
+	double := func(_ int, n int) int {
+		return n * 2
+	}
+
+	result, err := MapCtx(ctx, nums, double)
+	fmt.Println(result, err)
+
+	cancel()
+
+	result, err = MapCtx(ctx, nums, double)
+	fmt.Println(result, err)
+
+	// Output:
+	// [2 4 6] <nil>
+	// [] context canceled
+}
+
+func ExampleMapErr() {
+	result, err := MapErr([]string{"1", "2", "3"}, func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	fmt.Println(result, err)
+
+	result, err = MapErr([]string{"1", "x", "3"}, func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	fmt.Println(result, err)
+
+	// Output:
+	// [1 2 3] <nil>
+	// [] strconv.Atoi: parsing "x": invalid syntax
+}
+
 func ExampleMapConcurrent() {
 	nums := []int{1, 2, 3, 4, 5, 6}
 	result := MapConcurrent(nums, func(_, n int) int { return n * n }, 4)
@@ -1102,6 +1875,53 @@ func ExampleMapConcurrent() {
 	// [1 4 9 16 25 36]
 }
 
+func ExampleMapConcurrentCtx() {
+	parse := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+
+	result, err := MapConcurrentCtx(context.Background(), []string{"1", "2", "3"}, 2, parse)
+	fmt.Println(result, err)
+
+	_, err = MapConcurrentCtx(context.Background(), []string{"1", "x", "3"}, 2, parse)
+	fmt.Println(err)
+
+	// Output:
+	// [1 2 3] <nil>
+	// strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleForEachParallel() {
+	check := func(_ context.Context, _ int, s string) error {
+		if s == "boom" {
+			panic("unexpected input")
+		}
+		if _, err := strconv.Atoi(s); err != nil {
+			return fmt.Errorf("invalid %q", s)
+		}
+		return nil
+	}
+	inputs := []string{"1", "x", "2", "y"}
+
+	err := ForEachParallel(context.Background(), inputs, 1, check, FirstError)
+	fmt.Println(err)
+
+	err = ForEachParallel(context.Background(), inputs, 2, check, AllErrors)
+	fmt.Println(err)
+
+	err = ForEachParallel(context.Background(), []string{"1", "boom"}, 2, check)
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		fmt.Println(panicErr.Index, panicErr.Value)
+	}
+
+	// Output:
+	// invalid "x"
+	// invalid "x"
+	// invalid "y"
+	// 1 unexpected input
+}
+
 func ExampleFrequency() {
 	strs := []string{"a", "b", "b", "c", "c", "c"}
 	result := Frequency(strs)
@@ -1112,6 +1932,40 @@ func ExampleFrequency() {
 	// map[a:1 b:2 c:3]
 }
 
+func ExampleHistogram() {
+	latencies := []int{5, 12, 9, 10, 55, 100, 250}
+
+	result := Histogram(latencies, []int{10, 50, 200})
+
+	fmt.Println(result)
+
+	// Output:
+	// [2 2 2 1]
+}
+
+func ExampleMostCommon() {
+	errs := []string{"timeout", "refused", "timeout", "reset", "refused", "timeout"}
+
+	for _, c := range MostCommon(errs, 2) {
+		fmt.Println(c.Value, c.Count)
+	}
+
+	// Output:
+	// timeout 3
+	// refused 2
+}
+
+func ExampleMajority() {
+	votes := []string{"yes", "no", "yes", "abstain", "yes"}
+
+	result, ok := Majority(votes)
+
+	fmt.Println(result, ok)
+
+	// Output:
+	// yes true
+}
+
 func ExampleJoinFunc() {
 	result := JoinFunc([]string{"a", "b", "c"}, ", ", func(s string) string {
 		return strings.ToUpper(s)
@@ -1151,6 +2005,30 @@ func ExampleConcatBy() {
 	// 90
 }
 
+func ExampleIsRotation() {
+	result1 := IsRotation([]int{1, 2, 3}, []int{3, 1, 2})
+	result2 := IsRotation([]int{1, 2, 3}, []int{3, 2, 1})
+
+	fmt.Println(result1)
+	fmt.Println(result2)
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleIsPalindrome() {
+	result1 := IsPalindrome([]int{1, 2, 1})
+	result2 := IsPalindromeWith([]string{"a", "B", "A"}, strings.EqualFold)
+
+	fmt.Println(result1)
+	fmt.Println(result2)
+
+	// Output:
+	// true
+	// true
+}
+
 func ExampleIsPermutation() {
 	result1 := IsPermutation([]int{1, 2, 3}, []int{3, 2, 1})
 	result2 := IsPermutation([]int{1, 2, 3}, []int{4, 5, 6})
@@ -1162,3 +2040,240 @@ func ExampleIsPermutation() {
 	// true
 	// false
 }
+
+func ExampleIsPermutationBy() {
+	result := IsPermutationBy([]string{"Go", "rust"}, []string{"RUST", "go"}, strings.ToLower)
+
+	fmt.Println(result)
+
+	// Output:
+	// true
+}
+
+func ExampleEqualWith() {
+	approx := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-9
+	}
+
+	result1 := EqualWith([]float64{0.1 + 0.2, 1}, []float64{0.3, 1}, approx)
+	result2 := IsPermutationWith([]float64{0.1 + 0.2, 1}, []float64{1, 0.3}, approx)
+
+	fmt.Println(result1)
+	fmt.Println(result2)
+
+	// Output:
+	// true
+	// true
+}
+
+func ExampleSplit() {
+	result := Split([]int{1, 2, 0, 3, 0, 4}, 0)
+
+	fmt.Println(result)
+
+	// Output:
+	// [[1 2] [3] [4]]
+}
+
+func ExampleSplitWhen() {
+	tokens := []string{"a", "b", ";", "c", ",", "d"}
+
+	result := SplitWhen(tokens, func(s string) bool {
+		return s == ";" || s == ","
+	})
+
+	fmt.Println(result)
+
+	// Output:
+	// [[a b] [c] [d]]
+}
+
+func ExampleAddSlices() {
+	result, err := AddSlices([]int{1, 2, 3}, []int{4, 5, 6})
+	fmt.Println(result, err)
+
+	_, err = AddSlices([]int{1, 2, 3}, []int{4, 5})
+	fmt.Println(err)
+
+	// Output:
+	// [5 7 9] <nil>
+	// lengths 3 and 2: length mismatch
+}
+
+func ExampleDot() {
+	result, err := Dot([]float64{1, 2, 3}, []float64{4, 5, 6})
+
+	fmt.Println(result, err)
+	fmt.Println(Norm([]float64{3, 4}))
+
+	// Output:
+	// 32 <nil>
+	// 5
+}
+
+func ExampleConvertNumeric() {
+	result, err := ConvertNumeric[int, uint8]([]int{1, 2, 255})
+	fmt.Println(result, err)
+
+	_, err = ConvertNumeric[int, uint8]([]int{1, 256})
+	fmt.Println(err)
+
+	// Output:
+	// [1 2 255] <nil>
+	// value 256 at index 1 cannot be represented as uint8
+}
+
+func ExampleBatchedSeq() {
+	seq := slices.Values([]int{1, 2, 3, 4, 5})
+
+	for batch := range BatchedSeq(seq, 2) {
+		fmt.Println(batch)
+	}
+
+	// Output:
+	// [1 2]
+	// [3 4]
+	// [5]
+}
+
+func ExampleMovingAverage() {
+	result := MovingAverage([]int{1, 2, 3, 4, 5}, 3)
+
+	fmt.Println(result)
+
+	// Output:
+	// [2 3 4]
+}
+
+func ExampleMovingMax() {
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+
+	fmt.Println(MovingMax(nums, 3))
+	fmt.Println(MovingMin(nums, 3))
+
+	// Output:
+	// [3 3 5 5 6 7]
+	// [-1 -3 -3 -3 3 3]
+}
+
+func ExampleSortedSlice() {
+	s := NewSortedSlice(30, 10)
+
+	s.Insert(20)
+	s.Insert(40)
+	s.Delete(30)
+
+	fmt.Println(s.ToSlice())
+	fmt.Println(s.Contains(20), s.Rank(40))
+
+	for v := range s.Range(15, 40) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// [10 20 40]
+	// true 2
+	// 20
+}
+
+func ExampleImmutableSlice() {
+	nums := []int{1, 2, 3, 4}
+	s := NewImmutableSlice(nums...)
+
+	nums[0] = 100
+
+	evens := s.Filter(func(_ int, n int) bool { return n%2 == 0 })
+	squares := MapImmutable(s, func(_ int, n int) int { return n * n })
+
+	fmt.Println(s.ToSlice(), s.Len(), s.Get(0))
+	fmt.Println(evens.ToSlice())
+	fmt.Println(squares.ToSlice())
+	fmt.Println(s.Slice(1, 3).Append(9).ToSlice())
+
+	// Output:
+	// [1 2 3 4] 4 1
+	// [2 4]
+	// [1 4 9 16]
+	// [2 3 9]
+}
+
+func ExampleSparseSlice() {
+	features := NewSparseSlice[float64](6)
+
+	features.Set(1, 0.5)
+	features.Set(4, 2)
+
+	for i, v := range features.All() {
+		fmt.Println(i, v)
+	}
+	fmt.Println(features.Dense())
+
+	// Output:
+	// 1 0.5
+	// 4 2
+	// [0 0.5 0 0 2 0]
+}
+
+func ExampleMapInto() {
+	buf := make([]int, 0, 4)
+
+	for _, batch := range [][]int{{1, 2, 3}, {4, 5}} {
+		buf = MapInto(buf, batch, func(_ int, n int) int { return n * n })
+		fmt.Println(buf)
+	}
+
+	// Output:
+	// [1 4 9]
+	// [16 25]
+}
+
+func ExampleFilterInto() {
+	buf := make([]int, 0, 4)
+
+	buf = FilterInto(buf, []int{1, 2, 3, 4}, func(_ int, n int) bool { return n%2 == 0 })
+
+	fmt.Println(buf)
+
+	// Output:
+	// [2 4]
+}
+
+func ExampleToChannel() {
+	ctx := context.Background()
+
+	squares := make(chan int)
+	go func() {
+		defer close(squares)
+		for n := range ToChannel(ctx, []int{1, 2, 3, 4}) {
+			squares <- n * n
+		}
+	}()
+
+	result, err := Collect(ctx, squares, 0)
+
+	fmt.Println(result, err)
+
+	// Output:
+	// [1 4 9 16] <nil>
+}
+
+func ExampleToCSV() {
+	type product struct {
+		SKU   string  `csv:"sku"`
+		Price float64 `csv:"price"`
+		Notes string  `csv:"-"`
+	}
+
+	var buf strings.Builder
+	_ = ToCSV(&buf, []product{{"A-1", 9.5, "new"}, {"B-2", 12, ""}})
+	fmt.Print(buf.String())
+
+	products, _ := FromCSV[product](strings.NewReader(buf.String()))
+	fmt.Println(products)
+
+	// Output:
+	// sku,price
+	// A-1,9.5
+	// B-2,12
+	// [{A-1 9.5 } {B-2 12 }]
+}
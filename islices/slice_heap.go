@@ -0,0 +1,69 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import "github.com/idichekop/gods/icompare"
+
+// The functions in this file maintain a binary heap in a plain slice, ordered by a
+// comparator: slice[0] is always the smallest element.  Like the functions of
+// slice_inplace.go, they are exceptions to the purity principle of this package and
+// modify the given slice in place.  Use icompare.Reversed for a max-heap.
+
+// Heapify rearranges the elements of the slice into a heap, in O(n).
+func Heapify[T any](slice []T, compare icompare.Comparator[T]) {
+	for i := len(slice)/2 - 1; i >= 0; i-- {
+		siftDown(slice, i, compare)
+	}
+}
+
+// HeapPush adds the item to the heap, in O(log n), and returns the updated slice.
+func HeapPush[T any](slice []T, item T, compare icompare.Comparator[T]) []T {
+	slice = append(slice, item)
+	siftUp(slice, len(slice)-1, compare)
+	return slice
+}
+
+// HeapPop removes the smallest element of the heap, in O(log n), and returns it with
+// the updated slice.  The vacated slot is set to the zero value, so that it can be
+// garbage collected.  It panics if the slice is empty.
+func HeapPop[T any](slice []T, compare icompare.Comparator[T]) (T, []T) {
+	last := len(slice) - 1
+	top := slice[0]
+	slice[0] = slice[last]
+	var zero T
+	slice[last] = zero
+	slice = slice[:last]
+	siftDown(slice, 0, compare)
+	return top, slice
+}
+
+// siftUp moves the element at i up until its parent is not greater.
+func siftUp[T any](slice []T, i int, compare icompare.Comparator[T]) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if compare(slice[i], slice[parent]) >= 0 {
+			return
+		}
+		slice[i], slice[parent] = slice[parent], slice[i]
+		i = parent
+	}
+}
+
+// siftDown moves the element at i down until its children are not smaller.
+func siftDown[T any](slice []T, i int, compare icompare.Comparator[T]) {
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(slice) && compare(slice[child], slice[smallest]) < 0 {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		slice[i], slice[smallest] = slice[smallest], slice[i]
+		i = smallest
+	}
+}
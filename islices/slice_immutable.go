@@ -0,0 +1,89 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"iter"
+	stdslices "slices"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// ImmutableSlice is a read-only view over a slice.  Its backing array is never written to,
+// so values derived from it (Slice, and Filter when nothing is filtered out) share that
+// array instead of copying it.  Operations that would change the contents, like Append,
+// copy on write and return a new ImmutableSlice.
+//
+// The zero ImmutableSlice is empty and ready to use.  An ImmutableSlice is safe for
+// concurrent use.
+type ImmutableSlice[T any] struct {
+	items []T
+}
+
+// NewImmutableSlice creates an ImmutableSlice holding a copy of the given items, so later
+// changes to the items are not observed.
+func NewImmutableSlice[T any](items ...T) ImmutableSlice[T] {
+	return ImmutableSlice[T]{items: stdslices.Clone(items)}
+}
+
+// Len returns the number of elements.
+func (s ImmutableSlice[T]) Len() int {
+	return len(s.items)
+}
+
+// Get returns the element at index.  It panics if index is out of range.
+func (s ImmutableSlice[T]) Get(index int) T {
+	return s.items[index]
+}
+
+// All returns an iterator over the index and value of each element.
+func (s ImmutableSlice[T]) All() iter.Seq2[int, T] {
+	return stdslices.All(s.items)
+}
+
+// Keys returns an iterator over the indexes, in ascending order.
+func (s ImmutableSlice[T]) Keys() iter.Seq[int] {
+	return icontainer.Keys(s.All())
+}
+
+// Values returns an iterator over the elements, in index order.
+func (s ImmutableSlice[T]) Values() iter.Seq[T] {
+	return stdslices.Values(s.items)
+}
+
+// ToSlice returns a mutable copy of the elements.
+func (s ImmutableSlice[T]) ToSlice() []T {
+	return stdslices.Clone(s.items)
+}
+
+// Slice returns the elements in [start, end), sharing the backing array.  It panics if
+// the range is out of bounds.
+func (s ImmutableSlice[T]) Slice(start, end int) ImmutableSlice[T] {
+	// Capping the capacity makes any append to the view reallocate.
+	return ImmutableSlice[T]{items: s.items[start:end:end]}
+}
+
+// Append returns a new ImmutableSlice with the items added at the end.
+func (s ImmutableSlice[T]) Append(items ...T) ImmutableSlice[T] {
+	result := make([]T, 0, len(s.items)+len(items))
+	result = append(result, s.items...)
+	result = append(result, items...)
+	return ImmutableSlice[T]{items: result}
+}
+
+// Filter returns the elements that pass the predicate function.  If all of them pass, the
+// backing array is shared.
+func (s ImmutableSlice[T]) Filter(predicate func(index int, item T) bool) ImmutableSlice[T] {
+	result := Filter(s.items, predicate)
+	if len(result) == len(s.items) {
+		return s
+	}
+	return ImmutableSlice[T]{items: result}
+}
+
+// MapImmutable is like Map, for an ImmutableSlice.
+func MapImmutable[T any, U any](s ImmutableSlice[T], iteratee func(index int, item T) U) ImmutableSlice[U] {
+	return ImmutableSlice[U]{items: Map(s.items, iteratee)}
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import "sync"
+
+// Index is an explicit lookup table from the values of a slice to the position of their
+// first occurrence.  It is meant for callers who perform many lookups over the same
+// slice, where the linear scan of IndexOf becomes too costly.
+//
+// The index does not observe the slice.  If the slice is mutated after the index was
+// built, call Invalidate (the table is rebuilt on the next Lookup) or Rebuild.
+// An Index is safe for concurrent use.
+type Index[T comparable] struct {
+	mu        sync.RWMutex
+	slice     []T
+	positions map[T]int
+}
+
+// NewIndex builds an Index over the given slice.
+func NewIndex[T comparable](slice []T) *Index[T] {
+	idx := &Index[T]{slice: slice}
+	idx.positions = buildPositions(slice)
+	return idx
+}
+
+// Lookup returns the index of the first occurrence of item in the indexed slice, or -1
+// if the item cannot be found.
+func (idx *Index[T]) Lookup(item T) int {
+	idx.mu.RLock()
+	if idx.positions != nil {
+		i, ok := idx.positions[item]
+		idx.mu.RUnlock()
+		if !ok {
+			return -1
+		}
+		return i
+	}
+	idx.mu.RUnlock()
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if idx.positions == nil {
+		idx.positions = buildPositions(idx.slice)
+	}
+	if i, ok := idx.positions[item]; ok {
+		return i
+	}
+	return -1
+}
+
+// Invalidate discards the lookup table.  It is lazily rebuilt on the next Lookup.
+func (idx *Index[T]) Invalidate() {
+	idx.mu.Lock()
+	idx.positions = nil
+	idx.mu.Unlock()
+}
+
+// Rebuild eagerly rebuilds the lookup table from the current contents of the indexed
+// slice.
+func (idx *Index[T]) Rebuild() {
+	positions := buildPositions(idx.slice)
+	idx.mu.Lock()
+	idx.positions = positions
+	idx.mu.Unlock()
+}
+
+// buildPositions maps every value of slice to the position of its first occurrence.
+func buildPositions[T comparable](slice []T) map[T]int {
+	positions := make(map[T]int, len(slice))
+	for i := len(slice) - 1; i >= 0; i-- {
+		positions[slice[i]] = i
+	}
+	return positions
+}
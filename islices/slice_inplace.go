@@ -0,0 +1,63 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import stdslices "slices"
+
+// The functions in this file are the explicit exceptions to the purity principle of this
+// package: they modify the given slice in place and never allocate, for large and
+// reusable buffers.
+
+// FilterInPlace moves the elements that pass the predicate function to the front of the
+// slice, preserving their order, and returns their number n.  The elements of slice[n:]
+// are set to the zero value, so that they can be garbage collected; use slice[:n].
+func FilterInPlace[T any](slice []T, predicate func(index int, item T) bool) int {
+	n := 0
+	for i, v := range slice {
+		if predicate(i, v) {
+			slice[n] = v
+			n++
+		}
+	}
+
+	clear(slice[n:])
+
+	return n
+}
+
+// MapInPlace replaces every element of the slice by the result of the iteratee function.
+func MapInPlace[T any](slice []T, iteratee func(index int, item T) T) {
+	for i, v := range slice {
+		slice[i] = iteratee(i, v)
+	}
+}
+
+// ReverseRange reverses the order of the elements of slice[start:end], in place.
+// It panics if the range is out of the bounds of the slice.
+func ReverseRange[T any](slice []T, start, end int) {
+	stdslices.Reverse(slice[start:end])
+}
+
+// UniqueSorted removes the duplicates of a sorted slice in place, and returns the number n
+// of distinct elements, moved to the front of the slice.  The elements of slice[n:] are
+// set to the zero value; use slice[:n].  On an unsorted slice, only adjacent duplicates
+// are removed.
+func UniqueSorted[T comparable](slice []T) int {
+	if len(slice) == 0 {
+		return 0
+	}
+
+	n := 1
+	for i := 1; i < len(slice); i++ {
+		if slice[i] != slice[n-1] {
+			slice[n] = slice[i]
+			n++
+		}
+	}
+
+	clear(slice[n:])
+
+	return n
+}
@@ -1,10 +1,11 @@
 package islice
 
 import (
+	cryptorand "crypto/rand"
 	"fmt"
+	"math/big"
 	"reflect"
-
-	"golang.org/x/exp/constraints"
+	stdslices "slices"
 )
 
 // resultChunk is used to store the intermediate results of UniqueByConcurrent.
@@ -34,65 +35,118 @@ func sliceElemType(reflectType reflect.Type) reflect.Type {
 	}
 }
 
-func quickSort[T constraints.Ordered](slice []T, lowIndex, highIndex int, order string) {
-	if lowIndex < highIndex {
-		p := partitionOrderedSlice(slice, lowIndex, highIndex, order)
-		quickSort(slice, lowIndex, p-1, order)
-		quickSort(slice, p+1, highIndex, order)
+// lcsTable computes the dynamic-programming table of the longest common subsequence of
+// the suffixes of slice1 and slice2: table[i][j] is the length of the LCS of slice1[i:]
+// and slice2[j:].
+func lcsTable[T comparable](slice1, slice2 []T) [][]int {
+	table := make([][]int, len(slice1)+1)
+	for i := range table {
+		table[i] = make([]int, len(slice2)+1)
 	}
-}
-
-// partitionOrderedSlice split ordered slice into two parts for quick sort
-func partitionOrderedSlice[T constraints.Ordered](slice []T, lowIndex, highIndex int, order string) int {
-	p := slice[highIndex]
-	i := lowIndex
 
-	for j := lowIndex; j < highIndex; j++ {
-		if order == "desc" {
-			if slice[j] > p {
-				swap(slice, i, j)
-				i++
-			}
-		} else {
-			if slice[j] < p {
-				swap(slice, i, j)
-				i++
+	for i := len(slice1) - 1; i >= 0; i-- {
+		for j := len(slice2) - 1; j >= 0; j-- {
+			if slice1[i] == slice2[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
 			}
 		}
 	}
 
-	swap(slice, i, highIndex)
-
-	return i
+	return table
 }
 
-func quickSortBy[T any](slice []T, lowIndex, highIndex int, less func(a, b T) bool) {
-	if lowIndex < highIndex {
-		p := partitionAnySlice(slice, lowIndex, highIndex, less)
-		quickSortBy(slice, lowIndex, p-1, less)
-		quickSortBy(slice, p+1, highIndex, less)
+// secureIntn returns a uniformly distributed random integer in [0, n), drawn from
+// crypto/rand.  crypto/rand.Int uses rejection sampling, so there is no modulo bias.
+func secureIntn(n int) int {
+	v, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		// crypto/rand.Reader is not expected to fail; if it does, no secure result exists.
+		panic(fmt.Sprintf("crypto/rand failure: %v", err))
 	}
+	return int(v.Int64())
+}
+
+// rotateLeft rotates the elements of slice by k positions to the left, in place.
+func rotateLeft[T any](slice []T, k int) {
+	stdslices.Reverse(slice[:k])
+	stdslices.Reverse(slice[k:])
+	stdslices.Reverse(slice)
 }
 
-// partitionAnySlice split any slice into two parts for quick sort
-func partitionAnySlice[T any](slice []T, lowIndex, highIndex int, less func(a, b T) bool) int {
-	p := slice[highIndex]
-	i := lowIndex
+// useScan reports whether a set operation scans its inputs rather than hashing them:
+// as hint says, or else as small says.
+func useScan(hint SetHint, small bool) bool {
+	if hint != HintAuto {
+		return hint == HintScan
+	}
+	return small
+}
 
-	for j := lowIndex; j < highIndex; j++ {
+// isSmall reports whether all the slices are under SmallSliceThreshold.
+func isSmall[T any](slices [][]T) bool {
+	for _, slice := range slices {
+		if len(slice) > SmallSliceThreshold {
+			return false
+		}
+	}
+	return true
+}
 
-		if less(slice[j], p) {
-			swap(slice, i, j)
-			i++
+// containsSubSliceScan is ContainsSubSlice for small slices: each distinct element of
+// subSlice must occur in slice at least as many times as in subSlice.
+func containsSubSliceScan[T comparable](slice, subSlice []T) bool {
+	for i, item := range subSlice {
+		if stdslices.Contains(subSlice[:i], item) {
+			continue
+		}
+		if countOf(subSlice[i:], item) > countOf(slice, item) {
+			return false
 		}
 	}
+	return true
+}
 
-	swap(slice, i, highIndex)
+// differenceScan is Difference for small slices.
+func differenceScan[T comparable](slice, comparedSlice []T) []T {
+	result := []T{}
+	for _, v := range slice {
+		if !stdslices.Contains(comparedSlice, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
 
-	return i
+// intersectionScan is Intersection for small slices.
+func intersectionScan[T comparable](slices [][]T) []T {
+	result := []T{}
+	for _, item := range slices[0] {
+		if stdslices.Contains(result, item) {
+			continue
+		}
+		found := true
+		for _, slice := range slices[1:] {
+			if !stdslices.Contains(slice, item) {
+				found = false
+				break
+			}
+		}
+		if found {
+			result = append(result, item)
+		}
+	}
+	return result
 }
 
-// swap two slice value at index i and j
-func swap[T any](slice []T, i, j int) {
-	slice[i], slice[j] = slice[j], slice[i]
+// countOf returns the number of occurrences of item in slice.
+func countOf[T comparable](slice []T, item T) int {
+	count := 0
+	for _, v := range slice {
+		if v == item {
+			count++
+		}
+	}
+	return count
 }
@@ -0,0 +1,65 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import stdslices "slices"
+
+// The Into variants write their result into the caller's dst buffer instead of allocating
+// a new slice on every call.  The result overwrites dst[:0] and grows it only if its
+// capacity is not enough; pass the returned slice back as dst on the next call to reuse
+// it.  dst must not overlap src.
+
+// MapInto is like Map, but it writes the result into dst.
+func MapInto[T any, U any](dst []U, src []T, iteratee func(index int, item T) U) []U {
+	dst = dst[:0]
+	for i, v := range src {
+		dst = append(dst, iteratee(i, v))
+	}
+	return dst
+}
+
+// FilterInto is like Filter, but it writes the result into dst.
+func FilterInto[T any](dst []T, src []T, predicate func(index int, item T) bool) []T {
+	dst = dst[:0]
+	for i, v := range src {
+		if predicate(i, v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// DifferenceInto is like Difference, but it writes the result into dst.  Above
+// SmallSliceThreshold, the lookup map of comparedSlice is still allocated: see
+// DifferenceIntoHint.
+func DifferenceInto[T comparable](dst []T, slice, comparedSlice []T) []T {
+	return DifferenceIntoHint(dst, slice, comparedSlice, HintAuto)
+}
+
+// DifferenceIntoHint is like DifferenceInto, with the algorithm forced by hint.  HintScan
+// never allocates.
+func DifferenceIntoHint[T comparable](dst []T, slice, comparedSlice []T, hint SetHint) []T {
+	dst = dst[:0]
+
+	if useScan(hint, len(comparedSlice) <= SmallSliceThreshold) {
+		for _, v := range slice {
+			if !stdslices.Contains(comparedSlice, v) {
+				dst = append(dst, v)
+			}
+		}
+		return dst
+	}
+
+	comparedMap := make(map[T]struct{}, len(comparedSlice))
+	for _, v := range comparedSlice {
+		comparedMap[v] = struct{}{}
+	}
+	for _, v := range slice {
+		if _, found := comparedMap[v]; !found {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"fmt"
+	"iter"
+)
+
+// BatchedSeq returns a sequence yielding the values of seq grouped in batches of size
+// elements; the last batch may be shorter.  Values are pulled from seq lazily, one batch
+// at a time, so streams can be batched without being collected first.  Every yielded
+// batch is newly allocated and can be retained.
+// BatchedSeq panics if size is less than 1.
+func BatchedSeq[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic(fmt.Sprintf("BatchedSeq: invalid batch size %d, must be positive", size))
+	}
+
+	return func(yield func([]T) bool) {
+		batch := make([]T, 0, size)
+
+		for v := range seq {
+			batch = append(batch, v)
+			if len(batch) == size {
+				if !yield(batch) {
+					return
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+
+		if len(batch) > 0 {
+			yield(batch)
+		}
+	}
+}
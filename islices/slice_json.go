@@ -0,0 +1,44 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	stdslices "slices"
+
+	"github.com/idichekop/gods/internal/ijson"
+)
+
+// MarshalJSON encodes the slice as a JSON array.  The zero ImmutableSlice encodes as [].
+func (s ImmutableSlice[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(s.Values())
+}
+
+// UnmarshalJSON sets s to a new ImmutableSlice holding the elements of a JSON array.  The
+// previous backing array is left untouched, so views sharing it do not change.
+func (s *ImmutableSlice[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	s.items = items
+	return nil
+}
+
+// MarshalJSON encodes the slice as a JSON array, in ascending order.
+func (s *SortedSlice[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(s.All())
+}
+
+// UnmarshalJSON replaces the contents of the slice with the elements of a JSON array,
+// which need not be sorted.
+func (s *SortedSlice[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	stdslices.Sort(items)
+	s.items = items
+	return nil
+}
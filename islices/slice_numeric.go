@@ -0,0 +1,304 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the set of the numeric types supported by the element-wise operations.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// ErrLengthMismatch is returned by element-wise operations on slices of different lengths.
+var ErrLengthMismatch = errors.New("length mismatch")
+
+// AddSlices returns the element-wise sum of two slices of the same length.
+func AddSlices[T Number](slice1, slice2 []T) ([]T, error) {
+	return zipWith(slice1, slice2, func(a, b T) T { return a + b })
+}
+
+// SubSlices returns the element-wise difference of two slices of the same length.
+func SubSlices[T Number](slice1, slice2 []T) ([]T, error) {
+	return zipWith(slice1, slice2, func(a, b T) T { return a - b })
+}
+
+// MulSlices returns the element-wise product of two slices of the same length.
+func MulSlices[T Number](slice1, slice2 []T) ([]T, error) {
+	return zipWith(slice1, slice2, func(a, b T) T { return a * b })
+}
+
+// AddScalar returns a new slice with scalar added to every element of the slice.
+func AddScalar[T Number](slice []T, scalar T) []T {
+	return Map(slice, func(_ int, v T) T { return v + scalar })
+}
+
+// MulScalar returns a new slice with every element of the slice multiplied by scalar.
+func MulScalar[T Number](slice []T, scalar T) []T {
+	return Map(slice, func(_ int, v T) T { return v * scalar })
+}
+
+// Dot returns the dot product of two slices of the same length.
+func Dot[T Number](slice1, slice2 []T) (T, error) {
+	var sum T
+	if len(slice1) != len(slice2) {
+		return sum, fmt.Errorf("dot of lengths %d and %d: %w", len(slice1), len(slice2), ErrLengthMismatch)
+	}
+
+	for i := range slice1 {
+		sum += slice1[i] * slice2[i]
+	}
+
+	return sum, nil
+}
+
+// Norm returns the Euclidean norm of the slice, seen as a vector.
+func Norm[T Number](slice []T) float64 {
+	var sum float64
+	for _, v := range slice {
+		sum += float64(v) * float64(v)
+	}
+
+	return math.Sqrt(sum)
+}
+
+// zipWith combines two slices of the same length element by element.
+func zipWith[T Number](slice1, slice2 []T, op func(a, b T) T) ([]T, error) {
+	if len(slice1) != len(slice2) {
+		return nil, fmt.Errorf("lengths %d and %d: %w", len(slice1), len(slice2), ErrLengthMismatch)
+	}
+
+	result := make([]T, len(slice1))
+	for i := range slice1 {
+		result[i] = op(slice1[i], slice2[i])
+	}
+
+	return result, nil
+}
+
+// ConvertNumeric converts a slice of numbers to another numeric type.  A value that does
+// not survive the conversion unchanged (overflow, wrapped sign, truncated fraction, lost
+// float precision or NaN) is an error reporting its index, and a nil slice is returned.
+func ConvertNumeric[From Number, To Number](slice []From) ([]To, error) {
+	result := make([]To, len(slice))
+	fromLower, fromUpper, fromInt := intBounds[From]()
+	toLower, toUpper, toInt := intBounds[To]()
+
+	for i, v := range slice {
+		// Go leaves the conversion of out of range floats to integers implementation
+		// defined: it may saturate and then round-trip.  So check the bounds of the
+		// integer type first, both from a float to an integer and back from a float.
+		if !fromInt && toInt && !(float64(v) >= toLower && float64(v) < toUpper) {
+			return nil, fmt.Errorf("value %v at index %d cannot be represented as %T", v, i, To(0))
+		}
+		converted := To(v)
+		if fromInt && !toInt && !(float64(converted) >= fromLower && float64(converted) < fromUpper) {
+			return nil, fmt.Errorf("value %v at index %d cannot be represented as %T", v, i, converted)
+		}
+		if From(converted) != v || (v < 0) != (converted < 0) {
+			return nil, fmt.Errorf("value %v at index %d cannot be represented as %T", v, i, converted)
+		}
+		result[i] = converted
+	}
+
+	return result, nil
+}
+
+// intBounds returns the range [lower, upper) of the floats converting to the integer type
+// T, and whether T is an integer type at all.
+func intBounds[T Number]() (lower, upper float64, ok bool) {
+	if T(1)/2 != 0 {
+		return 0, 0, false
+	}
+	bits := reflect.TypeFor[T]().Bits()
+	if T(0)-1 > 0 {
+		return 0, math.Ldexp(1, bits), true
+	}
+	return -math.Ldexp(1, bits-1), math.Ldexp(1, bits-1), true
+}
+
+// MovingAverage returns the averages of all the windows of window consecutive elements of
+// the slice, in order: the result has len(slice)-window+1 elements.  It is computed with
+// a running sum in O(n).  The sum is exact for integers; for floats, it is compensated
+// against cancellation, and an infinite or NaN element only affects the windows holding
+// it.  The result is empty if window is not in [1, len(slice)].
+func MovingAverage[T Number](slice []T, window int) []float64 {
+	if window <= 0 || window > len(slice) {
+		return []float64{}
+	}
+
+	result := make([]float64, 0, len(slice)-window+1)
+
+	if T(1)/2 == 0 {
+		var sum int128
+		for i, v := range slice {
+			sum = sum.add(int128Of(v))
+			if i >= window {
+				sum = sum.sub(int128Of(slice[i-window]))
+			}
+			if i >= window-1 {
+				result = append(result, sum.float64()/float64(window))
+			}
+		}
+		return result
+	}
+
+	var sum floatSum
+	for i, v := range slice {
+		sum.add(float64(v))
+		if i >= window {
+			sum.remove(float64(slice[i-window]))
+		}
+		if sum.overflowed() {
+			// Sum the window again, as the running sum cannot recover from infinity.
+			sum = floatSum{}
+			for _, w := range slice[max(i-window+1, 0) : i+1] {
+				sum.add(float64(w))
+			}
+		}
+		if i >= window-1 {
+			result = append(result, sum.value()/float64(window))
+		}
+	}
+
+	return result
+}
+
+// int128 is a two's complement integer of 128 bits, which holds exactly any sum of up to
+// 2^63 integers of 64 bits.
+type int128 struct {
+	hi, lo uint64
+}
+
+// int128Of returns the integer v, which must not be a float, as an int128.
+func int128Of[T Number](v T) int128 {
+	if v < 0 {
+		// The conversion sign-extends v.
+		return int128{hi: math.MaxUint64, lo: uint64(v)}
+	}
+	return int128{lo: uint64(v)}
+}
+
+func (a int128) add(b int128) int128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, carry)
+	return int128{hi: hi, lo: lo}
+}
+
+func (a int128) sub(b int128) int128 {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	hi, _ := bits.Sub64(a.hi, b.hi, borrow)
+	return int128{hi: hi, lo: lo}
+}
+
+func (a int128) float64() float64 {
+	if int64(a.hi) < 0 {
+		return -int128{}.sub(a).float64()
+	}
+	return float64(a.hi)*(1<<64) + float64(a.lo)
+}
+
+// floatSum is a running sum of floats.  The finite values are summed with Neumaier's
+// compensation, so that large values leaving the sum do not cancel the small ones; the
+// infinite and NaN values are counted apart, so that removing them restores a finite sum.
+type floatSum struct {
+	sum, compensation    float64
+	nans, posInf, negInf int
+}
+
+func (s *floatSum) add(v float64) {
+	s.update(v, 1)
+}
+
+func (s *floatSum) remove(v float64) {
+	s.update(v, -1)
+}
+
+// update adds v to the sum if delta is 1, or removes it if delta is -1.
+func (s *floatSum) update(v float64, delta int) {
+	switch {
+	case math.IsNaN(v):
+		s.nans += delta
+	case math.IsInf(v, 1):
+		s.posInf += delta
+	case math.IsInf(v, -1):
+		s.negInf += delta
+	default:
+		v *= float64(delta)
+		t := s.sum + v
+		if math.Abs(s.sum) >= math.Abs(v) {
+			s.compensation += (s.sum - t) + v
+		} else {
+			s.compensation += (v - t) + s.sum
+		}
+		s.sum = t
+	}
+}
+
+// overflowed reports whether the sum of the finite values overflowed.
+func (s *floatSum) overflowed() bool {
+	return math.IsInf(s.sum, 0) || math.IsInf(s.compensation, 0) || math.IsNaN(s.compensation)
+}
+
+func (s *floatSum) value() float64 {
+	switch {
+	case s.nans > 0 || s.posInf > 0 && s.negInf > 0:
+		return math.NaN()
+	case s.posInf > 0:
+		return math.Inf(1)
+	case s.negInf > 0:
+		return math.Inf(-1)
+	case math.IsInf(s.sum, 0):
+		return s.sum
+	}
+	return s.sum + s.compensation
+}
+
+// MovingMax returns the maxima of all the windows of window consecutive elements of the
+// slice, in order: the result has len(slice)-window+1 elements.  It is computed with a
+// monotonic deque in O(n).  The result is empty if window is not in [1, len(slice)].
+func MovingMax[T constraints.Ordered](slice []T, window int) []T {
+	return movingExtreme(slice, window, func(a, b T) bool { return a >= b })
+}
+
+// MovingMin is like MovingMax, for the minima of the windows.
+func MovingMin[T constraints.Ordered](slice []T, window int) []T {
+	return movingExtreme(slice, window, func(a, b T) bool { return a <= b })
+}
+
+// movingExtreme computes the sliding extreme of slice, where dominates(a, b) reports
+// whether a is at least as extreme as b.
+func movingExtreme[T constraints.Ordered](slice []T, window int, dominates func(a, b T) bool) []T {
+	if window <= 0 || window > len(slice) {
+		return []T{}
+	}
+
+	result := make([]T, 0, len(slice)-window+1)
+
+	// deque holds indexes of slice, whose values are strictly decreasing in extremeness.
+	deque := make([]int, 0, window)
+	for i, v := range slice {
+		if len(deque) > 0 && deque[0] <= i-window {
+			deque = deque[1:]
+		}
+		for len(deque) > 0 && dominates(v, slice[deque[len(deque)-1]]) {
+			deque = deque[:len(deque)-1]
+		}
+		deque = append(deque, i)
+
+		if i >= window-1 {
+			result = append(result, slice[deque[0]])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	stdslices "slices"
+
+	"github.com/idichekop/gods/icompare"
+	"golang.org/x/exp/constraints"
+)
+
+// Ordering is a multi-key sort specification, built with OrderBy and ThenBy.  Each key is
+// compared in turn; the first key on which two elements differ decides their order.
+//
+// ThenBy receives a comparator instead of a key function.  Use CompareBy to turn a key
+// function into one:
+//
+//	OrderBy(func(e Employee) string { return e.Dept }).
+//		ThenBy(CompareBy(func(e Employee) int { return e.Salary })).Desc().
+//		Sort(employees)
+//
+// An Ordering is immutable: ThenBy, Asc and Desc return a new Ordering, so a common
+// prefix may be shared by several orderings.
+type Ordering[T any] struct {
+	steps []orderingStep[T]
+}
+
+type orderingStep[T any] struct {
+	compare icompare.Comparator[T]
+	order   SortOrder
+}
+
+// OrderBy starts an Ordering on the keys produced by the key function, in ascending order.
+func OrderBy[T any, K constraints.Ordered](key func(item T) K) *Ordering[T] {
+	return OrderByFunc(CompareBy(key))
+}
+
+// OrderByFunc starts an Ordering on the given comparator, in ascending order.
+// The comparator returns a negative number when a < b, a positive number when a > b and
+// zero when they are equal.
+func OrderByFunc[T any](compare icompare.Comparator[T]) *Ordering[T] {
+	return &Ordering[T]{steps: []orderingStep[T]{{compare: compare, order: Asc}}}
+}
+
+// CompareBy returns a comparator ordering elements by the keys produced by the key function.
+// It is icompare.ByKey, kept here for discoverability.
+func CompareBy[T any, K constraints.Ordered](key func(item T) K) icompare.Comparator[T] {
+	return icompare.ByKey(key)
+}
+
+// ThenBy returns a copy of the Ordering with a tie-breaking comparator added, in ascending
+// order.
+func (o *Ordering[T]) ThenBy(compare icompare.Comparator[T]) *Ordering[T] {
+	step := orderingStep[T]{compare: compare, order: Asc}
+	return &Ordering[T]{steps: append(stdslices.Clone(o.steps), step)}
+}
+
+// Asc returns a copy of the Ordering with the most recently added key in ascending order.
+func (o *Ordering[T]) Asc() *Ordering[T] {
+	return o.withLastOrder(Asc)
+}
+
+// Desc returns a copy of the Ordering with the most recently added key in descending
+// order.
+func (o *Ordering[T]) Desc() *Ordering[T] {
+	return o.withLastOrder(Desc)
+}
+
+func (o *Ordering[T]) withLastOrder(order SortOrder) *Ordering[T] {
+	steps := stdslices.Clone(o.steps)
+	steps[len(steps)-1].order = order
+	return &Ordering[T]{steps: steps}
+}
+
+// Compare compiles all the keys of the Ordering into a single comparator.
+func (o *Ordering[T]) Compare(a, b T) int {
+	for _, step := range o.steps {
+		c := step.compare(a, b)
+		if c == 0 {
+			continue
+		}
+		if step.order == Desc {
+			return -c
+		}
+		return c
+	}
+	return 0
+}
+
+// Sort sorts the slice in place with a single stable pass.  Elements equal on every key
+// keep their original order.
+func (o *Ordering[T]) Sort(slice []T) {
+	stdslices.SortStableFunc(slice, o.Compare)
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"iter"
+	stdslices "slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SortedSlice is a slice kept in ascending order on every insertion.  Inserts and deletes
+// find their position by binary search, so they cost O(log n) comparisons plus the O(n)
+// shift of the following elements, instead of the O(n log n) of InsertAt followed by Sort.
+// Duplicates are allowed.  A SortedSlice is not safe for concurrent use.
+type SortedSlice[T constraints.Ordered] struct {
+	items []T
+}
+
+// NewSortedSlice creates a SortedSlice holding the given items.  The items are copied.
+func NewSortedSlice[T constraints.Ordered](items ...T) *SortedSlice[T] {
+	s := &SortedSlice[T]{items: stdslices.Clone(items)}
+	stdslices.Sort(s.items)
+	return s
+}
+
+// Len returns the number of elements.
+func (s *SortedSlice[T]) Len() int {
+	return len(s.items)
+}
+
+// At returns the element at the given position of the sorted order.  It panics if index
+// is out of range.
+func (s *SortedSlice[T]) At(index int) T {
+	return s.items[index]
+}
+
+// Insert adds the item at its sorted position, after the elements equal to it.
+func (s *SortedSlice[T]) Insert(item T) {
+	i := s.upperBound(item)
+	s.items = stdslices.Insert(s.items, i, item)
+}
+
+// Delete removes one occurrence of the item, and reports whether it was found.
+func (s *SortedSlice[T]) Delete(item T) bool {
+	i, found := stdslices.BinarySearch(s.items, item)
+	if !found {
+		return false
+	}
+	s.items = stdslices.Delete(s.items, i, i+1)
+	return true
+}
+
+// Contains reports whether the item is in the slice.
+func (s *SortedSlice[T]) Contains(item T) bool {
+	_, found := stdslices.BinarySearch(s.items, item)
+	return found
+}
+
+// Rank returns the number of elements strictly less than the item, i.e. the position the
+// first occurrence of the item has, or would have.
+func (s *SortedSlice[T]) Rank(item T) int {
+	i, _ := stdslices.BinarySearch(s.items, item)
+	return i
+}
+
+// ToSlice returns a copy of the elements, in ascending order.
+func (s *SortedSlice[T]) ToSlice() []T {
+	return stdslices.Clone(s.items)
+}
+
+// All returns an iterator over the elements, in ascending order.
+func (s *SortedSlice[T]) All() iter.Seq[T] {
+	return stdslices.Values(s.items)
+}
+
+// Values returns an iterator over the elements, in ascending order.
+func (s *SortedSlice[T]) Values() iter.Seq[T] {
+	return s.All()
+}
+
+// Range returns an iterator over the elements in [from, to), in ascending order.
+func (s *SortedSlice[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.Rank(from); i < len(s.items) && s.items[i] < to; i++ {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// upperBound returns the position after the last element equal to or less than item.
+func (s *SortedSlice[T]) upperBound(item T) int {
+	lo, hi := 0, len(s.items)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s.items[mid] <= item {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
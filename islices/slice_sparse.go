@@ -0,0 +1,119 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package islice
+
+import (
+	"iter"
+	"maps"
+	stdslices "slices"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// SparseSlice is a slice whose elements are mostly the zero value.  Only the explicitly
+// set elements are stored, in a map from index to value; every other index reads as the
+// zero value of T.  A SparseSlice is not safe for concurrent use.
+type SparseSlice[T comparable] struct {
+	length int
+	values map[int]T
+}
+
+// NewSparseSlice creates a SparseSlice of the given length, with all elements set to the
+// zero value.
+func NewSparseSlice[T comparable](length int) *SparseSlice[T] {
+	if length < 0 {
+		panic("NewSparseSlice: negative length")
+	}
+	return &SparseSlice[T]{length: length, values: make(map[int]T)}
+}
+
+// SparseSliceFrom creates a SparseSlice holding the non-zero elements of a dense slice.
+func SparseSliceFrom[T comparable](dense []T) *SparseSlice[T] {
+	s := NewSparseSlice[T](len(dense))
+	var zero T
+	for i, v := range dense {
+		if v != zero {
+			s.values[i] = v
+		}
+	}
+	return s
+}
+
+// Len returns the length of the slice, stored elements or not.
+func (s *SparseSlice[T]) Len() int {
+	return s.length
+}
+
+// Stored returns the number of explicitly stored elements.
+func (s *SparseSlice[T]) Stored() int {
+	return len(s.values)
+}
+
+// Get returns the element at index, or the zero value if it is not stored.  It panics if
+// index is out of range.
+func (s *SparseSlice[T]) Get(index int) T {
+	s.checkIndex(index)
+	return s.values[index]
+}
+
+// Set stores the value at index.  Setting an index beyond the length grows the slice; it
+// panics if index is negative.
+func (s *SparseSlice[T]) Set(index int, value T) {
+	if index < 0 {
+		panic("SparseSlice.Set: negative index")
+	}
+	s.values[index] = value
+	s.length = max(s.length, index+1)
+}
+
+// Delete resets the element at index to the zero value, releasing its storage.
+func (s *SparseSlice[T]) Delete(index int) {
+	delete(s.values, index)
+}
+
+// Compact releases the storage of the elements explicitly set to the zero value.
+func (s *SparseSlice[T]) Compact() {
+	var zero T
+	maps.DeleteFunc(s.values, func(_ int, v T) bool {
+		return v == zero
+	})
+}
+
+// Dense materializes the slice as a regular slice of length Len.
+func (s *SparseSlice[T]) Dense() []T {
+	result := make([]T, s.length)
+	for i, v := range s.values {
+		result[i] = v
+	}
+	return result
+}
+
+// All returns an iterator over the stored elements, in ascending index order.
+func (s *SparseSlice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for _, i := range stdslices.Sorted(maps.Keys(s.values)) {
+			if !yield(i, s.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the indexes of the stored elements, in ascending order.
+func (s *SparseSlice[T]) Keys() iter.Seq[int] {
+	return icontainer.Keys(s.All())
+}
+
+// Values returns an iterator over the stored elements, in ascending index order.
+func (s *SparseSlice[T]) Values() iter.Seq[T] {
+	return icontainer.Values(s.All())
+}
+
+// checkIndex panics if index is out of range.
+func (s *SparseSlice[T]) checkIndex(index int) {
+	if index < 0 || index >= s.length {
+		panic("SparseSlice: index out of range")
+	}
+}
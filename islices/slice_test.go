@@ -1,16 +1,26 @@
 package islice
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
+	stdslices "slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/idichekop/gods/icompare"
 	"github.com/idichekop/gods/internal"
+	"github.com/idichekop/gods/ioptional"
+	"github.com/idichekop/gods/ituples"
 )
 
 func TestContainsSubSlice(t *testing.T) {
@@ -175,6 +185,120 @@ func TestGroupWith(t *testing.T) {
 	assert.Equal(expected, GroupBy(nums, floor))
 }
 
+func TestGroupByWith(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGroupByWith")
+
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	firstLetter := func(s string) byte { return s[0] }
+	length := func(s string) int { return len(s) }
+
+	expected := map[byte][]int{
+		'a': {5, 7},
+		'b': {6, 9},
+		'c': {6},
+	}
+
+	assert.Equal(expected, GroupByWith(words, firstLetter, length))
+	assert.Equal(map[byte][]int{}, GroupByWith([]string{}, firstLetter, length))
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGroupByOrdered")
+
+	nums := []int{5, 2, 8, 3, 4, 1}
+	parity := func(n int) string {
+		if n%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	groups, keys := GroupByOrdered(nums, parity)
+
+	assert.Equal([]string{"odd", "even"}, keys)
+	assert.Equal(map[string][]int{
+		"odd":  {5, 3, 1},
+		"even": {2, 8, 4},
+	}, groups)
+
+	groups, keys = GroupByOrdered([]int{}, parity)
+	assert.Equal([]string{}, keys)
+	assert.Equal(0, len(groups))
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCompact")
+
+	assert.Equal([]int{1, 2, 3}, Compact([]int{0, 1, 0, 2, 3, 0}))
+	assert.Equal([]string{"a", "b"}, Compact([]string{"", "a", "", "b"}))
+	assert.Equal([]int{}, Compact([]int{0, 0}))
+	assert.Equal([]int{}, Compact([]int{}))
+
+	one, two := 1, 2
+	ptrs := Compact([]*int{nil, &one, nil, &two})
+	assert.Equal(2, len(ptrs))
+	assert.Equal(1, *ptrs[0])
+	assert.Equal(2, *ptrs[1])
+}
+
+func TestCompactBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCompactBy")
+
+	words := []string{"a", " ", "b", "\t", ""}
+	result := CompactBy(words, func(s string) bool {
+		return strings.TrimSpace(s) != ""
+	})
+
+	assert.Equal([]string{"a", "b"}, result)
+	assert.Equal([]string{"a", " ", "b", "\t", ""}, words)
+}
+
+func TestGroupConsecutiveBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGroupConsecutiveBy")
+
+	type event struct {
+		user string
+		at   int
+	}
+	events := []event{{"a", 1}, {"a", 2}, {"b", 3}, {"a", 4}, {"a", 5}, {"a", 6}}
+
+	groups := GroupConsecutiveBy(events, func(e event) string { return e.user })
+	assert.Equal([][]event{
+		{{"a", 1}, {"a", 2}},
+		{{"b", 3}},
+		{{"a", 4}, {"a", 5}, {"a", 6}},
+	}, groups)
+
+	assert.Equal([][]int{}, GroupConsecutiveBy([]int{}, func(n int) int { return n }))
+	assert.Equal([][]int{{1}}, GroupConsecutiveBy([]int{1}, func(n int) int { return n }))
+}
+
+func TestGroupByPairs(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGroupByPairs")
+
+	nums := []int{5, 2, 8, 3}
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	assert.Equal([]ituples.Pair[bool, []int]{
+		ituples.NewPair(false, []int{5, 3}),
+		ituples.NewPair(true, []int{2, 8}),
+	}, GroupByPairs(nums, isEven))
+
+	assert.Equal([]ituples.Pair[bool, []int]{}, GroupByPairs([]int{}, isEven))
+}
+
 func TestCount(t *testing.T) {
 	t.Parallel()
 
@@ -220,6 +344,102 @@ func TestFindLastBy(t *testing.T) {
 	assert.Equal(result == 0 && ok == false, true)
 }
 
+func TestFirstNonZero(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFirstNonZero")
+
+	v, ok := FirstNonZero("", "", "b", "c")
+	assert.Equal("b", v)
+	assert.ShouldBeTrue(ok)
+
+	n, ok := FirstNonZero(0, 0)
+	assert.Equal(0, n)
+	assert.ShouldBeFalse(ok)
+
+	n, ok = FirstNonZero[int]()
+	assert.Equal(0, n)
+	assert.ShouldBeFalse(ok)
+
+	one := 1
+	p, ok := FirstNonZero(nil, &one)
+	assert.Equal(&one, p)
+	assert.ShouldBeTrue(ok)
+}
+
+func TestFirstOrDefault(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFirstOrDefault")
+
+	assert.Equal(1, FirstOrDefault([]int{1, 2}, 9))
+	assert.Equal(9, FirstOrDefault([]int{}, 9))
+	assert.Equal(9, FirstOrDefault(nil, 9))
+}
+
+func TestFirstBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFirstBy")
+
+	isEven := func(_ int, n int) bool { return n%2 == 0 }
+
+	v, ok := FirstBy([]int{1, 4, 6}, isEven)
+	assert.Equal(4, v)
+	assert.ShouldBeTrue(ok)
+
+	v, ok = FirstBy([]int{1, 3}, isEven)
+	assert.Equal(0, v)
+	assert.ShouldBeFalse(ok)
+}
+
+func TestFindIndexBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFindIndexBy")
+
+	nums := []int{1, 2, 3, 4, 5}
+	isEven := func(_ int, n int) bool { return n%2 == 0 }
+	isNegative := func(_ int, n int) bool { return n < 0 }
+
+	index, ok := FindIndexBy(nums, isEven)
+	assert.Equal(1, index)
+	assert.ShouldBeTrue(ok)
+
+	index, ok = FindIndexBy(nums, isNegative)
+	assert.Equal(-1, index)
+	assert.ShouldBeFalse(ok)
+
+	index, ok = FindLastIndexBy(nums, isEven)
+	assert.Equal(3, index)
+	assert.ShouldBeTrue(ok)
+
+	index, ok = FindLastIndexBy(nums, isNegative)
+	assert.Equal(-1, index)
+	assert.ShouldBeFalse(ok)
+
+	index, ok = FindLastIndexBy([]int{}, isEven)
+	assert.Equal(-1, index)
+	assert.ShouldBeFalse(ok)
+}
+
+func TestFindOpt(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFindOpt")
+
+	nums := []int{1, 2, 3, 4}
+	isEven := func(_ int, n int) bool { return n%2 == 0 }
+
+	assert.Equal(ioptional.Some(2), FindOpt(nums, isEven))
+	assert.Equal(ioptional.None[int](), FindOpt([]int{1, 3}, isEven))
+
+	assert.Equal(ioptional.Some(1), FirstOpt(nums))
+	assert.Equal(ioptional.Some(4), LastOpt(nums))
+	assert.Equal(ioptional.None[int](), FirstOpt([]int{}))
+	assert.Equal(ioptional.None[int](), LastOpt[int](nil))
+}
+
 func TestFindLast(t *testing.T) {
 	t.Parallel()
 
@@ -259,6 +479,36 @@ func TestFlattenDeep(t *testing.T) {
 	assert.Equal(expected, FlattenDeep(input))
 }
 
+func TestFlattenN(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFlattenN")
+
+	input := [][][]string{{{"a", "b"}}, {{"c"}, {"d"}}, {}}
+
+	result, err := FlattenN(input, 0)
+	assert.IsNil(err)
+	assert.Equal(input, result)
+
+	result, err = FlattenN(input, 1)
+	assert.IsNil(err)
+	assert.Equal([][]string{{"a", "b"}, {"c"}, {"d"}}, result)
+
+	result, err = FlattenN(input, 2)
+	assert.IsNil(err)
+	assert.Equal([]string{"a", "b", "c", "d"}, result)
+
+	result, err = FlattenN(input, 5)
+	assert.IsNil(err)
+	assert.Equal(FlattenDeep(input), result)
+
+	_, err = FlattenN(input, -1)
+	assert.Equal("FlattenN: invalid depth -1, must not be negative", err.Error())
+
+	_, err = FlattenN("abc", 1)
+	assert.Equal("FlattenN: input of type string is not a slice", err.Error())
+}
+
 func TestForEach(t *testing.T) {
 	t.Parallel()
 
@@ -507,6 +757,39 @@ func TestReduceBy(t *testing.T) {
 	assert.Equal("1234", result2)
 }
 
+func TestReduceWhile(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestReduceWhile")
+
+	costs := []int{3, 4, 2, 5, 1}
+	budget := 9
+
+	calls := 0
+	withinBudget := func(_ int, cost int, spent int) (int, bool) {
+		calls++
+		if spent+cost > budget {
+			return spent, false
+		}
+		return spent + cost, true
+	}
+
+	assert.Equal(9, ReduceWhile(costs, 0, withinBudget))
+	assert.Equal(4, calls)
+
+	assert.Equal(15, ReduceWhile(costs, 0, func(_ int, cost int, spent int) (int, bool) {
+		return spent + cost, true
+	}))
+
+	assert.Equal("start", ReduceWhile([]int{}, "start", func(_ int, n int, agg string) (string, bool) {
+		return agg + strconv.Itoa(n), true
+	}))
+
+	assert.Equal("start1", ReduceWhile([]int{1, 2}, "start", func(_ int, n int, agg string) (string, bool) {
+		return agg + strconv.Itoa(n), false
+	}))
+}
+
 func TestReduceRight(t *testing.T) {
 	t.Parallel()
 
@@ -702,6 +985,156 @@ func TestInsertAt(t *testing.T) {
 	}
 }
 
+func TestInsertValueAt(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestInsertValueAt")
+
+	slice := []string{"a", "b", "c"}
+
+	result, err := InsertValueAt(slice, 0, "1")
+	assert.IsNil(err)
+	assert.Equal([]string{"1", "a", "b", "c"}, result)
+
+	result, err = InsertValueAt(slice, 3, "1")
+	assert.IsNil(err)
+	assert.Equal([]string{"a", "b", "c", "1"}, result)
+	assert.Equal([]string{"a", "b", "c"}, slice)
+
+	_, err = InsertValueAt(slice, -1, "1")
+	assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+
+	_, err = InsertValueAt(slice, 4, "1")
+	assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+}
+
+func TestInsertSliceAt(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestInsertSliceAt")
+
+	slice := []string{"a", "b", "c"}
+
+	result, err := InsertSliceAt(slice, 1, []string{"1", "2"})
+	assert.IsNil(err)
+	assert.Equal([]string{"a", "1", "2", "b", "c"}, result)
+
+	result, err = InsertSliceAt(slice, 3, nil)
+	assert.IsNil(err)
+	assert.Equal([]string{"a", "b", "c"}, result)
+
+	_, err = InsertSliceAt(slice, 5, []string{"1"})
+	assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+}
+
+func TestIndexMutationsE(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIndexMutationsE")
+
+	slice := []string{"a", "b", "c"}
+
+	t.Run("DeleteAtE", func(t *testing.T) {
+		result, err := DeleteAtE(slice, 1)
+		assert.IsNil(err)
+		assert.Equal([]string{"a", "c"}, result)
+
+		for _, index := range []int{-1, 3} {
+			_, err = DeleteAtE(slice, index)
+			assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+		}
+	})
+
+	t.Run("UpdateAtE", func(t *testing.T) {
+		result, err := UpdateAtE(slice, 2, "z")
+		assert.IsNil(err)
+		assert.Equal([]string{"a", "b", "z"}, result)
+
+		_, err = UpdateAtE(slice, 3, "z")
+		assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+	})
+
+	t.Run("DeleteRangeE", func(t *testing.T) {
+		result, err := DeleteRangeE(slice, 0, 2)
+		assert.IsNil(err)
+		assert.Equal([]string{"c"}, result)
+
+		result, err = DeleteRangeE(slice, 3, 3)
+		assert.IsNil(err)
+		assert.Equal([]string{"a", "b", "c"}, result)
+
+		for _, r := range [][2]int{{-1, 1}, {2, 1}, {1, 4}} {
+			_, err = DeleteRangeE(slice, r[0], r[1])
+			assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+		}
+	})
+
+	t.Run("InsertAtE", func(t *testing.T) {
+		result, err := InsertAtE(slice, 1, "x")
+		assert.IsNil(err)
+		assert.Equal([]string{"a", "x", "b", "c"}, result)
+
+		result, err = InsertAtE(slice, 3, []string{"x", "y"})
+		assert.IsNil(err)
+		assert.Equal([]string{"a", "b", "c", "x", "y"}, result)
+
+		_, err = InsertAtE(slice, 4, "x")
+		assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+
+		_, err = InsertAtE(slice, 0, 1)
+		assert.IsNotNil(err)
+		assert.Equal(false, errors.Is(err, ErrIndexOutOfRange))
+
+		anys, err := InsertAtE([]any{1, 2}, 1, []any{"x", "y"})
+		assert.IsNil(err)
+		assert.Equal([]any{1, "x", "y", 2}, anys)
+		anys, err = InsertAtE([]any{1, 2}, 1, "x")
+		assert.IsNil(err)
+		assert.Equal([]any{1, "x", 2}, anys)
+
+		anys, err = InsertAtE([]any{1, 2}, 2, nil)
+		assert.IsNil(err)
+		assert.Equal([]any{1, 2, nil}, anys)
+		errs, err := InsertAtE([]error{io.EOF}, 0, nil)
+		assert.IsNil(err)
+		assert.Equal([]error{nil, io.EOF}, errs)
+		_, err = InsertAtE([]any{}, 1, nil)
+		assert.Equal(true, errors.Is(err, ErrIndexOutOfRange))
+	})
+
+	assert.Equal([]string{"a", "b", "c"}, slice)
+}
+
+func TestSplice(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSplice")
+
+	slice := []string{"a", "b", "c", "d"}
+
+	tests := []struct {
+		start       int
+		deleteCount int
+		items       []string
+		want        []string
+	}{
+		{1, 2, []string{"x", "y", "z"}, []string{"a", "x", "y", "z", "d"}},
+		{1, 0, []string{"x"}, []string{"a", "x", "b", "c", "d"}},
+		{0, 4, nil, []string{}},
+		{2, 10, nil, []string{"a", "b"}},
+		{-1, 1, []string{"x"}, []string{"a", "b", "c", "x"}},
+		{-10, 1, nil, []string{"b", "c", "d"}},
+		{10, 1, []string{"x"}, []string{"a", "b", "c", "d", "x"}},
+		{1, -1, []string{"x"}, []string{"a", "x", "b", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.want, Splice(slice, tt.start, tt.deleteCount, tt.items...))
+	}
+
+	assert.Equal([]string{"a", "b", "c", "d"}, slice)
+}
+
 func TestUpdateAt(t *testing.T) {
 	t.Parallel()
 
@@ -722,6 +1155,87 @@ func TestUnique(t *testing.T) {
 	assert.Equal([]string{"a", "b", "c"}, Unique([]string{"a", "a", "b", "c"}))
 }
 
+func TestUniqueDoesNotModifyInput(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestUniqueDoesNotModifyInput")
+
+	nums := []int{1, 2, 1, 3, 2}
+	assert.Equal([]int{1, 2, 3}, Unique(nums))
+	assert.Equal([]int{1, 2, 1, 3, 2}, nums)
+
+	assert.Equal([]int{1, 2}, UniqueBy(nums, func(n int) int { return n % 2 }))
+	assert.Equal([]int{1, 2, 1, 3, 2}, nums)
+}
+
+func TestFilterInPlace(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFilterInPlace")
+
+	nums := []int{1, 2, 3, 4, 5, 6}
+	n := FilterInPlace(nums, func(_ int, v int) bool { return v%2 == 0 })
+
+	assert.Equal(3, n)
+	assert.Equal([]int{2, 4, 6, 0, 0, 0}, nums)
+
+	ptrs := []*int{&nums[0], nil, &nums[1]}
+	n = FilterInPlace(ptrs, func(_ int, p *int) bool { return p != nil })
+	assert.Equal(2, n)
+	assert.IsNil(ptrs[2])
+
+	assert.Equal(0, FilterInPlace([]int{}, func(_ int, v int) bool { return true }))
+}
+
+func TestMapInPlace(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMapInPlace")
+
+	nums := []int{1, 2, 3}
+	MapInPlace(nums, func(i int, v int) int { return v*10 + i })
+
+	assert.Equal([]int{10, 21, 32}, nums)
+}
+
+func TestReverseRange(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestReverseRange")
+
+	nums := []int{1, 2, 3, 4, 5}
+
+	ReverseRange(nums, 1, 4)
+	assert.Equal([]int{1, 4, 3, 2, 5}, nums)
+
+	ReverseRange(nums, 2, 2)
+	assert.Equal([]int{1, 4, 3, 2, 5}, nums)
+
+	ReverseRange(nums, 0, 5)
+	assert.Equal([]int{5, 2, 3, 4, 1}, nums)
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	ReverseRange(nums, 3, 6)
+}
+
+func TestUniqueSorted(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestUniqueSorted")
+
+	nums := []int{1, 1, 2, 3, 3, 3, 4}
+	n := UniqueSorted(nums)
+
+	assert.Equal(4, n)
+	assert.Equal([]int{1, 2, 3, 4}, nums[:n])
+	assert.Equal([]int{0, 0, 0}, nums[n:])
+
+	assert.Equal(0, UniqueSorted([]string{}))
+	assert.Equal(1, UniqueSorted([]string{"a", "a"}))
+}
+
 func TestUniqueBy(t *testing.T) {
 	t.Parallel()
 
@@ -734,6 +1248,27 @@ func TestUniqueBy(t *testing.T) {
 	assert.Equal([]int{1, 2, 3, 4}, actual)
 }
 
+func TestDedupeConsecutive(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestDedupeConsecutive")
+
+	slice := []int{1, 1, 2, 2, 2, 1, 3, 3}
+	assert.Equal([]int{1, 2, 1, 3}, DedupeConsecutive(slice))
+	assert.Equal([]int{1, 1, 2, 2, 2, 1, 3, 3}, slice)
+	assert.Equal([]int{}, DedupeConsecutive([]int{}))
+	assert.Equal([]string{""}, DedupeConsecutive([]string{"", ""}))
+}
+
+func TestDedupeConsecutiveBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestDedupeConsecutiveBy")
+
+	words := []string{"Go", "GO", "go", "rust", "Rust", "go"}
+	assert.Equal([]string{"Go", "rust", "go"}, DedupeConsecutiveBy(words, strings.ToLower))
+}
+
 func TestUniqueByField(t *testing.T) {
 	t.Parallel()
 
@@ -840,6 +1375,30 @@ func TestUnionBy(t *testing.T) {
 	assert.Equal(result, []int{0, 2, 4, 10})
 }
 
+func TestInterleave(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestInterleave")
+
+	assert.Equal([]int{1, 4, 6, 2, 5, 7, 3, 8, 9}, Interleave([]int{1, 2, 3}, []int{4, 5}, []int{6, 7, 8, 9}))
+	assert.Equal([]int{1, 2}, Interleave([]int{}, []int{1, 2}))
+	assert.Equal([]int{}, Interleave[int]())
+	assert.Equal([]string{"a", "x", "b"}, Interleave([]string{"a", "b"}, []string{"x"}))
+}
+
+func TestRoundRobin(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRoundRobin")
+
+	a := []int{1, 2, 3, 4, 5}
+	b := []int{10, 20, 30}
+
+	assert.Equal([]int{1, 2, 10, 20, 3, 4, 30, 5}, RoundRobin(2, a, b))
+	assert.Equal([]int{1, 2, 3, 10, 20, 30, 4, 5}, RoundRobin(3, a, b))
+	assert.Equal(Interleave(a, b), RoundRobin(0, a, b))
+}
+
 func TestIntersection(t *testing.T) {
 	t.Parallel()
 
@@ -868,6 +1427,29 @@ func TestIntersection(t *testing.T) {
 	}
 }
 
+func TestIntersectionCounting(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIntersectionCounting")
+
+	assert.Equal([]int{1, 1}, IntersectionCounting([]int{1, 1, 2}, []int{1, 1, 1}))
+	assert.Equal([]int{2, 1, 2}, IntersectionCounting([]int{2, 3, 1, 2, 2}, []int{1, 2, 2, 4}, []int{2, 1, 2, 1}))
+	assert.Equal([]int{}, IntersectionCounting([]int{1, 2}, []int{}))
+	assert.Equal([]int{1, 1}, IntersectionCounting([]int{1, 1}))
+	assert.Equal([]int{}, IntersectionCounting[int]())
+}
+
+func TestDifferenceCounting(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestDifferenceCounting")
+
+	assert.Equal([]int{1, 1}, DifferenceCounting([]int{1, 1, 1, 2}, []int{1, 2, 2}))
+	assert.Equal([]int{3, 1}, DifferenceCounting([]int{1, 3, 1}, []int{1}))
+	assert.Equal([]int{1, 2}, DifferenceCounting([]int{1, 2}, []int{}))
+	assert.Equal([]int{}, DifferenceCounting([]int{}, []int{1}))
+}
+
 func TestSymmetricDifference(t *testing.T) {
 	t.Parallel()
 
@@ -882,29 +1464,81 @@ func TestSymmetricDifference(t *testing.T) {
 	assert.Equal([]int{3, 4, 5}, SymmetricDifference(s1, s2, s3))
 }
 
-func TestReverse(t *testing.T) {
+func TestSymmetricDifferenceBy(t *testing.T) {
 	t.Parallel()
 
-	assert := internal.NewAssert(t, "TestReverse")
+	assert := internal.NewAssert(t, "TestSymmetricDifferenceBy")
 
-	s1 := []int{1, 2, 3, 4, 5}
-	Reverse(s1)
-	assert.Equal([]int{5, 4, 3, 2, 1}, s1)
+	type user struct {
+		ID   int
+		Tags []string
+	}
+	byID := func(u user) int { return u.ID }
 
-	s2 := []string{"a", "b", "c", "d", "e"}
-	Reverse(s2)
-	assert.Equal([]string{"e", "d", "c", "b", "a"}, s2)
-}
+	a := []user{{1, nil}, {2, []string{"x"}}, {3, nil}}
+	b := []user{{2, nil}, {4, []string{"y"}}, {4, nil}}
 
-func TestReverseCopy(t *testing.T) {
-	t.Parallel()
-	assert := internal.NewAssert(t, "TestReverseCopy")
+	result := SymmetricDifferenceBy(byID, a, b)
+	assert.Equal([]int{1, 3, 4}, Map(result, func(_ int, u user) int { return u.ID }))
+	assert.Equal([]string{"y"}, result[2].Tags)
 
-	numbers := []int{1, 2, 3, 4, 5}
-	reversedNumbers := ReverseCopy(numbers)
+	floor := func(f float64) float64 { return math.Floor(f) }
+	assert.Equal([]float64{1.2, 3.4}, SymmetricDifferenceBy(floor, []float64{2.1, 1.2}, []float64{2.3, 3.4}))
+	assert.Equal([]float64{1.2, 3.4, 4.5}, SymmetricDifferenceBy(floor, []float64{2.1, 1.2}, []float64{2.3, 3.4}, []float64{2.5, 1.5, 4.5}))
 
-	assert.Equal([]int{5, 4, 3, 2, 1}, reversedNumbers)
-	assert.Equal([]int{1, 2, 3, 4, 5}, numbers)
+	assert.Equal(SymmetricDifference([]int{1, 2, 2}), SymmetricDifferenceBy(func(n int) int { return n }, []int{1, 2, 2}))
+	assert.Equal([]int{}, SymmetricDifferenceBy(func(n int) int { return n }))
+}
+
+func TestPowerSet(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPowerSet")
+
+	assert.Equal([][]int{
+		{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3},
+	}, stdslices.Collect(PowerSet([]int{1, 2, 3})))
+
+	assert.Equal([][]int{{}, {1}, {2}, {3}}, stdslices.Collect(PowerSet([]int{1, 2, 3}, 1)))
+	assert.Equal([][]int{{}}, stdslices.Collect(PowerSet([]int{1, 2, 3}, 0)))
+	assert.Equal(8, len(stdslices.Collect(PowerSet([]int{1, 2, 3}, 10))))
+	assert.Equal([][]int{{}}, stdslices.Collect(PowerSet([]int{})))
+
+	// the sequence must be lazy and stoppable
+	large := make([]int, 64)
+	count := 0
+	for range PowerSet(large) {
+		count++
+		if count == 100 {
+			break
+		}
+	}
+	assert.Equal(100, count)
+}
+
+func TestReverse(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestReverse")
+
+	s1 := []int{1, 2, 3, 4, 5}
+	Reverse(s1)
+	assert.Equal([]int{5, 4, 3, 2, 1}, s1)
+
+	s2 := []string{"a", "b", "c", "d", "e"}
+	Reverse(s2)
+	assert.Equal([]string{"e", "d", "c", "b", "a"}, s2)
+}
+
+func TestReverseCopy(t *testing.T) {
+	t.Parallel()
+	assert := internal.NewAssert(t, "TestReverseCopy")
+
+	numbers := []int{1, 2, 3, 4, 5}
+	reversedNumbers := ReverseCopy(numbers)
+
+	assert.Equal([]int{5, 4, 3, 2, 1}, reversedNumbers)
+	assert.Equal([]int{1, 2, 3, 4, 5}, numbers)
 }
 
 func TestDifference(t *testing.T) {
@@ -918,6 +1552,97 @@ func TestDifference(t *testing.T) {
 	assert.Equal([]int{1, 2, 3}, Difference(s1, s2))
 }
 
+func TestSetOperationsSmallAndLarge(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSetOperationsSmallAndLarge")
+
+	// The same inputs, below and above SmallSliceThreshold, must give the same results.
+	for _, size := range []int{SmallSliceThreshold / 2, SmallSliceThreshold * 4} {
+		s1 := make([]int, size)
+		s2 := make([]int, size)
+		for i := range size {
+			s1[i] = i % 7
+			s2[i] = i % 5
+		}
+		small := s1[:5]
+
+		assert.Equal(differenceScan(s1, s2), Difference(s1, s2))
+		assert.Equal(intersectionScan([][]int{s1, s2}), Intersection(s1, s2))
+		assert.Equal([]int{0, 1, 2, 3, 4}, Intersection(s1, s2))
+		assert.Equal(true, ContainsSubSlice(s1, small))
+		assert.Equal(containsSubSliceScan(s2, s1), ContainsSubSlice(s2, s1))
+	}
+
+	assert.Equal(false, containsSubSliceScan([]int{1, 2}, []int{1, 1}))
+	assert.Equal(true, containsSubSliceScan([]int{1, 2, 1}, []int{1, 1}))
+}
+
+func TestSetHint(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSetHint")
+
+	// Every hint gives the same results, whatever the sizes.
+	for _, size := range []int{SmallSliceThreshold / 2, SmallSliceThreshold * 4} {
+		s1 := make([]int, size)
+		s2 := make([]int, size)
+		for i := range size {
+			s1[i] = i % 7
+			s2[i] = i % 5
+		}
+		for _, hint := range []SetHint{HintAuto, HintScan, HintHash} {
+			assert.Equal(Difference(s1, s2), DifferenceHint(s1, s2, hint))
+			assert.Equal(Difference(s1, s2), DifferenceIntoHint(nil, s1, s2, hint))
+			assert.Equal(Intersection(s1, s2), IntersectionHint([][]int{s1, s2}, hint))
+			assert.Equal(ContainsSubSlice(s1, s2), ContainsSubSliceHint(s1, s2, hint))
+			assert.Equal(ContainsSubSlice(s1, s1[:5]), ContainsSubSliceHint(s1, s1[:5], hint))
+		}
+	}
+
+	assert.Equal(true, useScan(HintAuto, true))
+	assert.Equal(false, useScan(HintHash, true))
+	assert.Equal(true, useScan(HintScan, false))
+
+	// The functions without hints keep their signatures, so they can be passed as values.
+	var difference func(s1, s2 []int) []int = Difference[int]
+	assert.Equal([]int{1}, difference([]int{1, 2}, []int{2}))
+}
+
+func BenchmarkDifferenceSmall(b *testing.B) {
+	s1 := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	s2 := []int{2, 4, 6, 8}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = Difference(s1, s2)
+	}
+}
+
+// BenchmarkDifferenceCrossover compares the scan and hash algorithms of Difference around
+// SmallSliceThreshold, where the scans stop being faster.
+func BenchmarkDifferenceCrossover(b *testing.B) {
+	for _, size := range []int{8, 16, 32, 48, 64, 128} {
+		s1 := make([]int, size)
+		s2 := make([]int, size)
+		for i := range size {
+			s1[i] = i
+			s2[i] = 2 * i
+		}
+		for _, hint := range []struct {
+			name string
+			hint SetHint
+		}{{"scan", HintScan}, {"hash", HintHash}} {
+			b.Run(fmt.Sprintf("%s/%d", hint.name, size), func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					_ = DifferenceHint(s1, s2, hint.hint)
+				}
+			})
+		}
+	}
+}
+
 func TestDifferenceWith(t *testing.T) {
 	t.Parallel()
 
@@ -1016,6 +1741,33 @@ func TestSort(t *testing.T) {
 	assert.Equal([]string{"e", "d", "c", "b", "a"}, strings)
 }
 
+func TestSortOrdered(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortOrdered")
+
+	numbers := []int{1, 4, 3, 2, 5}
+
+	SortOrdered(numbers, Asc)
+	assert.Equal([]int{1, 2, 3, 4, 5}, numbers)
+
+	SortOrdered(numbers, Desc)
+	assert.Equal([]int{5, 4, 3, 2, 1}, numbers)
+
+	floats := []float64{2.5, -1, 0}
+	SortOrdered(floats, Desc)
+	assert.Equal([]float64{2.5, 0, -1}, floats)
+
+	// Already sorted and all-equal inputs were the worst cases of the previous quicksort.
+	sorted := RangeOf(0, 100000, 1)
+	SortOrdered(sorted, Desc)
+	assert.Equal(true, IsDescending(sorted))
+
+	same := Repeat(7, 100000)
+	SortOrdered(same, Asc)
+	assert.Equal(100000, len(same))
+}
+
 func TestSortBy(t *testing.T) {
 	t.Parallel()
 
@@ -1047,6 +1799,167 @@ func TestSortBy(t *testing.T) {
 	assert.EqualValues(100, users[2].Age)
 }
 
+func TestSortStableBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortStableBy")
+
+	type employee struct {
+		Name string
+		Dept string
+		Age  int
+	}
+
+	employees := []employee{
+		{"a", "sales", 30},
+		{"b", "dev", 25},
+		{"c", "sales", 25},
+		{"d", "dev", 30},
+		{"e", "ops", 25},
+	}
+
+	// sort by the secondary key first, then by the primary key
+	SortStableBy(employees, func(a, b employee) bool { return a.Age < b.Age })
+	SortStableBy(employees, func(a, b employee) bool { return a.Dept < b.Dept })
+
+	assert.Equal([]employee{
+		{"b", "dev", 25},
+		{"d", "dev", 30},
+		{"e", "ops", 25},
+		{"c", "sales", 25},
+		{"a", "sales", 30},
+	}, employees)
+
+	empty := []int{}
+	SortStableBy(empty, func(a, b int) bool { return a < b })
+	assert.Equal([]int{}, empty)
+}
+
+func TestSortStableByKey(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortStableByKey")
+
+	words := []string{"ccc", "a", "bb", "b", "aaa", "c"}
+	SortStableByKey(words, func(s string) int { return len(s) })
+
+	assert.Equal([]string{"a", "b", "c", "bb", "ccc", "aaa"}, words)
+}
+
+func TestSortByKey(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortByKey")
+
+	type student struct {
+		name     string
+		age      int
+		enrolled time.Time
+	}
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	students := []student{
+		{"a", 10, base.Add(2 * time.Hour)},
+		{"b", 15, base},
+		{"c", 5, base.Add(3 * time.Hour)},
+		{"d", 6, base.Add(time.Hour)},
+	}
+
+	byAge := func(s student) int { return s.age }
+
+	SortByKey(students, byAge, Asc)
+	assert.Equal([]string{"c", "d", "a", "b"}, Map(students, func(_ int, s student) string { return s.name }))
+
+	SortByKey(students, byAge, Desc)
+	assert.Equal([]string{"b", "a", "d", "c"}, Map(students, func(_ int, s student) string { return s.name }))
+
+	SortByKey(students, func(s student) int64 { return s.enrolled.UnixNano() }, Asc)
+	assert.Equal([]string{"b", "d", "a", "c"}, Map(students, func(_ int, s student) string { return s.name }))
+
+	SortByKey(students, func(s student) string { return s.name }, Desc)
+	assert.Equal([]string{"d", "c", "b", "a"}, Map(students, func(_ int, s student) string { return s.name }))
+}
+
+func TestOrderBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestOrderBy")
+
+	type employee struct {
+		Name   string
+		Dept   string
+		Salary int
+	}
+
+	employees := []employee{
+		{"a", "sales", 100},
+		{"b", "dev", 200},
+		{"c", "sales", 300},
+		{"d", "dev", 200},
+		{"e", "dev", 150},
+	}
+
+	t.Run("mixed directions", func(t *testing.T) {
+		result := append([]employee(nil), employees...)
+		OrderBy(func(e employee) string { return e.Dept }).
+			ThenBy(CompareBy(func(e employee) int { return e.Salary })).Desc().
+			Sort(result)
+
+		assert.Equal([]employee{
+			{"b", "dev", 200},
+			{"d", "dev", 200},
+			{"e", "dev", 150},
+			{"c", "sales", 300},
+			{"a", "sales", 100},
+		}, result)
+	})
+
+	t.Run("descending first key", func(t *testing.T) {
+		result := append([]employee(nil), employees...)
+		OrderBy(func(e employee) string { return e.Dept }).Desc().
+			ThenBy(CompareBy(func(e employee) string { return e.Name })).Desc().
+			Sort(result)
+
+		assert.Equal([]string{"c", "a", "e", "d", "b"}, Map(result, func(_ int, e employee) string { return e.Name }))
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		ordering := OrderByFunc(func(a, b employee) int { return a.Salary - b.Salary }).Desc().Asc()
+
+		assert.Equal(true, ordering.Compare(employees[0], employees[1]) < 0)
+		assert.Equal(0, ordering.Compare(employees[1], employees[3]))
+		assert.Equal(true, ordering.Compare(employees[2], employees[4]) > 0)
+	})
+
+	t.Run("icompare comparators", func(t *testing.T) {
+		result := append([]employee(nil), employees...)
+		bySalary := icompare.ByKey(func(e employee) int { return e.Salary })
+		byName := icompare.ByKey(func(e employee) string { return e.Name })
+
+		OrderByFunc(icompare.Reversed(bySalary)).ThenBy(byName).Sort(result)
+
+		assert.Equal([]string{"c", "b", "d", "e", "a"}, Map(result, func(_ int, e employee) string { return e.Name }))
+		assert.Equal(true, EqualWith(result, result, icompare.EqualBy(bySalary)))
+	})
+
+	t.Run("shared prefix", func(t *testing.T) {
+		byDept := OrderBy(func(e employee) string { return e.Dept })
+		bySalary := byDept.ThenBy(CompareBy(func(e employee) int { return e.Salary }))
+		byName := byDept.ThenBy(CompareBy(func(e employee) string { return e.Name })).Desc()
+		bySalaryDesc := bySalary.Desc()
+
+		names := func(ordering *Ordering[employee]) []string {
+			result := append([]employee(nil), employees...)
+			ordering.Sort(result)
+			return Map(result, func(_ int, e employee) string { return e.Name })
+		}
+		assert.Equal([]string{"b", "d", "e", "a", "c"}, names(byDept))
+		assert.Equal([]string{"e", "b", "d", "a", "c"}, names(bySalary))
+		assert.Equal([]string{"e", "d", "b", "c", "a"}, names(byName))
+		assert.Equal([]string{"b", "d", "e", "c", "a"}, names(bySalaryDesc))
+	})
+}
+
 func TestSortByFielDesc(t *testing.T) {
 	t.Parallel()
 
@@ -1103,6 +2016,136 @@ func TestSortByFieldAsc(t *testing.T) {
 	assert.Equal(studentsOfSortByAge, students)
 }
 
+func TestTranspose(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTranspose")
+
+	result, err := Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+	assert.IsNil(err)
+	assert.Equal([][]int{{1, 4}, {2, 5}, {3, 6}}, result)
+
+	result, err = Transpose([][]int{})
+	assert.IsNil(err)
+	assert.Equal([][]int{}, result)
+
+	result, err = Transpose([][]int{{1, 2}, {3}})
+	assert.Equal("ragged rows: row 1 has length 1, row 0 has length 2", err.Error())
+	assert.Equal([][]int(nil), result)
+}
+
+func TestTransposePadded(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTransposePadded")
+
+	result := TransposePadded([][]string{{"a", "b"}, {"c"}, {"d", "e", "f"}}, "-")
+	assert.Equal([][]string{{"a", "c", "d"}, {"b", "-", "e"}, {"-", "-", "f"}}, result)
+
+	assert.Equal([][]string{}, TransposePadded([][]string{{}, {}}, "-"))
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestDiff")
+
+	oldSlice := []string{"a", "b", "c", "d"}
+	newSlice := []string{"a", "c", "e", "d", "f"}
+
+	edits := Diff(oldSlice, newSlice)
+	assert.Equal([]Edit[string]{
+		{EditKeep, 0, 0, "a"},
+		{EditDelete, 1, 1, "b"},
+		{EditKeep, 2, 1, "c"},
+		{EditInsert, 3, 2, "e"},
+		{EditKeep, 3, 3, "d"},
+		{EditInsert, 4, 4, "f"},
+	}, edits)
+
+	assert.Equal([]Edit[int]{}, Diff([]int{}, []int{}))
+	assert.Equal([]Edit[int]{{EditDelete, 0, 0, 1}, {EditInsert, 1, 0, 2}}, Diff([]int{1}, []int{2}))
+
+	pairs := [][2][]int{
+		{{1, 2, 3}, {3, 2, 1}},
+		{{}, {1, 2}},
+		{{1, 2}, {}},
+		{{1, 1, 2, 2}, {2, 1, 2, 1}},
+	}
+	for _, pair := range pairs {
+		edits := Diff(pair[0], pair[1])
+		kept := CountIf(edits, func(_ int, e Edit[int]) bool { return e.Op == EditKeep })
+		assert.Equal(lcsTable(pair[0], pair[1])[0][0], kept)
+
+		result, err := ApplyPatch(pair[0], edits)
+		assert.IsNil(err)
+		assert.Equal(pair[1], result)
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestApplyPatch")
+
+	edits := Diff([]int{1, 2, 3}, []int{1, 3, 4})
+
+	result, err := ApplyPatch([]int{1, 2, 3}, edits)
+	assert.IsNil(err)
+	assert.Equal([]int{1, 3, 4}, result)
+
+	_, err = ApplyPatch([]int{1, 5, 3}, edits)
+	assert.Equal("edit 1: delete of 2 does not match element 5 at index 1", err.Error())
+
+	_, err = ApplyPatch([]int{1, 2}, edits)
+	assert.Equal("edit 2: keep past the end of the slice", err.Error())
+
+	_, err = ApplyPatch([]int{1, 2, 3, 4}, edits)
+	assert.Equal("patch consumed 3 of 4 elements", err.Error())
+
+	_, err = ApplyPatch([]int{1}, []Edit[int]{{Op: EditOp(7)}})
+	assert.Equal("edit 0: unknown operation EditOp(7)", err.Error())
+}
+
+func TestLongestCommonPrefix(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestLongestCommonPrefix")
+
+	assert.Equal([]string{"usr", "local"}, LongestCommonPrefix(
+		[]string{"usr", "local", "bin"},
+		[]string{"usr", "local", "lib", "go"},
+		[]string{"usr", "local"},
+	))
+	assert.Equal([]int{}, LongestCommonPrefix([]int{1, 2}, []int{2, 1}))
+	assert.Equal([]int{1, 2}, LongestCommonPrefix([]int{1, 2}))
+	assert.Equal([]int{}, LongestCommonPrefix([]int{1, 2}, []int{}))
+	assert.Equal([]int{}, LongestCommonPrefix[int]())
+}
+
+func TestLongestCommonSuffix(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestLongestCommonSuffix")
+
+	assert.Equal([]int{3, 4}, LongestCommonSuffix([]int{1, 2, 3, 4}, []int{9, 3, 4}, []int{0, 0, 0, 3, 4}))
+	assert.Equal([]int{}, LongestCommonSuffix([]int{1, 2}, []int{2, 1}))
+	assert.Equal([]int{1, 2}, LongestCommonSuffix([]int{1, 2}, []int{1, 2}))
+	assert.Equal([]int{}, LongestCommonSuffix([]int{}, []int{1}))
+	assert.Equal([]int{}, LongestCommonSuffix[int]())
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestLongestCommonSubsequence")
+
+	assert.Equal([]rune("BDAB"), LongestCommonSubsequence([]rune("ABCBDAB"), []rune("BDCABA")))
+	assert.Equal([]int{1, 3}, LongestCommonSubsequence([]int{1, 2, 3}, []int{1, 3, 4}))
+	assert.Equal([]int{}, LongestCommonSubsequence([]int{1, 2}, []int{3, 4}))
+	assert.Equal([]int{}, LongestCommonSubsequence([]int{}, []int{3, 4}))
+}
+
 func TestWithout(t *testing.T) {
 	t.Parallel()
 
@@ -1134,47 +2177,105 @@ func TestShuffleCopy(t *testing.T) {
 	assert.Equal([]int{1, 2, 3, 4, 5}, numbers)
 }
 
+func TestShuffleSecure(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestShuffleSecure")
+
+	numbers := []int{1, 2, 3, 4, 5}
+	result := ShuffleSecure(numbers)
+
+	assert.ShouldBeTrue(IsPermutation(numbers, result))
+	assert.Equal([]int{1, 2, 3, 4, 5}, numbers)
+	assert.Equal([]int{}, ShuffleSecure([]int{}))
+
+	// every position must be reachable by every element
+	seen := make(map[[2]int]bool)
+	for i := 0; i < 500; i++ {
+		for pos, n := range ShuffleSecure([]int{0, 1, 2}) {
+			seen[[2]int{pos, n}] = true
+		}
+	}
+	assert.Equal(9, len(seen))
+}
+
+func TestRandomSecure(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRandomSecure")
+
+	_, idx := RandomSecure([]int{})
+	assert.Equal(-1, idx)
+
+	val, idx := RandomSecure([]int{1})
+	assert.Equal(0, idx)
+	assert.Equal(1, val)
+
+	arr := []int{1, 2, 3}
+	counts := make([]int, len(arr))
+	for i := 0; i < 300; i++ {
+		val, idx = RandomSecure(arr)
+		assert.Equal(arr[idx], val)
+		counts[idx]++
+	}
+	for _, c := range counts {
+		assert.Less(0, c)
+	}
+}
+
 func TestIndexOf(t *testing.T) {
 	t.Parallel()
 
 	assert := internal.NewAssert(t, "TestIndexOf")
 
 	arr := []string{"a", "a", "b", "c"}
-	key := fmt.Sprintf("%p", arr)
 	assert.Equal(0, IndexOf(arr, "a"))
 	assert.Equal(-1, IndexOf(arr, "d"))
-	assert.Equal(2, memoryHashCounter[key])
 
 	arr1 := []int{1, 2, 3, 4, 5}
-	key1 := fmt.Sprintf("%p", arr1)
 	assert.Equal(3, IndexOf(arr1, 4))
 	assert.Equal(-1, IndexOf(arr1, 6))
-	assert.Equal(2, memoryHashCounter[key1])
+
+	// results must follow mutations of the slice
+	arr1[3] = 6
+	assert.Equal(3, IndexOf(arr1, 6))
+	assert.Equal(-1, IndexOf(arr1, 4))
 
 	arr2 := []float64{1.1, 2.2, 3.3, 4.4, 5.5}
-	key2 := fmt.Sprintf("%p", arr2)
 	assert.Equal(2, IndexOf(arr2, 3.3))
 	assert.Equal(3, IndexOf(arr2, 4.4))
 	assert.Equal(-1, IndexOf(arr2, 6.6))
-	assert.Equal(3, memoryHashCounter[key2])
 
-	for i := 0; i < 6; i++ {
-		a := []string{"a", "b", "c"}
-		IndexOf(a, "a")
-		IndexOf(a, "b")
-	}
-	minArr := []string{"c", "b", "a"}
-	minKey := fmt.Sprintf("%p", minArr)
-	assert.Equal(0, IndexOf(minArr, "c"))
+	assert.Equal(-1, IndexOf([]int{}, 1))
+}
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIndex")
+
+	arr := []string{"a", "a", "b", "c"}
+	idx := NewIndex(arr)
+	assert.Equal(0, idx.Lookup("a"))
+	assert.Equal(2, idx.Lookup("b"))
+	assert.Equal(-1, idx.Lookup("d"))
+
+	arr[0] = "d"
+	assert.Equal(-1, idx.Lookup("d"))
 
-	arr3 := []string{"q", "w", "e"}
-	key3 := fmt.Sprintf("%p", arr3)
-	assert.Equal(1, IndexOf(arr3, "w"))
-	assert.Equal(-1, IndexOf(arr3, "r"))
-	assert.Equal(2, memoryHashCounter[key3])
-	assert.Equal(0, memoryHashCounter[minKey])
+	idx.Invalidate()
+	assert.Equal(0, idx.Lookup("d"))
+	assert.Equal(1, idx.Lookup("a"))
+
+	arr[3] = "e"
+	idx.Rebuild()
+	assert.Equal(3, idx.Lookup("e"))
+	assert.Equal(-1, idx.Lookup("c"))
+
+	assert.Equal(-1, NewIndex([]int{}).Lookup(1))
 
 	arr4 := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	idx4 := NewIndex(arr4)
 
 	const numGoroutines = 100
 	var wg sync.WaitGroup
@@ -1183,7 +2284,10 @@ func TestIndexOf(t *testing.T) {
 	for i := 0; i < numGoroutines; i++ {
 		go func(i int) {
 			defer wg.Done()
-			index := IndexOf(arr4, i%10+1)
+			if i%7 == 0 {
+				idx4.Invalidate()
+			}
+			index := idx4.Lookup(i%10 + 1)
 			assert.Equal(i%10, index)
 		}(i)
 	}
@@ -1217,6 +2321,61 @@ func TestLastIndexOf(t *testing.T) {
 	assert.Equal(-1, LastIndexOf(arr, "d"))
 }
 
+func TestIndicesOf(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIndicesOf")
+
+	assert.Equal([]int{0, 1, 4}, IndicesOf([]string{"a", "a", "b", "c", "a"}, "a"))
+	assert.Equal([]int{}, IndicesOf([]string{"a", "b"}, "d"))
+	assert.Equal([]int{}, IndicesOf([]int{}, 1))
+}
+
+func TestIndicesBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIndicesBy")
+
+	isEven := func(_ int, n int) bool { return n%2 == 0 }
+
+	assert.Equal([]int{1, 3, 4}, IndicesBy([]int{1, 2, 3, 4, 6}, isEven))
+	assert.Equal([]int{}, IndicesBy([]int{1, 3}, isEven))
+}
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestZip")
+
+	pairs := Zip([]string{"a", "b", "c"}, []int{1, 2})
+	assert.Equal([]ituples.Pair[string, int]{
+		ituples.NewPair("a", 1),
+		ituples.NewPair("b", 2),
+	}, pairs)
+
+	triples := Zip3([]string{"a", "b"}, []int{1, 2, 3}, []bool{true, false})
+	assert.Equal([]ituples.Triple[string, int, bool]{
+		ituples.NewTriple("a", 1, true),
+		ituples.NewTriple("b", 2, false),
+	}, triples)
+
+	assert.Equal([]ituples.Pair[int, int]{}, Zip([]int{}, []int{1}))
+}
+
+func TestUnzip(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestUnzip")
+
+	letters, numbers := Unzip(Zip([]string{"a", "b"}, []int{1, 2}))
+	assert.Equal([]string{"a", "b"}, letters)
+	assert.Equal([]int{1, 2}, numbers)
+
+	letters, numbers = Unzip([]ituples.Pair[string, int]{})
+	assert.Equal([]string{}, letters)
+	assert.Equal([]int{}, numbers)
+}
+
 func TestToSlice(t *testing.T) {
 	t.Parallel()
 
@@ -1292,6 +2451,36 @@ func TestKeyBy(t *testing.T) {
 	assert.Equal(map[int]string{1: "a", 2: "ab", 3: "abc"}, result)
 }
 
+func TestAssociate(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestAssociate")
+
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {2, "b"}, {1, "c"}}
+	toPair := func(u user) (int, string) { return u.id, u.name }
+
+	assert.Equal(map[int]string{1: "c", 2: "b"}, Associate(users, toPair))
+	assert.Equal(map[int]string{1: "a", 2: "b"}, AssociateKeepFirst(users, toPair))
+	assert.Equal(map[int]string{1: "a+c", 2: "b"}, AssociateMerge(users, toPair, func(existing, incoming string) string {
+		return existing + "+" + incoming
+	}))
+
+	result, err := AssociateStrict(users, toPair)
+	assert.Equal(map[int]string(nil), result)
+	assert.ShouldBeTrue(errors.Is(err, ErrDuplicateKey))
+	assert.Equal("key 1 at index 2: duplicate key", err.Error())
+
+	result, err = AssociateStrict(users[:2], toPair)
+	assert.IsNil(err)
+	assert.Equal(map[int]string{1: "a", 2: "b"}, result)
+
+	assert.Equal(map[int]string{}, Associate([]user{}, toPair))
+}
+
 func TestRepeat(t *testing.T) {
 	t.Parallel()
 
@@ -1301,15 +2490,186 @@ func TestRepeat(t *testing.T) {
 	assert.Equal([]string{"a", "a", "a", "a", "a", "a"}, Repeat("a", 6))
 }
 
-func TestJoin(t *testing.T) {
+func TestPage(t *testing.T) {
 	t.Parallel()
 
-	assert := internal.NewAssert(t, "TestJoin")
+	assert := internal.NewAssert(t, "TestPage")
 
-	nums := []int{1, 2, 3, 4, 5}
+	nums := []int{1, 2, 3, 4, 5, 6, 7}
 
-	result1 := Join(nums, ",")
-	result2 := Join(nums, "-")
+	tests := []struct {
+		pageNum  int
+		pageSize int
+		want     []int
+	}{
+		{1, 3, []int{1, 2, 3}},
+		{2, 3, []int{4, 5, 6}},
+		{3, 3, []int{7}},
+		{4, 3, []int{}},
+		{0, 3, []int{1, 2, 3}},
+		{-5, 3, []int{1, 2, 3}},
+		{1, 10, []int{1, 2, 3, 4, 5, 6, 7}},
+		{1, 0, []int{}},
+		{1, -1, []int{}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.want, Page(nums, tt.pageNum, tt.pageSize))
+	}
+
+	assert.Equal([]int{}, Page([]int{}, 1, 3))
+}
+
+func TestPageCount(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPageCount")
+
+	assert.Equal(3, PageCount(7, 3))
+	assert.Equal(2, PageCount(6, 3))
+	assert.Equal(1, PageCount(1, 3))
+	assert.Equal(0, PageCount(0, 3))
+	assert.Equal(0, PageCount(7, 0))
+	assert.Equal(0, PageCount(-1, 3))
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPaginate")
+
+	assert.Equal([][]int{{1, 2}, {3, 4}, {5}}, Paginate([]int{1, 2, 3, 4, 5}, 2))
+	assert.Equal([][]int{}, Paginate([]int{}, 2))
+	assert.Equal([][]int{}, Paginate([]int{1}, 0))
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestGenerate")
+
+	assert.Equal([]int{0, 1, 4, 9}, Generate(4, func(i int) int { return i * i }))
+	assert.Equal([]int{}, Generate(0, func(i int) int { return i }))
+	assert.Equal([]int{}, Generate(-2, func(i int) int { return i }))
+}
+
+func TestUnfold(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestUnfold")
+
+	fibonacci := Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 50
+	})
+	assert.Equal([]int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, fibonacci)
+
+	backoff := Unfold(100*time.Millisecond, func(d time.Duration) (time.Duration, time.Duration, bool) {
+		return d, d * 2, d <= time.Second
+	})
+	assert.Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond}, backoff)
+
+	assert.Equal([]int{}, Unfold(0, func(s int) (int, int, bool) { return s, s, false }))
+}
+
+func TestRangeOf(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRangeOf")
+
+	assert.Equal([]int{0, 1, 2, 3, 4}, RangeOf(0, 5, 1))
+	assert.Equal([]int{0, 3, 6, 9}, RangeOf(0, 10, 3))
+	assert.Equal([]int{5, 3, 1}, RangeOf(5, 0, -2))
+	assert.Equal([]int{}, RangeOf(0, 5, -1))
+	assert.Equal([]int{}, RangeOf(5, 0, 1))
+	assert.Equal([]int{}, RangeOf(0, 5, 0))
+	assert.Equal([]int{}, RangeOf(3, 3, 1))
+
+	assert.Equal([]uint8{250, 252, 254}, RangeOf[uint8](250, 255, 2))
+	assert.Equal([]int8{120, 125}, RangeOf[int8](120, 127, 5))
+	assert.Equal([]int8{-120, -125}, RangeOf[int8](-120, -128, -5))
+
+	floats := RangeOf(0, 1, 0.1)
+	assert.Equal(10, len(floats))
+	assert.Equal(0.30000000000000004, floats[3])
+	assert.Equal(0.9, floats[9])
+	assert.Equal([]float64{1, 0.5}, RangeOf(1, 0, -0.5))
+	assert.Equal([]float64{}, RangeOf(0, 1, math.NaN()))
+	assert.Equal([]float64{}, RangeOf(math.NaN(), 1, 0.5))
+	assert.Equal([]float64{}, RangeOf(0, math.Inf(1), 1))
+	assert.Equal([]float64{}, RangeOf(math.Inf(-1), 0, 1))
+	assert.Equal([]float64{}, RangeOf(0, 1, math.Inf(1)))
+	assert.Equal([]float64{}, RangeOf(1e17, 1e17+100, 1))
+	assert.Equal([]float32{0, 0.5}, RangeOf[float32](0, 1, 0.5))
+}
+
+func TestLinspace(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestLinspace")
+
+	assert.Equal([]float64{0, 0.25, 0.5, 0.75, 1}, Linspace(0, 1, 5))
+	assert.Equal([]float64{1, 0}, Linspace(1, 0, 2))
+	assert.Equal([]float64{2}, Linspace(2, 3, 1))
+	assert.Equal([]float64{}, Linspace(2, 3, 0))
+
+	points := Linspace(0, 0.3, 4)
+	assert.Equal(0.3, points[3])
+}
+
+func TestRepeatBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRepeatBy")
+
+	maps := RepeatBy(3, func(_ int) map[string]int { return map[string]int{} })
+	maps[0]["a"] = 1
+
+	assert.Equal(3, len(maps))
+	assert.Equal(0, len(maps[1]))
+	assert.Equal(0, len(maps[2]))
+
+	assert.Equal([]string{"item-0", "item-1"}, RepeatBy(2, func(i int) string { return "item-" + strconv.Itoa(i) }))
+	assert.Equal([]int{}, RepeatBy(0, func(i int) int { return i }))
+}
+
+func TestPackBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPackBy")
+
+	messages := []string{"aaa", "bb", "c", "dddd", "ee", "ffffffff", "g"}
+	size := func(s string) int { return len(s) }
+
+	assert.Equal([][]string{{"aaa", "bb"}, {"c", "dddd"}, {"ee"}, {"ffffffff"}, {"g"}}, PackBy(messages, 5, size))
+	assert.Equal([][]string{{"aaa", "bb", "c", "dddd", "ee", "ffffffff", "g"}}, PackBy(messages, 100, size))
+	assert.Equal([][]string{}, PackBy([]string{}, 5, size))
+}
+
+func TestPackByFirstFitDecreasing(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPackByFirstFitDecreasing")
+
+	weights := []int{2, 5, 4, 7, 1, 3, 8}
+	identity := func(n int) int { return n }
+
+	result := PackByFirstFitDecreasing(weights, 10, identity)
+	assert.Equal([][]int{{8, 2}, {7, 3}, {5, 4, 1}}, result)
+	assert.Equal(5, len(PackBy(weights, 10, identity)))
+
+	assert.Equal([][]int{{12}, {3}}, PackByFirstFitDecreasing([]int{3, 12}, 10, identity))
+	assert.Equal([][]int{}, PackByFirstFitDecreasing([]int{}, 10, identity))
+}
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestJoin")
+
+	nums := []int{1, 2, 3, 4, 5}
+
+	result1 := Join(nums, ",")
+	result2 := Join(nums, "-")
 
 	assert.Equal("1,2,3,4,5", result1)
 	assert.Equal("1-2-3-4-5", result2)
@@ -1325,6 +2685,44 @@ func TestPartition(t *testing.T) {
 	assert.Equal([][]int{{1, 2}, {3, 4}, {5}}, Partition([]int{1, 2, 3, 4, 5}, func(n int) bool { return n == 1 || n == 2 }, func(n int) bool { return n == 2 || n == 3 || n == 4 }))
 }
 
+func TestPartitionInPlace(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPartitionInPlace")
+
+	type item struct {
+		id  int
+		odd bool
+	}
+	isOdd := func(it item) bool { return it.odd }
+
+	items := []item{{0, false}, {1, true}, {2, false}, {3, true}, {4, false}, {5, true}, {6, true}}
+	split := PartitionInPlace(items, isOdd)
+
+	assert.Equal(4, split)
+	assert.Equal([]int{1, 3, 5, 6, 0, 2, 4}, Map(items, func(_ int, it item) int { return it.id }))
+
+	nums := []int{}
+	assert.Equal(0, PartitionInPlace(nums, func(n int) bool { return true }))
+
+	nums = []int{2, 4}
+	assert.Equal(0, PartitionInPlace(nums, func(n int) bool { return n%2 == 1 }))
+	assert.Equal([]int{2, 4}, nums)
+
+	calls := 0
+	nums = RangeOf(0, 100, 1)
+	split = PartitionInPlace(nums, func(n int) bool {
+		calls++
+		return n%3 == 0
+	})
+	assert.Equal(100, calls)
+	assert.Equal(34, split)
+	assert.ShouldBeTrue(IsAscending(nums[:split]))
+	assert.ShouldBeTrue(IsAscending(nums[split:]))
+	assert.ShouldBeTrue(Every(nums[:split], func(_ int, n int) bool { return n%3 == 0 }))
+	assert.ShouldBeTrue(None(nums[split:], func(_ int, n int) bool { return n%3 == 0 }))
+}
+
 func TestRandom(t *testing.T) {
 	t.Parallel()
 	assert := internal.NewAssert(t, "TestRandom")
@@ -1488,6 +2886,41 @@ func TestSetToDefaultIf(t *testing.T) {
 	})
 }
 
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSplit")
+
+	tests := []struct {
+		slice []int
+		want  [][]int
+	}{
+		{[]int{1, 2, 0, 3, 0, 4, 5}, [][]int{{1, 2}, {3}, {4, 5}}},
+		{[]int{0, 1, 0, 0, 2, 0}, [][]int{{}, {1}, {}, {2}, {}}},
+		{[]int{1, 2}, [][]int{{1, 2}}},
+		{[]int{}, [][]int{{}}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.want, Split(tt.slice, 0))
+	}
+
+	parts := Split([]int{1, 0, 2}, 0)
+	parts[0] = append(parts[0], 9)
+	assert.Equal([]int{2}, parts[1])
+}
+
+func TestSplitWhen(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSplitWhen")
+
+	tokens := []string{"a", "b", ";", "c", ",", "d"}
+	isPunct := func(s string) bool { return s == ";" || s == "," }
+
+	assert.Equal([][]string{{"a", "b"}, {"c"}, {"d"}}, SplitWhen(tokens, isPunct))
+}
+
 func TestBreak(t *testing.T) {
 	t.Parallel()
 
@@ -1578,6 +3011,189 @@ func TestMapConcurrent(t *testing.T) {
 
 }
 
+func TestMapConcurrentCtx(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMapConcurrentCtx")
+
+	square := func(_ context.Context, n int) (int, error) { return n * n, nil }
+
+	t.Run("empty slice", func(t *testing.T) {
+		actual, err := MapConcurrentCtx(context.Background(), []int{}, 4, square)
+		assert.IsNil(err)
+		assert.Equal([]int{}, actual)
+	})
+
+	t.Run("order preserved", func(t *testing.T) {
+		nums := []int{1, 2, 3, 4, 5, 6}
+		actual, err := MapConcurrentCtx(context.Background(), nums, 0, square)
+		assert.IsNil(err)
+		assert.Equal([]int{1, 4, 9, 16, 25, 36}, actual)
+
+		actual, err = MapConcurrentCtx(context.Background(), nums, 3, func(_ context.Context, n int) (int, error) {
+			time.Sleep(time.Duration(7-n) * time.Millisecond)
+			return n * n, nil
+		})
+		assert.IsNil(err)
+		assert.Equal([]int{1, 4, 9, 16, 25, 36}, actual)
+	})
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		var mu sync.Mutex
+		inFlight, peak := 0, 0
+
+		_, err := MapConcurrentCtx(context.Background(), make([]int, 20), 3, func(_ context.Context, n int) (int, error) {
+			mu.Lock()
+			inFlight++
+			peak = max(peak, inFlight)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return n, nil
+		})
+		assert.IsNil(err)
+		assert.GreaterOrEqual(3, peak)
+	})
+
+	t.Run("first error cancels the rest", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		var mu sync.Mutex
+		calls := 0
+
+		actual, err := MapConcurrentCtx(context.Background(), make([]int, 100), 2, func(ctx context.Context, n int) (int, error) {
+			mu.Lock()
+			calls++
+			c := calls
+			mu.Unlock()
+			if c == 3 {
+				return 0, errBoom
+			}
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(time.Millisecond):
+				return n, nil
+			}
+		})
+		assert.Equal(errBoom, err)
+		assert.Equal([]int(nil), actual)
+		assert.Greater(100, calls)
+	})
+
+	t.Run("parent context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		actual, err := MapConcurrentCtx(ctx, []int{1, 2, 3}, 2, square)
+		assert.Equal(context.Canceled, err)
+		assert.Equal([]int(nil), actual)
+	})
+}
+
+func TestForEachParallel(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestForEachParallel")
+
+	t.Run("every element visited", func(t *testing.T) {
+		var sum atomic.Int64
+		var mu sync.Mutex
+		inFlight, peak := 0, 0
+
+		err := ForEachParallel(context.Background(), []int{1, 2, 3, 4, 5, 6, 7, 8}, 3, func(_ context.Context, i int, n int) error {
+			mu.Lock()
+			inFlight++
+			peak = max(peak, inFlight)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+			sum.Add(int64(i * n))
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		})
+		assert.IsNil(err)
+		assert.Equal(int64(0*1+1*2+2*3+3*4+4*5+5*6+6*7+7*8), sum.Load())
+		assert.GreaterOrEqual(3, peak)
+
+		assert.IsNil(ForEachParallel(context.Background(), []int{}, 0, func(context.Context, int, int) error {
+			return errors.New("unexpected call")
+		}))
+	})
+
+	t.Run("first error cancels the rest", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		var calls atomic.Int64
+
+		err := ForEachParallel(context.Background(), make([]int, 100), 1, func(ctx context.Context, i int, _ int) error {
+			calls.Add(1)
+			if i == 2 {
+				return errBoom
+			}
+			return nil
+		})
+		assert.Equal(errBoom, err)
+		assert.Equal(int64(3), calls.Load())
+	})
+
+	t.Run("all errors joined", func(t *testing.T) {
+		errs := []error{errors.New("e1"), errors.New("e3")}
+		err := ForEachParallel(context.Background(), []int{0, 1, 2, 3}, 4, func(_ context.Context, i int, _ int) error {
+			switch i {
+			case 1:
+				time.Sleep(2 * time.Millisecond)
+				return errs[0]
+			case 3:
+				return errs[1]
+			}
+			return nil
+		}, AllErrors)
+		assert.ShouldBeTrue(errors.Is(err, errs[0]))
+		assert.ShouldBeTrue(errors.Is(err, errs[1]))
+		assert.Equal("e1\ne3", err.Error())
+	})
+
+	t.Run("panics recovered", func(t *testing.T) {
+		errInner := errors.New("inner")
+		err := ForEachParallel(context.Background(), []int{0, 1, 2}, 2, func(_ context.Context, i int, _ int) error {
+			switch i {
+			case 0:
+				panic(errInner)
+			case 2:
+				panic("two")
+			}
+			return nil
+		}, AllErrors)
+
+		var panicErr *PanicError
+		assert.ShouldBeTrue(errors.As(err, &panicErr))
+		assert.Equal(0, panicErr.Index)
+		assert.ShouldBeTrue(errors.Is(err, errInner))
+		assert.ShouldBeTrue(len(panicErr.Stack) > 0)
+		assert.Equal("panic at index 0: inner\npanic at index 2: two", err.Error())
+	})
+
+	t.Run("parent context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		called := false
+		iteratee := func(context.Context, int, int) error {
+			called = true
+			return nil
+		}
+		assert.Equal(context.Canceled, ForEachParallel(ctx, []int{1, 2, 3}, 2, iteratee))
+		assert.ShouldBeTrue(errors.Is(ForEachParallel(ctx, []int{1}, 2, iteratee, AllErrors), context.Canceled))
+		assert.ShouldBeFalse(called)
+	})
+}
+
 func TestFilterConcurrent(t *testing.T) {
 	t.Parallel()
 
@@ -1605,118 +3221,671 @@ func TestFilterConcurrent(t *testing.T) {
 	})
 }
 
-func TestFrequency(t *testing.T) {
+func TestProcessInBatches(t *testing.T) {
 	t.Parallel()
 
-	assert := internal.NewAssert(t, "TestFrequency")
+	assert := internal.NewAssert(t, "TestProcessInBatches")
 
-	t.Run("empty slice", func(t *testing.T) {
-		result := Frequency([]int{})
-		assert.Equal(map[int]int{}, result)
-	})
+	nums := []int{1, 2, 3, 4, 5, 6, 7}
 
-	t.Run("int slice", func(t *testing.T) {
-		nums := []int{1, 2, 2, 3, 3, 3}
-		expected := map[int]int{1: 1, 2: 2, 3: 3}
-		result := Frequency(nums)
+	t.Run("all batches", func(t *testing.T) {
+		var batches [][]int
+		err := ProcessInBatches(nums, 3, func(batch []int) error {
+			batches = append(batches, batch)
+			return nil
+		})
+		assert.IsNil(err)
+		assert.Equal([][]int{{1, 2, 3}, {4, 5, 6}, {7}}, batches)
+	})
 
-		assert.Equal(expected, result)
+	t.Run("stops on first error", func(t *testing.T) {
+		calls := 0
+		errBoom := errors.New("boom")
+		err := ProcessInBatches(nums, 2, func(batch []int) error {
+			calls++
+			if batch[0] == 3 {
+				return errBoom
+			}
+			return nil
+		})
+		assert.Equal(errBoom, err)
+		assert.Equal(2, calls)
 	})
 
-	t.Run("string slice", func(t *testing.T) {
-		strs := []string{"a", "b", "b", "c", "c", "c"}
-		expected := map[string]int{"a": 1, "b": 2, "c": 3}
-		result := Frequency(strs)
+	t.Run("empty slice", func(t *testing.T) {
+		calls := 0
+		err := ProcessInBatches([]int{}, 2, func(batch []int) error {
+			calls++
+			return nil
+		})
+		assert.IsNil(err)
+		assert.Equal(0, calls)
+	})
 
-		assert.Equal(expected, result)
+	t.Run("invalid batch size", func(t *testing.T) {
+		err := ProcessInBatches(nums, 0, func(batch []int) error { return nil })
+		assert.IsNotNil(err)
 	})
+}
 
-	t.Run("struct slice", func(t *testing.T) {
-		type student struct {
-			Name string
-			Age  int
-		}
+func TestProcessInBatchesConcurrent(t *testing.T) {
+	t.Parallel()
 
-		students := []student{
-			{Name: "a", Age: 11},
-			{Name: "b", Age: 12},
-			{Name: "a", Age: 13},
-			{Name: "c", Age: 14},
-		}
+	assert := internal.NewAssert(t, "TestProcessInBatchesConcurrent")
 
-		expected := map[student]int{
-			{Name: "a", Age: 11}: 1,
-			{Name: "a", Age: 13}: 1,
-			{Name: "b", Age: 12}: 1,
-			{Name: "c", Age: 14}: 1,
-		}
-		result := Frequency(students)
+	nums := []int{1, 2, 3, 4, 5, 6, 7}
 
-		assert.Equal(expected, result)
+	t.Run("all batches", func(t *testing.T) {
+		var mu sync.Mutex
+		sum := 0
+		err := ProcessInBatchesConcurrent(nums, 2, func(batch []int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, n := range batch {
+				sum += n
+			}
+			return nil
+		}, 3)
+		assert.IsNil(err)
+		assert.Equal(28, sum)
+	})
+
+	t.Run("earliest error", func(t *testing.T) {
+		err := ProcessInBatchesConcurrent(nums, 2, func(batch []int) error {
+			if batch[0] >= 3 {
+				return fmt.Errorf("batch %d", batch[0])
+			}
+			return nil
+		}, 4)
+		assert.Equal("batch 3", err.Error())
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		err := ProcessInBatchesConcurrent(nums, -1, func(batch []int) error { return nil }, 2)
+		assert.IsNotNil(err)
 	})
 }
 
-func TestJoinFunc(t *testing.T) {
+func TestForEachCtx(t *testing.T) {
 	t.Parallel()
 
-	assert := internal.NewAssert(t, "TestJoinFunc")
+	assert := internal.NewAssert(t, "TestForEachCtx")
 
-	t.Run("basic case", func(t *testing.T) {
-		result := JoinFunc([]int{1, 2, 3}, ", ", func(i int) int {
-			return i * 2
-		})
+	nums := []int{1, 2, 3, 4, 5}
 
-		expected := "2, 4, 6"
-		assert.Equal(expected, result)
+	t.Run("visits all", func(t *testing.T) {
+		sum := 0
+		err := ForEachCtx(context.Background(), nums, func(_ int, n int) { sum += n })
+		assert.IsNil(err)
+		assert.Equal(15, sum)
 	})
 
-	t.Run("empty slice", func(t *testing.T) {
-		result := JoinFunc([]int{}, ", ", func(i int) int {
-			return i * 2
-		})
+	t.Run("cancelled midway", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 
-		assert.Equal("", result)
+		visited := []int{}
+		err := ForEachCtx(ctx, nums, func(i int, n int) {
+			visited = append(visited, n)
+			if i == 1 {
+				cancel()
+			}
+		})
+		assert.Equal(context.Canceled, err)
+		assert.Equal([]int{1, 2}, visited)
 	})
+}
 
-	t.Run("single element slice", func(t *testing.T) {
-		result := JoinFunc([]int{1}, ", ", func(i int) int {
-			return i * 2
-		})
+func TestMapCtx(t *testing.T) {
+	t.Parallel()
 
-		assert.Equal("2", result)
-	})
+	assert := internal.NewAssert(t, "TestMapCtx")
 
-	t.Run("string slice", func(t *testing.T) {
-		result := JoinFunc([]string{"a", "b", "c"}, ", ", func(s string) string {
-			return strings.ToUpper(s)
-		})
+	nums := []int{1, 2, 3}
+	double := func(_ int, n int) int { return n * 2 }
 
-		expected := "A, B, C"
-		assert.Equal(expected, result)
-	})
+	result, err := MapCtx(context.Background(), nums, double)
+	assert.IsNil(err)
+	assert.Equal([]int{2, 4, 6}, result)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err = MapCtx(ctx, nums, double)
+	assert.Equal(context.Canceled, err)
+	assert.Equal([]int(nil), result)
 }
 
-func TestConcatBy(t *testing.T) {
+func TestFilterCtx(t *testing.T) {
 	t.Parallel()
 
-	assert := internal.NewAssert(t, "TestConcatBy")
+	assert := internal.NewAssert(t, "TestFilterCtx")
 
-	t.Run("Join strings", func(t *testing.T) {
-		result := ConcatBy([]string{"Hello", "World"}, ", ", func(a, b string) string {
-			return a + b
-		})
+	nums := []int{1, 2, 3, 4}
+	isEven := func(_ int, n int) bool { return n%2 == 0 }
 
-		expected := "Hello, World"
-		assert.Equal(expected, result)
-	})
+	result, err := FilterCtx(context.Background(), nums, isEven)
+	assert.IsNil(err)
+	assert.Equal([]int{2, 4}, result)
 
-	t.Run("Join Person struct", func(t *testing.T) {
-		type Person struct {
-			Name string
-			Age  int
-		}
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
 
-		people := []Person{
+	result, err = FilterCtx(ctx, nums, isEven)
+	assert.Equal(context.DeadlineExceeded, err)
+	assert.Equal([]int(nil), result)
+}
+
+func TestMapErr(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMapErr")
+
+	result, err := MapErr([]string{"1", "2", "3"}, func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	assert.IsNil(err)
+	assert.Equal([]int{1, 2, 3}, result)
+
+	calls := 0
+	result, err = MapErr([]string{"1", "x", "y"}, func(_ int, s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	assert.IsNotNil(err)
+	assert.Equal([]int(nil), result)
+	assert.Equal(2, calls)
+
+	result, err = MapErrAll([]string{"1", "x", "3", "y"}, func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	assert.Equal([]int{1, 0, 3, 0}, result)
+	assert.Equal(2, len(err.(interface{ Unwrap() []error }).Unwrap()))
+
+	result, err = MapErrAll([]string{"1"}, func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	assert.IsNil(err)
+	assert.Equal([]int{1}, result)
+}
+
+func TestFilterErr(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFilterErr")
+
+	errNegative := errors.New("negative")
+	isEven := func(_ int, n int) (bool, error) {
+		if n < 0 {
+			return false, errNegative
+		}
+		return n%2 == 0, nil
+	}
+
+	result, err := FilterErr([]int{1, 2, 3, 4}, isEven)
+	assert.IsNil(err)
+	assert.Equal([]int{2, 4}, result)
+
+	result, err = FilterErr([]int{1, 2, -3, 4}, isEven)
+	assert.Equal(errNegative, err)
+	assert.Equal([]int(nil), result)
+
+	result, err = FilterErrAll([]int{1, 2, -3, 4, -5}, isEven)
+	assert.ShouldBeTrue(errors.Is(err, errNegative))
+	assert.Equal("negative\nnegative", err.Error())
+	assert.Equal([]int{2, 4}, result)
+}
+
+func TestForEachErr(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestForEachErr")
+
+	visited := []int{}
+	err := ForEachErr([]int{1, 2, 3, 4}, func(i int, n int) error {
+		visited = append(visited, n)
+		if n == 2 {
+			return fmt.Errorf("item %d", i)
+		}
+		return nil
+	})
+	assert.Equal("item 1", err.Error())
+	assert.Equal([]int{1, 2}, visited)
+
+	err = ForEachErrAll([]int{1, 2, 3, 4}, func(i int, n int) error {
+		if n%2 == 0 {
+			return fmt.Errorf("item %d", i)
+		}
+		return nil
+	})
+	assert.Equal("item 1\nitem 3", err.Error())
+
+	err = ForEachErrAll([]int{1, 3}, func(i int, n int) error {
+		if n%2 == 0 {
+			return fmt.Errorf("item %d", i)
+		}
+		return nil
+	})
+	assert.IsNil(err)
+}
+
+func TestElementWise(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestElementWise")
+
+	a := []int{1, 2, 3}
+	b := []int{4, 5, 6}
+
+	sum, err := AddSlices(a, b)
+	assert.IsNil(err)
+	assert.Equal([]int{5, 7, 9}, sum)
+
+	diff, err := SubSlices(a, b)
+	assert.IsNil(err)
+	assert.Equal([]int{-3, -3, -3}, diff)
+
+	prod, err := MulSlices(a, b)
+	assert.IsNil(err)
+	assert.Equal([]int{4, 10, 18}, prod)
+
+	_, err = AddSlices(a, b[:2])
+	assert.ShouldBeTrue(errors.Is(err, ErrLengthMismatch))
+	assert.Equal("lengths 3 and 2: length mismatch", err.Error())
+
+	_, err = SubSlices(a, nil)
+	assert.ShouldBeTrue(errors.Is(err, ErrLengthMismatch))
+
+	empty, err := MulSlices([]float64{}, []float64{})
+	assert.IsNil(err)
+	assert.Equal([]float64{}, empty)
+}
+
+func TestScalar(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestScalar")
+
+	a := []float64{1, 2.5, -3}
+
+	assert.Equal([]float64{2, 3.5, -2}, AddScalar(a, 1))
+	assert.Equal([]float64{2, 5, -6}, MulScalar(a, 2))
+	assert.Equal([]float64{1, 2.5, -3}, a)
+}
+
+func TestDot(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestDot")
+
+	dot, err := Dot([]int{1, 2, 3}, []int{4, 5, 6})
+	assert.IsNil(err)
+	assert.Equal(32, dot)
+
+	dot, err = Dot([]int{1, 2, 3}, []int{4})
+	assert.ShouldBeTrue(errors.Is(err, ErrLengthMismatch))
+	assert.Equal(0, dot)
+}
+
+func TestNorm(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestNorm")
+
+	assert.Equal(5.0, Norm([]int{3, 4}))
+	assert.Equal(0.0, Norm([]float64{}))
+	assert.Equal(3.0, Norm([]int8{-1, 2, -2}))
+}
+
+func TestConvertNumeric(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConvertNumeric")
+
+	t.Run("lossless", func(t *testing.T) {
+		result, err := ConvertNumeric[int, int8]([]int{-128, 0, 127})
+		assert.IsNil(err)
+		assert.Equal([]int8{-128, 0, 127}, result)
+
+		floats, err := ConvertNumeric[int, float64]([]int{1, -2, 1 << 53})
+		assert.IsNil(err)
+		assert.Equal([]float64{1, -2, 1 << 53}, floats)
+
+		ints, err := ConvertNumeric[float64, int]([]float64{1, -2, 3e9})
+		assert.IsNil(err)
+		assert.Equal([]int{1, -2, 3e9}, ints)
+
+		bounds, err := ConvertNumeric[float64, int64]([]float64{-1 << 63, 1<<63 - 1024})
+		assert.IsNil(err)
+		assert.Equal([]int64{math.MinInt64, 1<<63 - 1024}, bounds)
+
+		fromBounds, err := ConvertNumeric[int64, float64]([]int64{math.MinInt64, 1<<63 - 1024})
+		assert.IsNil(err)
+		assert.Equal([]float64{-1 << 63, 1<<63 - 1024}, fromBounds)
+
+		empty, err := ConvertNumeric[int, uint]([]int{})
+		assert.IsNil(err)
+		assert.Equal([]uint{}, empty)
+	})
+
+	t.Run("lossy", func(t *testing.T) {
+		tests := []struct {
+			convert func() error
+			want    string
+		}{
+			{func() error { _, err := ConvertNumeric[int, int8]([]int{1, 128}); return err }, "value 128 at index 1 cannot be represented as int8"},
+			{func() error { _, err := ConvertNumeric[int, uint]([]int{-1}); return err }, "value -1 at index 0 cannot be represented as uint"},
+			{func() error { _, err := ConvertNumeric[int8, uint8]([]int8{-1}); return err }, "value -1 at index 0 cannot be represented as uint8"},
+			{func() error { _, err := ConvertNumeric[uint64, int64]([]uint64{math.MaxUint64}); return err }, "value 18446744073709551615 at index 0 cannot be represented as int64"},
+			{func() error { _, err := ConvertNumeric[float64, int]([]float64{0, 1.5}); return err }, "value 1.5 at index 1 cannot be represented as int"},
+			{func() error { _, err := ConvertNumeric[float64, int32]([]float64{1e10}); return err }, "value 1e+10 at index 0 cannot be represented as int32"},
+			{func() error { _, err := ConvertNumeric[float64, float32]([]float64{0.1}); return err }, "value 0.1 at index 0 cannot be represented as float32"},
+			{func() error { _, err := ConvertNumeric[int64, float64]([]int64{1<<53 + 1}); return err }, "value 9007199254740993 at index 0 cannot be represented as float64"},
+			{func() error { _, err := ConvertNumeric[float64, float32]([]float64{math.NaN()}); return err }, "value NaN at index 0 cannot be represented as float32"},
+			{func() error { _, err := ConvertNumeric[float64, int64]([]float64{1 << 63}); return err }, "value 9.223372036854776e+18 at index 0 cannot be represented as int64"},
+			{func() error { _, err := ConvertNumeric[float64, uint8]([]float64{256}); return err }, "value 256 at index 0 cannot be represented as uint8"},
+			{func() error { _, err := ConvertNumeric[float32, uint]([]float32{-1}); return err }, "value -1 at index 0 cannot be represented as uint"},
+			{func() error { _, err := ConvertNumeric[float64, int]([]float64{math.NaN()}); return err }, "value NaN at index 0 cannot be represented as int"},
+			{func() error { _, err := ConvertNumeric[int64, float64]([]int64{math.MaxInt64}); return err }, "value 9223372036854775807 at index 0 cannot be represented as float64"},
+			{func() error { _, err := ConvertNumeric[uint64, float64]([]uint64{math.MaxUint64}); return err }, "value 18446744073709551615 at index 0 cannot be represented as float64"},
+			{func() error { _, err := ConvertNumeric[int32, float32]([]int32{math.MaxInt32}); return err }, "value 2147483647 at index 0 cannot be represented as float32"},
+		}
+
+		for _, tt := range tests {
+			assert.Equal(tt.want, tt.convert().Error())
+		}
+	})
+}
+
+func TestBatchedSeq(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBatchedSeq")
+
+	nums := []int{1, 2, 3, 4, 5, 6, 7}
+
+	assert.Equal([][]int{{1, 2, 3}, {4, 5, 6}, {7}}, stdslices.Collect(BatchedSeq(stdslices.Values(nums), 3)))
+	assert.Equal([][]int{{1, 2, 3, 4, 5, 6, 7}}, stdslices.Collect(BatchedSeq(stdslices.Values(nums), 7)))
+	assert.Equal([][]int(nil), stdslices.Collect(BatchedSeq(stdslices.Values([]int{}), 3)))
+
+	t.Run("lazy", func(t *testing.T) {
+		pulled := 0
+		counting := func(yield func(int) bool) {
+			for i := 0; ; i++ {
+				pulled++
+				if !yield(i) {
+					return
+				}
+			}
+		}
+
+		for batch := range BatchedSeq(counting, 2) {
+			assert.Equal([]int{0, 1}, batch)
+			break
+		}
+		assert.Equal(2, pulled)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		defer func() {
+			assert.IsNotNil(recover())
+		}()
+		BatchedSeq(stdslices.Values(nums), 0)
+	})
+}
+
+func TestMovingAverage(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMovingAverage")
+
+	assert.Equal([]float64{2, 3, 4}, MovingAverage([]int{1, 2, 3, 4, 5}, 3))
+	assert.Equal([]float64{1, 2, 3}, MovingAverage([]int{1, 2, 3}, 1))
+	assert.Equal([]float64{2}, MovingAverage([]int{1, 2, 3}, 3))
+	assert.Equal([]float64{}, MovingAverage([]int{1, 2, 3}, 4))
+	assert.Equal([]float64{}, MovingAverage([]int{1, 2, 3}, 0))
+
+	// Large values do not overflow integer sums, nor cancel small values in float sums.
+	assert.Equal([]float64{1e17, 1, 1}, MovingAverage([]float64{1e17, 1, 1}, 1))
+	assert.Equal([]float64{5e16, 0.5, 1}, MovingAverage([]float64{1e17, 0, 1, 1}, 2))
+	assert.Equal([]float64{math.MaxInt64 - 1}, MovingAverage([]int64{math.MaxInt64, math.MaxInt64 - 2}, 2))
+	assert.Equal([]float64{-128, -0.5, 127}, MovingAverage([]int8{-128, -128, 127, 127}, 2))
+	assert.Equal([]float64{math.MaxUint64}, MovingAverage([]uint64{math.MaxUint64, math.MaxUint64}, 2))
+
+	// A non-finite value only affects the windows holding it.
+	inf := math.Inf(1)
+	averages := MovingAverage([]float64{1, inf, 3, math.NaN(), 5, 7, -inf, 9, 11}, 2)
+	assert.Equal([]float64{inf, inf}, averages[:2])
+	assert.ShouldBeTrue(math.IsNaN(averages[2]) && math.IsNaN(averages[3]))
+	assert.Equal([]float64{6, -inf, -inf, 10}, averages[4:])
+
+	// The running sum recovers from an overflow of the finite values.
+	assert.Equal([]float64{inf, math.MaxFloat64 / 2, 1}, MovingAverage([]float64{math.MaxFloat64, math.MaxFloat64, 0, 2}, 2))
+}
+
+func TestMovingMaxMin(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMovingMaxMin")
+
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+
+	assert.Equal([]int{3, 3, 5, 5, 6, 7}, MovingMax(nums, 3))
+	assert.Equal([]int{-1, -3, -3, -3, 3, 3}, MovingMin(nums, 3))
+	assert.Equal(nums, MovingMax(nums, 1))
+	assert.Equal([]int{7}, MovingMax(nums, len(nums)))
+	assert.Equal([]int{}, MovingMin(nums, 0))
+	assert.Equal([]int{}, MovingMin(nums, 9))
+	assert.Equal([]int{2, 2, 2}, MovingMax([]int{2, 2, 2, 2}, 2))
+
+	// compare with the naive O(n*w) computation
+	data := []int{5, 1, 4, 4, 9, 0, 2, 8, 3, 3, 7, 6}
+	for w := 1; w <= len(data); w++ {
+		for i := 0; i+w <= len(data); i++ {
+			assert.Equal(stdslices.Max(data[i:i+w]), MovingMax(data, w)[i])
+			assert.Equal(stdslices.Min(data[i:i+w]), MovingMin(data, w)[i])
+		}
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFrequency")
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := Frequency([]int{})
+		assert.Equal(map[int]int{}, result)
+	})
+
+	t.Run("int slice", func(t *testing.T) {
+		nums := []int{1, 2, 2, 3, 3, 3}
+		expected := map[int]int{1: 1, 2: 2, 3: 3}
+		result := Frequency(nums)
+
+		assert.Equal(expected, result)
+	})
+
+	t.Run("string slice", func(t *testing.T) {
+		strs := []string{"a", "b", "b", "c", "c", "c"}
+		expected := map[string]int{"a": 1, "b": 2, "c": 3}
+		result := Frequency(strs)
+
+		assert.Equal(expected, result)
+	})
+
+	t.Run("struct slice", func(t *testing.T) {
+		type student struct {
+			Name string
+			Age  int
+		}
+
+		students := []student{
+			{Name: "a", Age: 11},
+			{Name: "b", Age: 12},
+			{Name: "a", Age: 13},
+			{Name: "c", Age: 14},
+		}
+
+		expected := map[student]int{
+			{Name: "a", Age: 11}: 1,
+			{Name: "a", Age: 13}: 1,
+			{Name: "b", Age: 12}: 1,
+			{Name: "c", Age: 14}: 1,
+		}
+		result := Frequency(students)
+
+		assert.Equal(expected, result)
+	})
+}
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHistogram")
+
+	latencies := []float64{5, 12, 9.9, 10, 55, 100, 250, 0.1}
+	assert.Equal([]int{3, 2, 2, 1}, Histogram(latencies, []float64{10, 50, 200}))
+	assert.Equal([]int{8}, Histogram(latencies, []float64{}))
+	assert.Equal([]int{0, 0, 0}, Histogram([]int{}, []int{1, 2}))
+	assert.Equal([]int{1, 1, 2}, Histogram([]string{"a", "m", "z", "zz"}, []string{"b", "n"}))
+}
+
+func TestHistogramBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHistogramBy")
+
+	sizes := []int{1, 1023, 1024, 5000, 2 << 20, -1}
+	bySizeClass := func(n int) int {
+		switch {
+		case n < 0:
+			return -1
+		case n < 1024:
+			return 0
+		case n < 1<<20:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	assert.Equal([]int{2, 2, 1}, HistogramBy(sizes, 3, bySizeClass))
+	assert.Equal([]int{2, 2}, HistogramBy(sizes, 2, bySizeClass))
+	assert.Equal([]int{}, HistogramBy(sizes, -1, bySizeClass))
+}
+
+func TestMostCommon(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMostCommon")
+
+	errs := []string{"timeout", "refused", "timeout", "reset", "refused", "timeout", "eof"}
+
+	assert.Equal([]Counted[string]{
+		{"timeout", 3},
+		{"refused", 2},
+	}, MostCommon(errs, 2))
+
+	assert.Equal([]Counted[string]{
+		{"timeout", 3},
+		{"refused", 2},
+		{"reset", 1},
+		{"eof", 1},
+	}, MostCommon(errs, -1))
+
+	assert.Equal(4, len(MostCommon(errs, 10)))
+	assert.Equal([]Counted[string]{}, MostCommon(errs, 0))
+	assert.Equal([]Counted[int]{}, MostCommon([]int{}, 3))
+}
+
+func TestMajority(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestMajority")
+
+	tests := []struct {
+		slice []string
+		want  string
+		ok    bool
+	}{
+		{[]string{"a", "b", "a", "c", "a"}, "a", true},
+		{[]string{"b", "b", "a", "a", "a"}, "a", true},
+		{[]string{"a", "b", "a", "b"}, "", false},
+		{[]string{"a", "b", "c"}, "", false},
+		{[]string{"a", "a", "b", "b", "c", "c", "a"}, "", false},
+		{[]string{"x"}, "x", true},
+		{[]string{}, "", false},
+	}
+
+	for _, tt := range tests {
+		v, ok := Majority(tt.slice)
+		assert.Equal(tt.want, v)
+		assert.Equal(tt.ok, ok)
+	}
+}
+
+func TestJoinFunc(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestJoinFunc")
+
+	t.Run("basic case", func(t *testing.T) {
+		result := JoinFunc([]int{1, 2, 3}, ", ", func(i int) int {
+			return i * 2
+		})
+
+		expected := "2, 4, 6"
+		assert.Equal(expected, result)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := JoinFunc([]int{}, ", ", func(i int) int {
+			return i * 2
+		})
+
+		assert.Equal("", result)
+	})
+
+	t.Run("single element slice", func(t *testing.T) {
+		result := JoinFunc([]int{1}, ", ", func(i int) int {
+			return i * 2
+		})
+
+		assert.Equal("2", result)
+	})
+
+	t.Run("string slice", func(t *testing.T) {
+		result := JoinFunc([]string{"a", "b", "c"}, ", ", func(s string) string {
+			return strings.ToUpper(s)
+		})
+
+		expected := "A, B, C"
+		assert.Equal(expected, result)
+	})
+}
+
+func TestConcatBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestConcatBy")
+
+	t.Run("Join strings", func(t *testing.T) {
+		result := ConcatBy([]string{"Hello", "World"}, ", ", func(a, b string) string {
+			return a + b
+		})
+
+		expected := "Hello, World"
+		assert.Equal(expected, result)
+	})
+
+	t.Run("Join Person struct", func(t *testing.T) {
+		type Person struct {
+			Name string
+			Age  int
+		}
+
+		people := []Person{
 			{Name: "Alice", Age: 30},
 			{Name: "Bob", Age: 25},
 			{Name: "Charlie", Age: 35},
@@ -1734,6 +3903,102 @@ func TestConcatBy(t *testing.T) {
 	})
 }
 
+func TestIsPermutationBy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIsPermutationBy")
+
+	type tagged struct {
+		name string
+		tags []string
+	}
+	byName := func(t tagged) string { return t.name }
+
+	a := []tagged{{"a", []string{"x"}}, {"b", nil}, {"a", nil}}
+	b := []tagged{{"b", []string{"y"}}, {"a", nil}, {"a", []string{"z"}}}
+	c := []tagged{{"b", nil}, {"b", nil}, {"a", nil}}
+
+	assert.ShouldBeTrue(IsPermutationBy(a, b, byName))
+	assert.ShouldBeFalse(IsPermutationBy(a, c, byName))
+	assert.ShouldBeFalse(IsPermutationBy(a, b[:2], byName))
+	assert.ShouldBeTrue(IsPermutationBy([]string{"Go", "rust"}, []string{"RUST", "go"}, strings.ToLower))
+}
+
+func TestIsPermutationWith(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIsPermutationWith")
+
+	approx := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+	assert.ShouldBeTrue(IsPermutationWith([]float64{0.1 + 0.2, 1}, []float64{1, 0.3}, approx))
+	assert.ShouldBeFalse(IsPermutationWith([]float64{0.3, 0.3}, []float64{0.3, 1}, approx))
+	assert.ShouldBeFalse(IsPermutationWith([]float64{0.3}, []float64{0.3, 1}, approx))
+	assert.ShouldBeTrue(IsPermutationWith([]float64{}, []float64{}, approx))
+
+	// eq is not transitive: 1.0 matches both 1.05 and 0.95, 1.1 only 1.05.
+	within := func(a, b float64) bool { return math.Abs(a-b) <= 0.1 }
+	assert.ShouldBeTrue(IsPermutationWith([]float64{1.0, 1.1}, []float64{1.05, 0.95}, within))
+	assert.ShouldBeFalse(IsPermutationWith([]float64{1.1, 1.1}, []float64{1.05, 0.95}, within))
+}
+
+func TestEqualWith(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestEqualWith")
+
+	approx := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+	assert.ShouldBeTrue(EqualWith([]float64{0.1 + 0.2, 1}, []float64{0.3, 1}, approx))
+	assert.ShouldBeFalse(EqualWith([]float64{0.3, 1}, []float64{1, 0.3}, approx))
+	assert.ShouldBeFalse(EqualWith([]float64{0.3}, []float64{0.3, 1}, approx))
+	assert.ShouldBeTrue(EqualWith([]string{"Go"}, []string{"GO"}, strings.EqualFold))
+}
+
+func TestIsRotation(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIsRotation")
+
+	tests := []struct {
+		slice1, slice2 []int
+		expected       bool
+	}{
+		{[]int{}, []int{}, true},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, true},
+		{[]int{1, 2, 3}, []int{3, 1, 2}, true},
+		{[]int{1, 2, 3}, []int{2, 3, 1}, true},
+		{[]int{1, 2, 3}, []int{3, 2, 1}, false},
+		{[]int{1, 2, 3}, []int{1, 2}, false},
+		{[]int{1, 1, 2, 1, 1, 3}, []int{1, 3, 1, 1, 2, 1}, true},
+		{[]int{1, 1, 2, 1, 1, 3}, []int{1, 1, 3, 1, 2, 1}, false},
+		{[]int{1, 1, 1}, []int{1, 1, 1}, true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.expected, IsRotation(test.slice1, test.slice2))
+		assert.Equal(test.expected, IsRotationWith(test.slice1, test.slice2, func(a, b int) bool { return a == b }))
+	}
+
+	assert.ShouldBeTrue(IsRotationWith([]string{"a", "B", "c"}, []string{"C", "a", "b"}, strings.EqualFold))
+}
+
+func TestIsPalindrome(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIsPalindrome")
+
+	assert.ShouldBeTrue(IsPalindrome([]int{}))
+	assert.ShouldBeTrue(IsPalindrome([]int{1}))
+	assert.ShouldBeTrue(IsPalindrome([]int{1, 2, 1}))
+	assert.ShouldBeTrue(IsPalindrome([]int{1, 2, 2, 1}))
+	assert.ShouldBeFalse(IsPalindrome([]int{1, 2, 3}))
+	assert.ShouldBeFalse(IsPalindrome([]int{1, 2, 2, 3}))
+
+	assert.ShouldBeTrue(IsPalindromeWith([]string{"a", "B", "b", "A"}, strings.EqualFold))
+	assert.ShouldBeFalse(IsPalindromeWith([]string{"a", "B", "c", "A"}, strings.EqualFold))
+}
+
 func TestIsPermutation(t *testing.T) {
 	t.Parallel()
 
@@ -1755,3 +4020,458 @@ func TestIsPermutation(t *testing.T) {
 		assert.Equal(test.expected, IsPermutation(test.slice1, test.slice2))
 	}
 }
+
+func TestSortedSlice(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSortedSlice")
+
+	input := []int{5, 1, 4}
+	s := NewSortedSlice(input...)
+	assert.Equal([]int{1, 4, 5}, s.ToSlice())
+	assert.Equal([]int{5, 1, 4}, input)
+
+	for _, v := range []int{3, 4, 0, 9} {
+		s.Insert(v)
+	}
+	assert.Equal([]int{0, 1, 3, 4, 4, 5, 9}, s.ToSlice())
+	assert.Equal(7, s.Len())
+	assert.Equal(9, s.At(6))
+
+	assert.Equal(true, s.Contains(3))
+	assert.Equal(false, s.Contains(2))
+
+	assert.Equal(0, s.Rank(-1))
+	assert.Equal(3, s.Rank(4))
+	assert.Equal(5, s.Rank(5))
+	assert.Equal(7, s.Rank(10))
+
+	assert.Equal(true, s.Delete(4))
+	assert.Equal(false, s.Delete(2))
+	assert.Equal([]int{0, 1, 3, 4, 5, 9}, s.ToSlice())
+
+	assert.Equal([]int{3, 4, 5}, stdslices.Collect(s.Range(2, 9)))
+	assert.Equal(0, len(stdslices.Collect(s.Range(6, 9))))
+	assert.Equal(s.ToSlice(), stdslices.Collect(s.All()))
+
+	empty := NewSortedSlice[string]()
+	assert.Equal(false, empty.Delete("a"))
+	empty.Insert("b")
+	empty.Insert("a")
+	assert.Equal([]string{"a", "b"}, empty.ToSlice())
+}
+
+func TestImmutableSlice(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestImmutableSlice")
+
+	input := []int{1, 2, 3, 4}
+	s := NewImmutableSlice(input...)
+	input[0] = 100
+
+	assert.Equal(4, s.Len())
+	assert.Equal(1, s.Get(0))
+	assert.Equal([]int{1, 2, 3, 4}, s.ToSlice())
+
+	copied := s.ToSlice()
+	copied[1] = 200
+	assert.Equal(2, s.Get(1))
+
+	view := s.Slice(1, 3)
+	assert.Equal([]int{2, 3}, view.ToSlice())
+	assert.Equal(&s.items[1], &view.items[0])
+
+	appended := view.Append(9)
+	assert.Equal([]int{2, 3, 9}, appended.ToSlice())
+	assert.Equal([]int{1, 2, 3, 4}, s.ToSlice())
+
+	evens := s.Filter(func(_ int, n int) bool { return n%2 == 0 })
+	assert.Equal([]int{2, 4}, evens.ToSlice())
+	all := s.Filter(func(_ int, n int) bool { return true })
+	assert.Equal(&s.items[0], &all.items[0])
+
+	doubled := MapImmutable(s, func(_ int, n int) string { return strconv.Itoa(n * 2) })
+	assert.Equal([]string{"2", "4", "6", "8"}, doubled.ToSlice())
+
+	sum := 0
+	for i, v := range s.All() {
+		sum += i * v
+	}
+	assert.Equal(0*1+1*2+2*3+3*4, sum)
+
+	var zero ImmutableSlice[int]
+	assert.Equal(0, zero.Len())
+	assert.Equal([]int{1}, zero.Append(1).ToSlice())
+}
+
+func TestImmutableSliceIterators(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestImmutableSliceIterators")
+
+	s := NewImmutableSlice("a", "b", "c")
+
+	var pairs []string
+	for i, v := range s.All() {
+		pairs = append(pairs, strconv.Itoa(i)+v)
+	}
+	assert.Equal([]string{"0a", "1b", "2c"}, pairs)
+	assert.Equal([]int{0, 1, 2}, stdslices.Collect(s.Keys()))
+	assert.Equal([]string{"a", "b", "c"}, stdslices.Collect(s.Values()))
+
+	for i := range s.All() {
+		if i == 1 {
+			break
+		}
+	}
+}
+
+func TestSparseSlice(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSparseSlice")
+
+	s := NewSparseSlice[float64](5)
+	assert.Equal(5, s.Len())
+	assert.Equal(0, s.Stored())
+	assert.Equal(0.0, s.Get(4))
+
+	s.Set(3, 1.5)
+	s.Set(1, 0.5)
+	s.Set(7, 2)
+	assert.Equal(8, s.Len())
+	assert.Equal(1.5, s.Get(3))
+	assert.Equal([]float64{0, 0.5, 0, 1.5, 0, 0, 0, 2}, s.Dense())
+
+	var indexes []int
+	for i := range s.All() {
+		indexes = append(indexes, i)
+	}
+	assert.Equal([]int{1, 3, 7}, indexes)
+
+	s.Set(3, 0)
+	assert.Equal(3, s.Stored())
+	s.Compact()
+	assert.Equal(2, s.Stored())
+
+	s.Delete(1)
+	assert.Equal(1, s.Stored())
+	assert.Equal(8, s.Len())
+
+	from := SparseSliceFrom([]int{0, 0, 4, 0, 5})
+	assert.Equal(5, from.Len())
+	assert.Equal(2, from.Stored())
+	assert.Equal([]int{0, 0, 4, 0, 5}, from.Dense())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	from.Get(5)
+}
+
+func TestIntoVariants(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestIntoVariants")
+
+	nums := []int{1, 2, 3, 4, 5, 6}
+
+	t.Run("MapInto", func(t *testing.T) {
+		buf := make([]string, 0, 8)
+		result := MapInto(buf, nums, func(_ int, n int) string { return strconv.Itoa(n) })
+		assert.Equal([]string{"1", "2", "3", "4", "5", "6"}, result)
+		assert.Equal(&buf[:1][0], &result[0])
+
+		result = MapInto(result, nums[:2], func(_ int, n int) string { return strconv.Itoa(-n) })
+		assert.Equal([]string{"-1", "-2"}, result)
+
+		assert.Equal([]string{"1"}, MapInto(nil, nums[:1], func(_ int, n int) string { return strconv.Itoa(n) }))
+	})
+
+	t.Run("FilterInto", func(t *testing.T) {
+		buf := make([]int, 0, 8)
+		result := FilterInto(buf, nums, func(_ int, n int) bool { return n%2 == 0 })
+		assert.Equal([]int{2, 4, 6}, result)
+		assert.Equal(&buf[:1][0], &result[0])
+	})
+
+	t.Run("DifferenceInto", func(t *testing.T) {
+		buf := make([]int, 0, 8)
+		assert.Equal(Difference(nums, []int{2, 3}), DifferenceInto(buf, nums, []int{2, 3}))
+
+		large := RangeOf(0, SmallSliceThreshold*2, 1)
+		assert.Equal(Difference(nums, large), DifferenceInto(buf, nums, large))
+		assert.Equal(0, len(DifferenceInto(buf, nums, large)))
+	})
+}
+
+func TestHeapHelpers(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHeapHelpers")
+
+	natural := icompare.Natural[int]()
+	heap := []int{9, 4, 7, 1, 8, 2}
+	Heapify(heap, natural)
+	assert.Equal(1, heap[0])
+
+	heap = HeapPush(heap, 0, natural)
+	heap = HeapPush(heap, 5, natural)
+
+	var popped []int
+	for len(heap) > 0 {
+		var v int
+		v, heap = HeapPop(heap, natural)
+		popped = append(popped, v)
+	}
+	assert.Equal([]int{0, 1, 2, 4, 5, 7, 8, 9}, popped)
+
+	maxHeap := []string{"b", "d", "a", "c"}
+	Heapify(maxHeap, icompare.Reversed(icompare.Natural[string]()))
+	top, maxHeap := HeapPop(maxHeap, icompare.Reversed(icompare.Natural[string]()))
+	assert.Equal("d", top)
+	assert.Equal(3, len(maxHeap))
+
+	// the vacated slot is cleared
+	backing := []*int{new(int), new(int)}
+	_, rest := HeapPop(backing, func(a, b *int) int { return 0 })
+	assert.Equal(1, len(rest))
+	assert.ShouldBeTrue(backing[1] == nil)
+}
+
+func TestChannelHelpers(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestChannelHelpers")
+
+	t.Run("ToChannelAndCollect", func(t *testing.T) {
+		ctx := context.Background()
+		values, err := Collect(ctx, ToChannel(ctx, []int{1, 2, 3}), 0)
+		assert.IsNil(err)
+		assert.Equal([]int{1, 2, 3}, values)
+
+		values, err = Collect(ctx, ToChannel(ctx, RangeOf(0, 100, 1)), 2)
+		assert.IsNil(err)
+		assert.Equal([]int{0, 1}, values)
+	})
+
+	t.Run("Cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := ToChannel(ctx, []int{1, 2, 3})
+		assert.Equal(1, <-ch)
+		cancel()
+
+		// the producer stops, and the channel gets closed
+		for range ch {
+		}
+
+		values, err := Collect(ctx, make(chan int), 0)
+		assert.Equal([]int{}, values)
+		assert.Equal(context.Canceled, err)
+	})
+
+	t.Run("FanOutFanIn", func(t *testing.T) {
+		ctx := context.Background()
+		workers := FanOut(ctx, ToChannel(ctx, RangeOf(0, 100, 1)), 4)
+		assert.Equal(4, len(workers))
+
+		doubled := make([]<-chan int, len(workers))
+		for i, in := range workers {
+			out := make(chan int)
+			doubled[i] = out
+			go func() {
+				defer close(out)
+				for v := range in {
+					out <- v * 2
+				}
+			}()
+		}
+
+		values, err := Collect(ctx, FanIn(ctx, doubled...), 0)
+		assert.IsNil(err)
+		stdslices.Sort(values)
+		assert.Equal(RangeOf(0, 200, 2), values)
+	})
+
+	t.Run("FanOutUnreadOutput", func(t *testing.T) {
+		// Only the first output is read: every value must reach it, none being held by
+		// the other output.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		outs := FanOut(ctx, ToChannel(ctx, []int{0, 1, 2, 3}), 2)
+
+		values, err := Collect(ctx, outs[0], 0)
+		assert.IsNil(err)
+		assert.Equal([]int{0, 1, 2, 3}, values)
+		_, open := <-outs[1]
+		assert.ShouldBeFalse(open)
+	})
+
+	t.Run("FanInCancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		merged := FanIn(ctx, make(chan int), make(chan int))
+		cancel()
+		_, open := <-merged
+		assert.ShouldBeFalse(open)
+	})
+}
+
+func TestSliceJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSliceJSON")
+
+	var zero ImmutableSlice[int]
+	data, err := json.Marshal(zero)
+	assert.IsNil(err)
+	assert.Equal("[]", string(data))
+
+	s := NewImmutableSlice(1, 2, 3)
+	view := s.Slice(0, 2)
+	data, err = json.Marshal(s)
+	assert.IsNil(err)
+	assert.Equal("[1,2,3]", string(data))
+	assert.IsNil(json.Unmarshal([]byte("[7]"), &s))
+	assert.Equal([]int{7}, s.ToSlice())
+	assert.Equal([]int{1, 2}, view.ToSlice())
+
+	sorted := NewSortedSlice(5)
+	assert.IsNil(json.Unmarshal([]byte("[3,1,2,1]"), sorted))
+	assert.Equal([]int{1, 1, 2, 3}, sorted.ToSlice())
+	data, err = json.Marshal(sorted)
+	assert.IsNil(err)
+	assert.Equal("[1,1,2,3]", string(data))
+}
+
+func TestSliceBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSliceBinary")
+
+	data, err := NewImmutableSlice("a", "b").MarshalBinary()
+	assert.IsNil(err)
+	var s ImmutableSlice[string]
+	assert.IsNil(s.UnmarshalBinary(data))
+	assert.Equal([]string{"a", "b"}, s.ToSlice())
+
+	data, err = NewSortedSlice(3, 1, 2).MarshalBinary()
+	assert.IsNil(err)
+	sorted := NewSortedSlice[int]()
+	assert.IsNil(sorted.UnmarshalBinary(data))
+	assert.Equal([]int{1, 2, 3}, sorted.ToSlice())
+	assert.IsNotNil(sorted.UnmarshalBinary(data[:2]))
+}
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCSV")
+
+	type Audit struct {
+		Created time.Time `csv:"created"`
+	}
+	type row struct {
+		Audit
+		Name   string   `csv:"name"`
+		Score  float64  `csv:"score"`
+		Active bool     `csv:"active"`
+		Parent *int     `csv:"parent"`
+		Tags   []string `csv:"-"`
+		Count  uint8
+		secret string
+	}
+
+	one := 1
+	created := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	rows := []row{
+		{Audit: Audit{created}, Name: "a, \"quoted\"", Score: 1.5, Active: true, Parent: &one, Count: 3, secret: "x"},
+		{Audit: Audit{created}, Name: "b", Score: -2},
+	}
+
+	var buf strings.Builder
+	assert.IsNil(ToCSV(&buf, rows))
+	assert.Equal("created,name,score,active,parent,Count\n"+
+		"2025-03-01T12:00:00Z,\"a, \"\"quoted\"\"\",1.5,true,1,3\n"+
+		"2025-03-01T12:00:00Z,b,-2,false,,0\n", buf.String())
+
+	decoded, err := FromCSV[row](strings.NewReader(buf.String()))
+	assert.IsNil(err)
+	rows[0].secret = ""
+	assert.Equal(rows, decoded)
+
+	// Columns are matched by name; unknown ones are ignored.
+	pointers, err := FromCSV[*row](strings.NewReader("score,extra,name\n3,x,c\n"))
+	assert.IsNil(err)
+	assert.Equal(1, len(pointers))
+	assert.Equal("c", pointers[0].Name)
+	assert.Equal(3.0, pointers[0].Score)
+
+	empty, err := FromCSV[row](strings.NewReader(""))
+	assert.IsNil(err)
+	assert.Equal(0, len(empty))
+
+	_, err = FromCSV[row](strings.NewReader("name,active\nok,true\nko,maybe\n"))
+	assert.Equal(`FromCSV: line 3, column "active": invalid value "maybe": invalid syntax`, err.Error())
+	_, err = FromCSV[row](strings.NewReader("Count\n300\n"))
+	assert.IsNotNil(err)
+
+	_, err = FromCSV[int](strings.NewReader("1\n"))
+	assert.IsNotNil(err)
+	assert.IsNotNil(ToCSV(&buf, []struct{ C chan int }{{}}))
+	assert.IsNotNil(ToCSV(&buf, []struct {
+		A int `csv:"x"`
+		B int `csv:"x"`
+	}{}))
+}
+
+func TestCSVOptions(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestCSVOptions")
+
+	type point struct {
+		X, Y int
+		Day  time.Time `csv:"day"`
+	}
+	opts := CSVOptions{
+		NoHeader: true,
+		Formatters: map[string]func(any) (string, error){
+			"day": func(v any) (string, error) { return v.(time.Time).Format(time.DateOnly), nil },
+		},
+		Parsers: map[string]func(string) (any, error){
+			"day": func(s string) (any, error) { return time.Parse(time.DateOnly, s) },
+		},
+	}
+	points := []*point{{1, 2, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)}, nil}
+
+	var buf strings.Builder
+	assert.IsNil(ToTSV(&buf, points, opts))
+	assert.Equal("1\t2\t2025-01-02\n", buf.String())
+
+	decoded, err := FromTSV[point](strings.NewReader(buf.String()), opts)
+	assert.IsNil(err)
+	assert.Equal([]point{*points[0]}, decoded)
+
+	_, err = FromTSV[point](strings.NewReader("1\t2\n"), opts)
+	assert.IsNotNil(err)
+
+	opts.Parsers["day"] = func(s string) (any, error) { return s, nil }
+	_, err = FromTSV[point](strings.NewReader(buf.String()), opts)
+	assert.IsNotNil(err)
+
+	buf.Reset()
+	assert.IsNil(ToCSV(&buf, points, CSVOptions{Comma: ';'}))
+	assert.Equal("X;Y;day\n1;2;2025-01-02T00:00:00Z\n", buf.String())
+}
+
+func BenchmarkMapInto(b *testing.B) {
+	src := RangeOf(0, 1000, 1)
+	buf := make([]int, 0, len(src))
+	double := func(_ int, n int) int { return n * 2 }
+
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = MapInto(buf, src, double)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package istacks
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/idichekop/gods/internal/ibinary"
+)
+
+// MarshalBinary encodes the elements of the stack, from the bottom to the top.
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(slices.Values(s.items))
+}
+
+// UnmarshalBinary replaces the contents of the stack with the elements encoded by
+// MarshalBinary.  The stack keeps its limit; it returns ErrStackFull if the elements
+// exceed it.
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	items, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	if s.limit > 0 && len(items) > s.limit {
+		return fmt.Errorf("Stack.UnmarshalBinary: %d elements for a limit of %d: %w", len(items), s.limit, ErrStackFull)
+	}
+	s.items = items
+	return nil
+}
@@ -0,0 +1,30 @@
+package istacks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestStackBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStackBinary")
+
+	s := NewStack[string]()
+	s.Push("a")
+	s.Push("b")
+	data, err := s.MarshalBinary()
+	assert.IsNil(err)
+
+	var decoded Stack[string]
+	assert.IsNil(decoded.UnmarshalBinary(data))
+	top, _ := decoded.Pop()
+	assert.Equal("b", top)
+	assert.Equal(1, decoded.Len())
+
+	limited := NewStack[string](1)
+	assert.ShouldBeTrue(errors.Is(limited.UnmarshalBinary(data), ErrStackFull))
+	assert.IsNotNil(decoded.UnmarshalBinary(nil))
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package istacks
+
+import "errors"
+
+// Diff tells how History.Do records a new state, as compared to the current one.
+type Diff int
+
+const (
+	// DiffNew records the new state as a step of its own: Undo returns to the current one.
+	DiffNew Diff = iota
+	// DiffSame ignores the new state, as it does not differ from the current one.
+	DiffSame
+	// DiffMerge merges the new state into the current step: it replaces the current state,
+	// and Undo skips both.  This compacts runs of small changes, such as typed characters.
+	DiffMerge
+)
+
+// HistoryOptions configures a History.  The zero HistoryOptions keeps every step.
+type HistoryOptions[T any] struct {
+	// Limit caps the number of steps that can be undone: the oldest steps are dropped
+	// beyond it.  Zero means no limit.
+	Limit int
+	// Diff compares the current state to the new state passed to Do, and tells how to
+	// record it.  Nil means every state is a new step.
+	Diff func(current, next T) Diff
+}
+
+// History manages the undo and redo stacks of the states of a document, as in an editor.
+// Do records a new state, Undo and Redo move back and forth among the recorded states.  A
+// History is not safe for concurrent use.
+type History[T any] struct {
+	current T
+	undo    *Stack[T]
+	redo    *Stack[T]
+	diff    func(current, next T) Diff
+}
+
+// NewHistory creates a History whose current state is initial, with nothing to undo.  It
+// panics if the limit of opts is negative.
+func NewHistory[T any](initial T, opts ...HistoryOptions[T]) *History[T] {
+	var o HistoryOptions[T]
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.Limit < 0 {
+		panic("NewHistory: limit must not be negative")
+	}
+
+	h := &History[T]{current: initial, undo: NewStack[T](), redo: NewStack[T](), diff: o.Diff}
+	if o.Limit > 0 {
+		h.undo = NewStack[T](o.Limit)
+	}
+	return h
+}
+
+// Current returns the current state.
+func (h *History[T]) Current() T {
+	return h.current
+}
+
+// Do makes state the current state, and discards the states that could be redone.  The
+// previous state is recorded for Undo, unless the Diff of the options says otherwise.
+func (h *History[T]) Do(state T) {
+	d := DiffNew
+	if h.diff != nil {
+		d = h.diff(h.current, state)
+	}
+	switch d {
+	case DiffSame:
+		return
+	case DiffNew:
+		if errors.Is(h.undo.Push(h.current), ErrStackFull) {
+			h.undo.removeBottom()
+			h.undo.Push(h.current)
+		}
+	}
+	h.current = state
+	h.redo.clear()
+}
+
+// Undo returns to the previous state and returns it, or false if there is nothing to
+// undo.
+func (h *History[T]) Undo() (T, bool) {
+	state, ok := h.undo.Pop()
+	if !ok {
+		return state, false
+	}
+	h.redo.Push(h.current)
+	h.current = state
+	return state, true
+}
+
+// Redo returns to the state left by the last Undo and returns it, or false if there is
+// nothing to redo.
+func (h *History[T]) Redo() (T, bool) {
+	state, ok := h.redo.Pop()
+	if !ok {
+		return state, false
+	}
+	// The undo stack is at most as long as before the Undo, so it is not full.
+	h.undo.Push(h.current)
+	h.current = state
+	return state, true
+}
+
+// UndoLen returns the number of steps that can be undone.
+func (h *History[T]) UndoLen() int {
+	return h.undo.Len()
+}
+
+// RedoLen returns the number of steps that can be redone.
+func (h *History[T]) RedoLen() int {
+	return h.redo.Len()
+}
+
+// Clear forgets the steps that can be undone or redone, keeping the current state.
+func (h *History[T]) Clear() {
+	h.undo.clear()
+	h.redo.clear()
+}
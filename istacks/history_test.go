@@ -0,0 +1,102 @@
+package istacks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHistory")
+
+	h := NewHistory("")
+	_, ok := h.Undo()
+	assert.ShouldBeFalse(ok)
+	_, ok = h.Redo()
+	assert.ShouldBeFalse(ok)
+
+	h.Do("a")
+	h.Do("ab")
+	h.Do("abc")
+	assert.Equal("abc", h.Current())
+	assert.Equal(3, h.UndoLen())
+
+	state, ok := h.Undo()
+	assert.Equal("ab", state)
+	assert.ShouldBeTrue(ok)
+	state, _ = h.Undo()
+	assert.Equal("a", state)
+	assert.Equal(2, h.RedoLen())
+
+	state, ok = h.Redo()
+	assert.Equal("ab", state)
+	assert.ShouldBeTrue(ok)
+
+	// A new state discards the redo stack.
+	h.Do("abd")
+	assert.Equal(0, h.RedoLen())
+	assert.Equal(3, h.UndoLen())
+	for _, expected := range []string{"ab", "a", ""} {
+		state, _ = h.Undo()
+		assert.Equal(expected, state)
+	}
+	_, ok = h.Undo()
+	assert.ShouldBeFalse(ok)
+	assert.Equal("", h.Current())
+
+	h.Clear()
+	assert.Equal(0, h.RedoLen())
+	assert.Equal("", h.Current())
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewHistory(0, HistoryOptions[int]{Limit: -1})
+}
+
+func TestHistoryOptions(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestHistoryOptions")
+
+	h := NewHistory(0, HistoryOptions[int]{Limit: 3})
+	for i := 1; i <= 10; i++ {
+		h.Do(i)
+	}
+	assert.Equal(3, h.UndoLen())
+	for _, expected := range []int{9, 8, 7} {
+		state, _ := h.Undo()
+		assert.Equal(expected, state)
+	}
+	_, ok := h.Undo()
+	assert.ShouldBeFalse(ok)
+	for range 3 {
+		h.Redo()
+	}
+	assert.Equal(10, h.Current())
+	assert.Equal(3, h.UndoLen())
+
+	// Typing merges into the current step, until a space starts a new word.
+	words := NewHistory("", HistoryOptions[string]{
+		Diff: func(current, next string) Diff {
+			switch {
+			case current == next:
+				return DiffSame
+			case strings.HasPrefix(next, current) && !strings.HasSuffix(current, " "):
+				return DiffMerge
+			}
+			return DiffNew
+		},
+	})
+	for _, text := range []string{"h", "hi", "hi", "hi ", "hi t", "hi th", "hi there"} {
+		words.Do(text)
+	}
+	assert.Equal(1, words.UndoLen())
+	state, _ := words.Undo()
+	assert.Equal("hi ", state)
+	_, ok = words.Undo()
+	assert.ShouldBeFalse(ok)
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package istacks
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/idichekop/gods/internal/ijson"
+)
+
+// MarshalJSON encodes the stack as a JSON array, from the bottom to the top of the stack:
+// the order in which the elements were pushed.
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(slices.Values(s.items))
+}
+
+// UnmarshalJSON replaces the contents of the stack with the elements of a JSON array,
+// pushed in order.  The stack keeps its limit; it returns ErrStackFull if the array
+// exceeds it.
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	items, err := ijson.UnmarshalArray[T](data)
+	if err != nil || items == nil {
+		return err
+	}
+	if s.limit > 0 && len(items) > s.limit {
+		return fmt.Errorf("Stack.UnmarshalJSON: %d elements for a limit of %d: %w", len(items), s.limit, ErrStackFull)
+	}
+	s.items = items
+	return nil
+}
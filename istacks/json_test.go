@@ -0,0 +1,36 @@
+package istacks
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestStackJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStackJSON")
+
+	s := NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	data, err := json.Marshal(s)
+	assert.IsNil(err)
+	assert.Equal("[1,2]", string(data))
+
+	var decoded Stack[int]
+	assert.IsNil(json.Unmarshal(data, &decoded))
+	top, _ := decoded.Peek()
+	assert.Equal(2, top)
+	assert.Equal(2, decoded.Len())
+
+	data, _ = json.Marshal(NewStack[string]())
+	assert.Equal("[]", string(data))
+
+	limited := NewStack[int](1)
+	err = json.Unmarshal([]byte("[1,2]"), limited)
+	assert.ShouldBeTrue(errors.Is(err, ErrStackFull))
+	assert.Equal(0, limited.Len())
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package istacks implements a generic LIFO stack, and an undo/redo history built on it.
+package istacks
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrStackFull is returned by Push when the stack has reached its capacity limit.
+var ErrStackFull = errors.New("stack is full")
+
+// Stack is a last-in first-out collection, backed by a slice with amortized growth.  It
+// may have a capacity limit.  A Stack is not safe for concurrent use.
+type Stack[T any] struct {
+	items []T
+	limit int
+}
+
+// NewStack creates an empty stack.  The optional limit caps the number of elements; it
+// panics if it is not positive.  Without limit, the stack grows as needed.
+func NewStack[T any](limit ...int) *Stack[T] {
+	s := &Stack[T]{}
+	if len(limit) > 0 {
+		if limit[0] < 1 {
+			panic("NewStack: limit must be positive")
+		}
+		s.limit = limit[0]
+	}
+	return s
+}
+
+// Push adds item on top of the stack.  It returns ErrStackFull if the stack has reached
+// its limit.
+func (s *Stack[T]) Push(item T) error {
+	if s.limit > 0 && len(s.items) >= s.limit {
+		return ErrStackFull
+	}
+	s.items = append(s.items, item)
+	return nil
+}
+
+// Pop removes and returns the top element, or false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+
+	last := len(s.items) - 1
+	item := s.items[last]
+	// Release the reference held by the backing array.
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return item, true
+}
+
+// Peek returns the top element without removing it, or false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of elements.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the stack has no elements.
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// All returns an iterator over the elements, from the top to the bottom of the stack,
+// without removing them.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, from the top to the bottom of the stack,
+// without removing them.
+func (s *Stack[T]) Values() iter.Seq[T] {
+	return s.All()
+}
+
+// removeBottom removes the bottom element, if any.
+func (s *Stack[T]) removeBottom() {
+	if len(s.items) == 0 {
+		return
+	}
+	var zero T
+	s.items[0] = zero
+	s.items = s.items[1:]
+}
+
+// clear removes all the elements, keeping the backing array.
+func (s *Stack[T]) clear() {
+	clear(s.items)
+	s.items = s.items[:0]
+}
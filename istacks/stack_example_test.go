@@ -0,0 +1,52 @@
+package istacks
+
+import "fmt"
+
+func ExampleStack() {
+	s := NewStack[string]()
+
+	for _, tag := range []string{"html", "body", "div"} {
+		s.Push(tag)
+	}
+
+	top, _ := s.Peek()
+	fmt.Println(top, s.Len())
+
+	for !s.IsEmpty() {
+		tag, _ := s.Pop()
+		fmt.Printf("</%s>", tag)
+	}
+	fmt.Println()
+
+	// Output:
+	// div 3
+	// </div></body></html>
+}
+
+func ExampleNewStack() {
+	undo := NewStack[int](2)
+
+	fmt.Println(undo.Push(1), undo.Push(2))
+	fmt.Println(undo.Push(3))
+
+	// Output:
+	// <nil> <nil>
+	// stack is full
+}
+
+func ExampleHistory() {
+	h := NewHistory("draft")
+
+	h.Do("draft v2")
+	h.Do("draft v3")
+	h.Undo()
+	h.Undo()
+	fmt.Println(h.Current())
+
+	h.Redo()
+	fmt.Println(h.Current(), h.UndoLen(), h.RedoLen())
+
+	// Output:
+	// draft
+	// draft v2 1 1
+}
@@ -0,0 +1,73 @@
+package istacks
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestStack(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStack")
+
+	s := NewStack[int]()
+	assert.ShouldBeTrue(s.IsEmpty())
+	_, ok := s.Pop()
+	assert.ShouldBeFalse(ok)
+	_, ok = s.Peek()
+	assert.ShouldBeFalse(ok)
+
+	for i := range 100 {
+		assert.IsNil(s.Push(i))
+	}
+	assert.Equal(100, s.Len())
+
+	top, ok := s.Peek()
+	assert.Equal(99, top)
+	assert.ShouldBeTrue(ok)
+	assert.Equal([]int{99, 98, 97}, slices.Collect(s.All())[:3])
+
+	for i := 99; i >= 0; i-- {
+		v, ok := s.Pop()
+		assert.Equal(i, v)
+		assert.ShouldBeTrue(ok)
+	}
+	assert.ShouldBeTrue(s.IsEmpty())
+}
+
+func TestStackLimit(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStackLimit")
+
+	s := NewStack[string](2)
+	assert.IsNil(s.Push("a"))
+	assert.IsNil(s.Push("b"))
+	assert.Equal(true, errors.Is(s.Push("c"), ErrStackFull))
+	assert.Equal(2, s.Len())
+
+	s.Pop()
+	assert.IsNil(s.Push("c"))
+	assert.Equal([]string{"c", "a"}, slices.Collect(s.All()))
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewStack[string](0)
+}
+
+func TestStackPopReleasesReferences(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStackPopReleasesReferences")
+
+	s := NewStack[*int]()
+	v := 1
+	s.Push(&v)
+	s.Pop()
+
+	assert.IsNil(s.items[:1][0])
+}
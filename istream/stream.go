@@ -0,0 +1,238 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package istream implements lazy streams: chains of operations over a sequence of
+// values, evaluated in a single pass when a terminal operation pulls them.
+package istream
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/idichekop/gods/icompare"
+)
+
+// Stream is a lazy sequence of values.  Intermediate operations (Filter, Limit, Map,
+// ...) return a new Stream without evaluating anything; terminal operations (Collect,
+// Count, Reduce, ...) pull the values through the whole chain in one pass.
+//
+// A Stream is only as replayable as its source: streams over slices or over an
+// iter.Seq that can be ranged several times can be consumed several times, while a
+// stream over a channel is consumed once.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Of returns a stream of the given values.
+func Of[T any](values ...T) Stream[T] {
+	return FromSlice(values)
+}
+
+// FromSlice returns a stream of the elements of the slice.  The slice is not copied:
+// changes made to it before the stream is consumed are visible.
+func FromSlice[T any](slice []T) Stream[T] {
+	return Stream[T]{seq: slices.Values(slice)}
+}
+
+// FromSeq returns a stream of the values of seq.
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// FromChannel returns a stream of the values received from ch, until it is closed.
+func FromChannel[T any](ch <-chan T) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Seq returns the stream as an iter.Seq.
+func (s Stream[T]) Seq() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter returns a stream of the values that pass the predicate.
+func (s Stream[T]) Filter(predicate func(item T) bool) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			if predicate(v) && !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Limit returns a stream of at most the first n values.  The source is not pulled past
+// the n-th value.
+func (s Stream[T]) Limit(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for v := range s.seq {
+			count++
+			if !yield(v) || count == n {
+				return
+			}
+		}
+	}}
+}
+
+// Skip returns a stream without the first n values.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		skipped := 0
+		for v := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Peek returns a stream of the same values, calling action on each value as it flows
+// through, e.g. for logging.
+func (s Stream[T]) Peek(action func(item T)) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			action(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Sorted returns a stream of the values ordered by the comparator.  The sort is stable.
+// Unlike the other intermediate operations, Sorted has to pull all of the values of
+// its source before yielding the first one.
+func (s Stream[T]) Sorted(compare icompare.Comparator[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		values := slices.Collect(s.seq)
+		slices.SortStableFunc(values, compare)
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Collect returns the values of the stream in a slice.
+func (s Stream[T]) Collect() []T {
+	result := []T{}
+	for v := range s.seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Count returns the number of values of the stream.
+func (s Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// ForEach calls action on each value of the stream.
+func (s Stream[T]) ForEach(action func(item T)) {
+	for v := range s.seq {
+		action(v)
+	}
+}
+
+// First returns the first value of the stream, or false if it is empty.
+func (s Stream[T]) First() (T, bool) {
+	for v := range s.seq {
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
+
+// AnyMatch reports whether a value passes the predicate.  It stops at the first one.
+func (s Stream[T]) AnyMatch(predicate func(item T) bool) bool {
+	for v := range s.seq {
+		if predicate(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch reports whether every value passes the predicate.  It stops at the first
+// one that does not.  It returns true for an empty stream.
+func (s Stream[T]) AllMatch(predicate func(item T) bool) bool {
+	return !s.AnyMatch(func(item T) bool { return !predicate(item) })
+}
+
+// NoneMatch reports whether no value passes the predicate.
+func (s Stream[T]) NoneMatch(predicate func(item T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// Reduce combines the values of the stream from left to right, starting from initial.
+// Use the function Fold to reduce to a value of another type.
+func (s Stream[T]) Reduce(initial T, combine func(acc T, item T) T) T {
+	return Fold(s, initial, combine)
+}
+
+// Map returns a stream of the results of the iteratee on each value of s.
+func Map[T any, U any](s Stream[T], iteratee func(item T) U) Stream[U] {
+	return Stream[U]{seq: func(yield func(U) bool) {
+		for v := range s.seq {
+			if !yield(iteratee(v)) {
+				return
+			}
+		}
+	}}
+}
+
+// Distinct returns a stream of the values of s without duplicates, in the order of
+// their first occurrence.  It keeps every distinct value seen in memory.
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		seen := map[T]struct{}{}
+		for v := range s.seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Fold combines the values of the stream from left to right, starting from initial.
+func Fold[T any, A any](s Stream[T], initial A, combine func(acc A, item T) A) A {
+	acc := initial
+	for v := range s.seq {
+		acc = combine(acc, v)
+	}
+	return acc
+}
+
+// GroupBy groups the values of the stream by the key the key function returns for
+// them.  Each group keeps the order of the stream.
+func GroupBy[T any, K comparable](s Stream[T], key func(item T) K) map[K][]T {
+	groups := map[K][]T{}
+	for v := range s.seq {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
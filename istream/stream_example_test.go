@@ -0,0 +1,26 @@
+package istream
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/idichekop/gods/icompare"
+)
+
+func ExampleStream() {
+	words := FromSlice(strings.Fields("the quick brown fox jumps over the lazy dog"))
+
+	long := words.
+		Filter(func(w string) bool { return len(w) > 3 }).
+		Sorted(icompare.Natural[string]()).
+		Limit(3).
+		Collect()
+	fmt.Println(long)
+
+	lengths := Distinct(Map(words, func(w string) int { return len(w) })).Collect()
+	fmt.Println(lengths)
+
+	// Output:
+	// [brown jumps lazy]
+	// [3 5 4]
+}
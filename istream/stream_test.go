@@ -0,0 +1,122 @@
+package istream
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/internal"
+)
+
+func TestStreamIntermediateOperations(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStreamIntermediateOperations")
+
+	s := Of(5, 3, 8, 3, 1, 8, 9)
+	isOdd := func(n int) bool { return n%2 == 1 }
+
+	assert.Equal([]int{5, 3, 3, 1, 9}, s.Filter(isOdd).Collect())
+	assert.Equal([]int{5, 3}, s.Limit(2).Collect())
+	assert.Equal([]int{}, s.Limit(0).Collect())
+	assert.Equal([]int{8, 9}, s.Skip(5).Collect())
+	assert.Equal([]int{}, s.Skip(10).Collect())
+	assert.Equal([]int{1, 3, 3, 5, 8, 8, 9}, s.Sorted(icompare.Natural[int]()).Collect())
+	assert.Equal([]int{5, 3, 8, 1, 9}, Distinct(s).Collect())
+	assert.Equal([]string{"5", "3"}, Map(s.Limit(2), strconv.Itoa).Collect())
+
+	var peeked []int
+	assert.Equal(2, s.Peek(func(n int) { peeked = append(peeked, n) }).Limit(2).Count())
+	assert.Equal([]int{5, 3}, peeked)
+}
+
+func TestStreamTerminalOperations(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStreamTerminalOperations")
+
+	s := Of("apple", "avocado", "banana", "cherry", "blueberry")
+
+	assert.Equal(5, s.Count())
+	first, ok := s.First()
+	assert.Equal("apple", first)
+	assert.ShouldBeTrue(ok)
+	_, ok = Of[string]().First()
+	assert.ShouldBeFalse(ok)
+
+	hasLetter := func(b byte) func(string) bool {
+		return func(w string) bool { return w[0] == b }
+	}
+	assert.ShouldBeTrue(s.AnyMatch(hasLetter('c')))
+	assert.ShouldBeFalse(s.AllMatch(hasLetter('a')))
+	assert.ShouldBeTrue(s.NoneMatch(hasLetter('z')))
+	assert.ShouldBeTrue(Of[string]().AllMatch(hasLetter('a')))
+
+	assert.Equal(map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"banana", "blueberry"},
+		'c': {"cherry"},
+	}, GroupBy(s, func(w string) byte { return w[0] }))
+
+	assert.Equal("apple+avocado", s.Limit(2).Reduce("", func(acc, w string) string {
+		if acc == "" {
+			return w
+		}
+		return acc + "+" + w
+	}))
+	assert.Equal(33, Fold(s, 0, func(acc int, w string) int { return acc + len(w) }))
+
+	var visited []string
+	s.Skip(3).ForEach(func(w string) { visited = append(visited, w) })
+	assert.Equal([]string{"cherry", "blueberry"}, visited)
+}
+
+func TestStreamIsLazy(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStreamIsLazy")
+
+	pulled := 0
+	source := FromSeq(func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	evens := source.Filter(func(n int) bool { return n%2 == 0 })
+	assert.Equal(0, pulled)
+
+	assert.Equal([]int{0, 2, 4}, evens.Limit(3).Collect())
+	assert.Equal(5, pulled)
+	assert.ShouldBeTrue(source.AnyMatch(func(n int) bool { return n == 10 }))
+}
+
+func TestStreamSources(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestStreamSources")
+
+	slice := []int{1, 2, 3}
+	s := FromSlice(slice)
+	slice[0] = 10
+	assert.Equal([]int{10, 2, 3}, s.Collect())
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	fromChannel := FromChannel(ch)
+	assert.Equal([]int{1, 2}, fromChannel.Limit(2).Collect())
+	assert.Equal([]int{3}, fromChannel.Collect())
+	assert.Equal(0, fromChannel.Count())
+
+	var collected []int
+	for v := range Of(4, 5).Seq() {
+		collected = append(collected, v)
+	}
+	assert.Equal([]int{4, 5}, collected)
+}
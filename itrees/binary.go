@@ -0,0 +1,100 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"errors"
+
+	"github.com/idichekop/gods/internal/ibinary"
+)
+
+// MarshalBinary encodes the values of the tree in pre-order.  As for MarshalJSON,
+// UnmarshalBinary rebuilds a tree of the same shape.
+func (t *BST[T]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodeSlice(t.PreOrder())
+}
+
+// UnmarshalBinary replaces the contents of the tree with the values encoded by
+// MarshalBinary.  The tree keeps its comparator, so it must have been created by NewBST
+// or NewBSTFunc.
+func (t *BST[T]) UnmarshalBinary(data []byte) error {
+	if t.compare == nil {
+		return errors.New("BST.UnmarshalBinary: tree has no comparator")
+	}
+	values, err := ibinary.DecodeSlice[T](data)
+	if err != nil {
+		return err
+	}
+	t.root, t.length = nil, 0
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return nil
+}
+
+// MarshalBinary encodes the keys and values of the tree.
+func (t *RBTree[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(t.All())
+}
+
+// UnmarshalBinary replaces the contents of the tree with the entries encoded by
+// MarshalBinary.  The tree keeps its comparator, so it must have been created by
+// NewRBTree or NewRBTreeFunc.
+func (t *RBTree[K, V]) UnmarshalBinary(data []byte) error {
+	if t.compare == nil {
+		return errors.New("RBTree.UnmarshalBinary: tree has no comparator")
+	}
+	keys, values, err := ibinary.DecodePairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	t.root = nil
+	for i, k := range keys {
+		t.Put(k, values[i])
+	}
+	return nil
+}
+
+// MarshalBinary encodes the keys and values of the tree, in ascending order of keys.
+func (t *BTree[K, V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(t.All())
+}
+
+// UnmarshalBinary replaces the contents of the tree with the entries encoded by
+// MarshalBinary.  The tree keeps its degree and comparator, so it must have been created
+// by NewBTree, NewBTreeFunc or NewBTreeFromSorted.
+func (t *BTree[K, V]) UnmarshalBinary(data []byte) error {
+	if t.compare == nil {
+		return errors.New("BTree.UnmarshalBinary: tree has no comparator")
+	}
+	keys, values, err := ibinary.DecodePairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	t.root, t.length = nil, 0
+	for i, k := range keys {
+		t.Put(k, values[i])
+	}
+	return nil
+}
+
+// MarshalBinary encodes the keys and values of the tree.
+func (t *RadixTree[V]) MarshalBinary() ([]byte, error) {
+	return ibinary.EncodePairs(t.All())
+}
+
+// UnmarshalBinary replaces the contents of the tree with the entries encoded by
+// MarshalBinary.
+func (t *RadixTree[V]) UnmarshalBinary(data []byte) error {
+	keys, values, err := ibinary.DecodePairs[string, V](data)
+	if err != nil {
+		return err
+	}
+	*t = RadixTree[V]{}
+	for i, k := range keys {
+		t.Insert(k, values[i])
+	}
+	return nil
+}
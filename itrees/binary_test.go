@@ -0,0 +1,57 @@
+package itrees
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestTreesBinary(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTreesBinary")
+
+	bst := NewBST(5, 2, 8, 1)
+	data, err := bst.MarshalBinary()
+	assert.IsNil(err)
+	decodedBST := NewBST[int]()
+	assert.IsNil(decodedBST.UnmarshalBinary(data))
+	assert.Equal([]int{5, 2, 1, 8}, slices.Collect(decodedBST.PreOrder()))
+
+	rb := NewRBTree[int, string]()
+	bt := NewBTree[int, string](2)
+	for i := range 30 {
+		rb.Put(i, "v")
+		bt.Put(i, "w")
+	}
+	data, err = rb.MarshalBinary()
+	assert.IsNil(err)
+	decodedRB := NewRBTree[int, string]()
+	assert.IsNil(decodedRB.UnmarshalBinary(data))
+	assert.IsNil(decodedRB.Validate())
+	assert.Equal(30, decodedRB.Len())
+
+	data, err = bt.MarshalBinary()
+	assert.IsNil(err)
+	decodedBT := NewBTree[int, string](4)
+	assert.IsNil(decodedBT.UnmarshalBinary(data))
+	checkBTree(t, decodedBT)
+	v, _ := decodedBT.Get(29)
+	assert.Equal("w", v)
+
+	var zeroRB RBTree[int, string]
+	assert.IsNotNil(zeroRB.UnmarshalBinary(data))
+	var zeroBT BTree[int, string]
+	assert.IsNotNil(zeroBT.UnmarshalBinary(data))
+
+	radix := NewRadixTree[int]()
+	radix.Insert("tea", 1)
+	radix.Insert("team", 2)
+	data, err = radix.MarshalBinary()
+	assert.IsNil(err)
+	var decodedRadix RadixTree[int]
+	assert.IsNil(decodedRadix.UnmarshalBinary(data))
+	checkRadix(t, &decodedRadix)
+	assert.Equal([]string{"tea", "team"}, slices.Collect(decodedRadix.Keys()))
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+// Package itrees implements generic search trees.
+package itrees
+
+import (
+	"iter"
+
+	"github.com/idichekop/gods/icompare"
+	"golang.org/x/exp/constraints"
+)
+
+// BST is an unbalanced binary search tree of distinct values.  Its operations run in
+// O(h), where h is the height of the tree: O(log n) for random insertions, but O(n) when
+// values are inserted in order.  Prefer a balanced tree, such as imaps.TreeMap, when the
+// insertion order is not random.  A BST is not safe for concurrent use.
+type BST[T any] struct {
+	root    *bstNode[T]
+	length  int
+	compare icompare.Comparator[T]
+}
+
+type bstNode[T any] struct {
+	value       T
+	left, right *bstNode[T]
+}
+
+// NewBST creates a BST ordered by the natural order of the values, holding the given
+// values.
+func NewBST[T constraints.Ordered](values ...T) *BST[T] {
+	t := NewBSTFunc(icompare.Natural[T]())
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return t
+}
+
+// NewBSTFunc creates an empty BST ordered by the given comparator.
+func NewBSTFunc[T any](compare icompare.Comparator[T]) *BST[T] {
+	return &BST[T]{compare: compare}
+}
+
+// Len returns the number of values.
+func (t *BST[T]) Len() int {
+	return t.length
+}
+
+// Contains reports whether value is in the tree.
+func (t *BST[T]) Contains(value T) bool {
+	return *t.find(value) != nil
+}
+
+// Insert adds value, and reports whether it was added.  A value equal to one already in
+// the tree is not added.
+func (t *BST[T]) Insert(value T) bool {
+	link := t.find(value)
+	if *link != nil {
+		return false
+	}
+	*link = &bstNode[T]{value: value}
+	t.length++
+	return true
+}
+
+// Delete removes value, and reports whether it was found.
+func (t *BST[T]) Delete(value T) bool {
+	link := t.find(value)
+	n := *link
+	if n == nil {
+		return false
+	}
+
+	switch {
+	case n.left == nil:
+		*link = n.right
+	case n.right == nil:
+		*link = n.left
+	default:
+		// Replace the value by its successor, the minimum of the right subtree, and
+		// unlink the successor instead.
+		succ := &n.right
+		for (*succ).left != nil {
+			succ = &(*succ).left
+		}
+		n.value = (*succ).value
+		*succ = (*succ).right
+	}
+	t.length--
+	return true
+}
+
+// Min returns the smallest value, or false if the tree is empty.
+func (t *BST[T]) Min() (T, bool) {
+	n := t.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n.get()
+}
+
+// Max returns the greatest value, or false if the tree is empty.
+func (t *BST[T]) Max() (T, bool) {
+	n := t.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n.get()
+}
+
+// Successor returns the smallest value strictly greater than value, or false if there is
+// none.  The value itself need not be in the tree.
+func (t *BST[T]) Successor(value T) (T, bool) {
+	var best *bstNode[T]
+	for n := t.root; n != nil; {
+		if t.compare(value, n.value) < 0 {
+			best, n = n, n.left
+		} else {
+			n = n.right
+		}
+	}
+	return best.get()
+}
+
+// Predecessor returns the greatest value strictly less than value, or false if there is
+// none.  The value itself need not be in the tree.
+func (t *BST[T]) Predecessor(value T) (T, bool) {
+	var best *bstNode[T]
+	for n := t.root; n != nil; {
+		if t.compare(value, n.value) > 0 {
+			best, n = n, n.right
+		} else {
+			n = n.left
+		}
+	}
+	return best.get()
+}
+
+// All returns an iterator over the values in ascending order.  It is InOrder.
+func (t *BST[T]) All() iter.Seq[T] {
+	return t.InOrder()
+}
+
+// Values returns an iterator over the values in ascending order.
+func (t *BST[T]) Values() iter.Seq[T] {
+	return t.All()
+}
+
+// InOrder returns an iterator over the values in ascending order.
+func (t *BST[T]) InOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*bstNode[T]
+		for n := t.root; n != nil || len(stack) > 0; {
+			for ; n != nil; n = n.left {
+				stack = append(stack, n)
+			}
+			n, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+			n = n.right
+		}
+	}
+}
+
+// PreOrder returns an iterator over the values, each node before its left then its right
+// subtree.  Inserting the values in this order into an empty tree rebuilds the same
+// shape.
+func (t *BST[T]) PreOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root == nil {
+			return
+		}
+		stack := []*bstNode[T]{t.root}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.value) {
+				return
+			}
+			if n.right != nil {
+				stack = append(stack, n.right)
+			}
+			if n.left != nil {
+				stack = append(stack, n.left)
+			}
+		}
+	}
+}
+
+// PostOrder returns an iterator over the values, each node after its left then its right
+// subtree.
+func (t *BST[T]) PostOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*bstNode[T]
+		var last *bstNode[T]
+		for n := t.root; n != nil || len(stack) > 0; {
+			if n != nil {
+				stack = append(stack, n)
+				n = n.left
+				continue
+			}
+			top := stack[len(stack)-1]
+			if top.right != nil && top.right != last {
+				n = top.right
+				continue
+			}
+			if !yield(top.value) {
+				return
+			}
+			last, stack = top, stack[:len(stack)-1]
+		}
+	}
+}
+
+// find returns the link that holds value, or the nil link where it would be inserted.
+func (t *BST[T]) find(value T) **bstNode[T] {
+	link := &t.root
+	for *link != nil {
+		c := t.compare(value, (*link).value)
+		switch {
+		case c < 0:
+			link = &(*link).left
+		case c > 0:
+			link = &(*link).right
+		default:
+			return link
+		}
+	}
+	return link
+}
+
+// get returns the value of n, or false if n is nil.
+func (n *bstNode[T]) get() (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.value, true
+}
@@ -0,0 +1,137 @@
+package itrees
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestBST(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBST")
+
+	tree := NewBST[int]()
+	_, ok := tree.Min()
+	assert.ShouldBeFalse(ok)
+	_, ok = tree.Max()
+	assert.ShouldBeFalse(ok)
+	assert.ShouldBeFalse(tree.Delete(1))
+	assert.Equal([]int(nil), slices.Collect(tree.PreOrder()))
+
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		assert.ShouldBeTrue(tree.Insert(v))
+	}
+	assert.ShouldBeFalse(tree.Insert(40))
+	assert.Equal(7, tree.Len())
+	assert.ShouldBeTrue(tree.Contains(60))
+	assert.ShouldBeFalse(tree.Contains(65))
+
+	assert.Equal([]int{20, 30, 40, 50, 60, 70, 80}, slices.Collect(tree.InOrder()))
+	assert.Equal([]int{50, 30, 20, 40, 70, 60, 80}, slices.Collect(tree.PreOrder()))
+	assert.Equal([]int{20, 40, 30, 60, 80, 70, 50}, slices.Collect(tree.PostOrder()))
+
+	min, _ := tree.Min()
+	max, _ := tree.Max()
+	assert.Equal(20, min)
+	assert.Equal(80, max)
+
+	v, ok := tree.Successor(50)
+	assert.Equal(60, v)
+	assert.ShouldBeTrue(ok)
+	v, _ = tree.Successor(45)
+	assert.Equal(50, v)
+	_, ok = tree.Successor(80)
+	assert.ShouldBeFalse(ok)
+
+	v, ok = tree.Predecessor(50)
+	assert.Equal(40, v)
+	assert.ShouldBeTrue(ok)
+	v, _ = tree.Predecessor(55)
+	assert.Equal(50, v)
+	_, ok = tree.Predecessor(20)
+	assert.ShouldBeFalse(ok)
+
+	// root with two children, then a leaf, then a node with one child
+	assert.ShouldBeTrue(tree.Delete(50))
+	assert.ShouldBeTrue(tree.Delete(20))
+	assert.ShouldBeTrue(tree.Delete(30))
+	assert.ShouldBeFalse(tree.Delete(30))
+	assert.Equal(4, tree.Len())
+	assert.Equal([]int{40, 60, 70, 80}, slices.Collect(tree.InOrder()))
+	assert.Equal([]int{60, 40, 70, 80}, slices.Collect(tree.PreOrder()))
+}
+
+func TestBSTFunc(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBSTFunc")
+
+	tree := NewBSTFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	tree.Insert("b")
+	tree.Insert("A")
+	assert.ShouldBeFalse(tree.Insert("B"))
+
+	assert.Equal([]string{"A", "b"}, slices.Collect(tree.InOrder()))
+	assert.ShouldBeTrue(tree.Contains("a"))
+}
+
+func TestBSTIteratorsStopEarly(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBSTIteratorsStopEarly")
+
+	tree := NewBST(4, 2, 6, 1, 3, 5, 7)
+	for _, seq := range []func(func(int) bool){tree.InOrder(), tree.PreOrder(), tree.PostOrder()} {
+		count := 0
+		for range seq {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		assert.Equal(3, count)
+	}
+}
+
+func TestBSTRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBSTRandomized")
+
+	rng := rand.New(rand.NewSource(3))
+	tree := NewBST[int]()
+	reference := map[int]bool{}
+
+	for range 3000 {
+		v := rng.Intn(300)
+		if rng.Intn(3) == 0 {
+			assert.Equal(reference[v], tree.Delete(v))
+			delete(reference, v)
+		} else {
+			assert.Equal(!reference[v], tree.Insert(v))
+			reference[v] = true
+		}
+	}
+
+	values := slices.Collect(tree.InOrder())
+	assert.Equal(len(reference), tree.Len())
+	assert.Equal(len(reference), len(values))
+	assert.ShouldBeTrue(slices.IsSorted(values))
+
+	// pre-order rebuilds the same tree
+	rebuilt := NewBST(slices.Collect(tree.PreOrder())...)
+	assert.Equal(slices.Collect(tree.PostOrder()), slices.Collect(rebuilt.PostOrder()))
+
+	for i := 1; i < len(values); i++ {
+		succ, _ := tree.Successor(values[i-1])
+		pred, _ := tree.Predecessor(values[i])
+		assert.Equal(values[i], succ)
+		assert.Equal(values[i-1], pred)
+	}
+}
@@ -0,0 +1,465 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/icontainer"
+	"github.com/idichekop/gods/ituples"
+	"golang.org/x/exp/constraints"
+)
+
+// BTree is an ordered map kept in a B-tree.  Every node but the root holds between
+// degree-1 and 2*degree-1 keys in a contiguous slice, so a lookup touches far fewer
+// nodes than in a binary tree, which pays off in cache behavior for large maps.  Get,
+// Put and Delete run in O(degree * log n / log degree).  A BTree is not safe for
+// concurrent use.
+type BTree[K any, V any] struct {
+	root    *bNode[K, V]
+	degree  int
+	length  int
+	compare icompare.Comparator[K]
+}
+
+type bNode[K any, V any] struct {
+	keys   []K
+	values []V
+	// children is nil for leaves, and holds len(keys)+1 nodes otherwise.
+	children []*bNode[K, V]
+}
+
+// NewBTree creates an empty BTree of the given degree, ordered by the natural order of
+// the keys.  It panics if degree is less than 2.
+func NewBTree[K constraints.Ordered, V any](degree int) *BTree[K, V] {
+	return NewBTreeFunc[K, V](degree, icompare.Natural[K]())
+}
+
+// NewBTreeFunc creates an empty BTree of the given degree, ordered by the given
+// comparator.  It panics if degree is less than 2.
+func NewBTreeFunc[K any, V any](degree int, compare icompare.Comparator[K]) *BTree[K, V] {
+	if degree < 2 {
+		panic("NewBTree: degree must be at least 2")
+	}
+	return &BTree[K, V]{degree: degree, compare: compare}
+}
+
+// NewBTreeFromSorted creates a BTree of the given degree holding the entries, which must
+// be sorted by strictly ascending keys.  The tree is built bottom-up in O(n), with nodes
+// filled close to capacity; this is much faster than n calls to Put.  It panics if
+// degree is less than 2, and returns an error if the keys are not strictly ascending.
+func NewBTreeFromSorted[K constraints.Ordered, V any](degree int, entries []ituples.Pair[K, V]) (*BTree[K, V], error) {
+	t := NewBTree[K, V](degree)
+	for i := 1; i < len(entries); i++ {
+		if entries[i-1].First >= entries[i].First {
+			return nil, fmt.Errorf("NewBTreeFromSorted: keys not strictly ascending at index %d", i)
+		}
+	}
+	if len(entries) == 0 {
+		return t, nil
+	}
+
+	height := 0
+	for capacity := 2*degree - 1; capacity < len(entries); height++ {
+		capacity = capacity*2*degree + 2*degree - 1
+	}
+	t.root = t.build(entries, height, true)
+	t.length = len(entries)
+	return t, nil
+}
+
+// Degree returns the degree of the tree.
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Len returns the number of keys.
+func (t *BTree[K, V]) Len() int {
+	return t.length
+}
+
+// Get returns the value of key, and whether it was found.
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return n.values[i], true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is in the tree.
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put sets the value of key.
+func (t *BTree[K, V]) Put(key K, value V) {
+	if t.root == nil {
+		t.root = &bNode[K, V]{keys: []K{key}, values: []V{value}}
+		t.length++
+		return
+	}
+	if t.full(t.root) {
+		root := &bNode[K, V]{children: []*bNode[K, V]{t.root}}
+		t.splitChild(root, 0)
+		t.root = root
+	}
+
+	n := t.root
+	for {
+		i, found := t.search(n, key)
+		if found {
+			n.values[i] = value
+			return
+		}
+		if n.leaf() {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			t.length++
+			return
+		}
+		if t.full(n.children[i]) {
+			t.splitChild(n, i)
+			c := t.compare(key, n.keys[i])
+			if c == 0 {
+				n.values[i] = value
+				return
+			}
+			if c > 0 {
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// Delete removes key, and reports whether it was found.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+	found := t.delete(t.root, key)
+	// The merges on the way down may empty the root, even when key is absent.
+	if len(t.root.keys) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if found {
+		t.length--
+	}
+	return found
+}
+
+// Min returns the smallest key and its value, or false if the tree is empty.
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return (*bNode[K, V])(nil).entry(0)
+	}
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.entry(0)
+}
+
+// Max returns the greatest key and its value, or false if the tree is empty.
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return (*bNode[K, V])(nil).entry(0)
+	}
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.entry(len(n.keys) - 1)
+}
+
+// Ascend calls fn for each key and value in ascending order of keys, until fn returns
+// false.
+func (t *BTree[K, V]) Ascend(fn func(key K, value V) bool) {
+	if t.root != nil {
+		t.ascend(t.root, fn)
+	}
+}
+
+// Descend calls fn for each key and value in descending order of keys, until fn returns
+// false.
+func (t *BTree[K, V]) Descend(fn func(key K, value V) bool) {
+	if t.root != nil {
+		t.descend(t.root, fn)
+	}
+}
+
+// All returns an iterator over the keys and values, in ascending order of keys.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return t.Ascend
+}
+
+// Keys returns an iterator over the keys, in ascending order.
+func (t *BTree[K, V]) Keys() iter.Seq[K] {
+	return icontainer.Keys(t.All())
+}
+
+// Values returns an iterator over the values, in ascending order of keys.
+func (t *BTree[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(t.All())
+}
+
+// Range returns an iterator over the keys in [from, to) and their values, in ascending
+// order.  Subtrees entirely outside the range are skipped.
+func (t *BTree[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.walkRange(t.root, from, to, yield)
+		}
+	}
+}
+
+// build returns a subtree of the given height holding the entries.  Nodes get as few
+// children as possible while still keeping every child within capacity, which fills
+// them close to full.
+func (t *BTree[K, V]) build(entries []ituples.Pair[K, V], height int, root bool) *bNode[K, V] {
+	n := &bNode[K, V]{}
+	if height == 0 {
+		for _, e := range entries {
+			n.keys = append(n.keys, e.First)
+			n.values = append(n.values, e.Second)
+		}
+		return n
+	}
+
+	childCapacity := 2*t.degree - 1
+	for range height - 1 {
+		childCapacity = childCapacity*2*t.degree + 2*t.degree - 1
+	}
+	minChildren := t.degree
+	if root {
+		minChildren = 2
+	}
+	children := minChildren
+	for len(entries)/children > childCapacity {
+		children++
+	}
+
+	// Spread the keys left after removing the separators evenly over the children.
+	rest := len(entries) - (children - 1)
+	start := 0
+	for c := range children {
+		size := rest / children
+		if c < rest%children {
+			size++
+		}
+		n.children = append(n.children, t.build(entries[start:start+size], height-1, false))
+		start += size
+		if c < children-1 {
+			n.keys = append(n.keys, entries[start].First)
+			n.values = append(n.values, entries[start].Second)
+			start++
+		}
+	}
+	return n
+}
+
+// delete removes key from the subtree of n, which holds at least degree keys unless it
+// is the root, and reports whether it was found.
+func (t *BTree[K, V]) delete(n *bNode[K, V], key K) bool {
+	for {
+		i, found := t.search(n, key)
+		if n.leaf() {
+			if found {
+				n.removeAt(i)
+			}
+			return found
+		}
+
+		if found {
+			left, right := n.children[i], n.children[i+1]
+			switch {
+			case len(left.keys) >= t.degree:
+				// Replace by the predecessor, then delete the predecessor.
+				m := left
+				for !m.leaf() {
+					m = m.children[len(m.children)-1]
+				}
+				last := len(m.keys) - 1
+				n.keys[i], n.values[i] = m.keys[last], m.values[last]
+				key, n = m.keys[last], left
+			case len(right.keys) >= t.degree:
+				// Replace by the successor, then delete the successor.
+				m := right
+				for !m.leaf() {
+					m = m.children[0]
+				}
+				n.keys[i], n.values[i] = m.keys[0], m.values[0]
+				key, n = m.keys[0], right
+			default:
+				t.merge(n, i)
+				n = left
+			}
+			continue
+		}
+
+		if len(n.children[i].keys) < t.degree {
+			i = t.fill(n, i)
+		}
+		n = n.children[i]
+	}
+}
+
+// fill gives the child i of n at least degree keys, borrowing from a sibling or merging
+// with it, and returns the index of the child that now covers the same keys.
+func (t *BTree[K, V]) fill(n *bNode[K, V], i int) int {
+	child := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= t.degree:
+		left := n.children[i-1]
+		last := len(left.keys) - 1
+		child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+		child.values = slices.Insert(child.values, 0, n.values[i-1])
+		n.keys[i-1], n.values[i-1] = left.keys[last], left.values[last]
+		if !left.leaf() {
+			child.children = slices.Insert(child.children, 0, left.children[last+1])
+			left.children[last+1] = nil
+			left.children = left.children[:last+1]
+		}
+		left.removeAt(last)
+		return i
+	case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+		right := n.children[i+1]
+		child.keys = append(child.keys, n.keys[i])
+		child.values = append(child.values, n.values[i])
+		n.keys[i], n.values[i] = right.keys[0], right.values[0]
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+		right.removeAt(0)
+		return i
+	case i < len(n.keys):
+		t.merge(n, i)
+		return i
+	default:
+		t.merge(n, i-1)
+		return i - 1
+	}
+}
+
+// merge moves the key i of n and all of its child i+1 into its child i.
+func (t *BTree[K, V]) merge(n *bNode[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+	left.values = append(append(left.values, n.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+	n.removeAt(i)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// splitChild splits the full child i of n around its median key, which moves up into n.
+func (t *BTree[K, V]) splitChild(n *bNode[K, V], i int) {
+	child := n.children[i]
+	mid := t.degree - 1
+	sibling := &bNode[K, V]{
+		keys:   slices.Clone(child.keys[mid+1:]),
+		values: slices.Clone(child.values[mid+1:]),
+	}
+	if !child.leaf() {
+		sibling.children = slices.Clone(child.children[mid+1:])
+		clear(child.children[mid+1:])
+		child.children = child.children[:mid+1]
+	}
+
+	n.keys = slices.Insert(n.keys, i, child.keys[mid])
+	n.values = slices.Insert(n.values, i, child.values[mid])
+	n.children = slices.Insert(n.children, i+1, sibling)
+
+	clear(child.keys[mid:])
+	clear(child.values[mid:])
+	child.keys, child.values = child.keys[:mid], child.values[:mid]
+}
+
+func (t *BTree[K, V]) ascend(n *bNode[K, V], fn func(K, V) bool) bool {
+	for i := range n.keys {
+		if !n.leaf() && !t.ascend(n.children[i], fn) {
+			return false
+		}
+		if !fn(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+	return n.leaf() || t.ascend(n.children[len(n.keys)], fn)
+}
+
+func (t *BTree[K, V]) descend(n *bNode[K, V], fn func(K, V) bool) bool {
+	for i := len(n.keys); i > 0; i-- {
+		if !n.leaf() && !t.descend(n.children[i], fn) {
+			return false
+		}
+		if !fn(n.keys[i-1], n.values[i-1]) {
+			return false
+		}
+	}
+	return n.leaf() || t.descend(n.children[0], fn)
+}
+
+// walkRange yields the entries of the subtree of n in [from, to), and returns false when
+// the walk is over, either because yield asked to stop or because it reached to.
+func (t *BTree[K, V]) walkRange(n *bNode[K, V], from, to K, yield func(K, V) bool) bool {
+	i, _ := t.search(n, from)
+	for ; ; i++ {
+		if !n.leaf() && !t.walkRange(n.children[i], from, to, yield) {
+			return false
+		}
+		if i == len(n.keys) {
+			return true
+		}
+		if t.compare(n.keys[i], to) >= 0 || !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+}
+
+// search returns the position of the first key of n not less than key, and whether it
+// is equal to key.
+func (t *BTree[K, V]) search(n *bNode[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.keys, key, t.compare)
+}
+
+func (t *BTree[K, V]) full(n *bNode[K, V]) bool {
+	return len(n.keys) == 2*t.degree-1
+}
+
+func (n *bNode[K, V]) leaf() bool {
+	return n.children == nil
+}
+
+// removeAt removes the key i of n and its value.
+func (n *bNode[K, V]) removeAt(i int) {
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.values = slices.Delete(n.values, i, i+1)
+}
+
+// entry returns the key i of n and its value, or false if n is nil.
+func (n *bNode[K, V]) entry(i int) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.keys[i], n.values[i], true
+}
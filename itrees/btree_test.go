@@ -0,0 +1,255 @@
+package itrees
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+	"github.com/idichekop/gods/ituples"
+)
+
+// checkBTree verifies the invariants of a B-tree: node sizes, key order, and that every
+// leaf lies at the same depth.
+func checkBTree[K any, V any](t *testing.T, tree *BTree[K, V]) {
+	t.Helper()
+
+	leafDepth := -1
+	count := 0
+	var check func(n *bNode[K, V], depth int, root bool)
+	check = func(n *bNode[K, V], depth int, root bool) {
+		count += len(n.keys)
+		if len(n.keys) != len(n.values) {
+			t.Fatalf("node with %d keys and %d values", len(n.keys), len(n.values))
+		}
+		if len(n.keys) == 0 || len(n.keys) > 2*tree.degree-1 || !root && len(n.keys) < tree.degree-1 {
+			t.Fatalf("node with %d keys in a tree of degree %d", len(n.keys), tree.degree)
+		}
+		for i := 1; i < len(n.keys); i++ {
+			if tree.compare(n.keys[i-1], n.keys[i]) >= 0 {
+				t.Fatalf("unordered keys at %v", n.keys[i])
+			}
+		}
+		if n.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				t.Fatalf("leaves at depths %d and %d", leafDepth, depth)
+			}
+			return
+		}
+		if len(n.children) != len(n.keys)+1 {
+			t.Fatalf("node with %d keys and %d children", len(n.keys), len(n.children))
+		}
+		for i, child := range n.children {
+			if i > 0 && tree.compare(child.keys[0], n.keys[i-1]) <= 0 ||
+				i < len(n.keys) && tree.compare(child.keys[len(child.keys)-1], n.keys[i]) >= 0 {
+				t.Fatalf("child %d out of the bounds of its separators", i)
+			}
+			check(child, depth+1, false)
+		}
+	}
+
+	if tree.root != nil {
+		check(tree.root, 0, true)
+	}
+	if count != tree.Len() {
+		t.Fatalf("length is %d, but the tree holds %d keys", tree.Len(), count)
+	}
+}
+
+func TestBTree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBTree")
+
+	tree := NewBTree[int, string](2)
+	_, _, ok := tree.Min()
+	assert.ShouldBeFalse(ok)
+	_, _, ok = tree.Max()
+	assert.ShouldBeFalse(ok)
+	assert.ShouldBeFalse(tree.Delete(1))
+	assert.Equal(2, tree.Degree())
+
+	for i := range 20 {
+		tree.Put(i*10, "v")
+	}
+	tree.Put(50, "fifty")
+	checkBTree(t, tree)
+
+	assert.Equal(20, tree.Len())
+	v, ok := tree.Get(50)
+	assert.Equal("fifty", v)
+	assert.ShouldBeTrue(ok)
+	assert.ShouldBeFalse(tree.Contains(55))
+
+	k, _, _ := tree.Min()
+	assert.Equal(0, k)
+	k, _, _ = tree.Max()
+	assert.Equal(190, k)
+
+	var keys []int
+	for k := range tree.Range(35, 80) {
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{40, 50, 60, 70}, keys)
+
+	keys = nil
+	tree.Descend(func(k int, _ string) bool {
+		keys = append(keys, k)
+		return k > 160
+	})
+	assert.Equal([]int{190, 180, 170, 160}, keys)
+
+	keys = nil
+	tree.Ascend(func(k int, _ string) bool {
+		keys = append(keys, k)
+		return k < 20
+	})
+	assert.Equal([]int{0, 10, 20}, keys)
+
+	for i := range 20 {
+		assert.ShouldBeTrue(tree.Delete(i * 10))
+		checkBTree(t, tree)
+	}
+	assert.Equal(0, tree.Len())
+	_, _, ok = tree.Min()
+	assert.ShouldBeFalse(ok)
+}
+
+func TestBTreeFromSorted(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBTreeFromSorted")
+
+	for _, degree := range []int{2, 3, 5, 16} {
+		for _, n := range []int{0, 1, 2, 3, 7, 8, 31, 100, 1000} {
+			entries := make([]ituples.Pair[int, int], n)
+			for i := range entries {
+				entries[i] = ituples.NewPair(i*2, i)
+			}
+
+			tree, err := NewBTreeFromSorted(degree, entries)
+			assert.IsNil(err)
+			checkBTree(t, tree)
+
+			var got []ituples.Pair[int, int]
+			for k, v := range tree.All() {
+				got = append(got, ituples.NewPair(k, v))
+			}
+			assert.Equal(n, len(got))
+			if n > 0 {
+				assert.Equal(entries, got)
+			}
+
+			// the tree stays valid under updates
+			tree.Put(3, 3)
+			tree.Delete(0)
+			checkBTree(t, tree)
+		}
+	}
+
+	_, err := NewBTreeFromSorted(2, []ituples.Pair[int, int]{{First: 1}, {First: 1}})
+	assert.IsNotNil(err)
+}
+
+func TestBTreePanicsOnSmallDegree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBTreePanicsOnSmallDegree")
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	NewBTree[int, int](1)
+}
+
+func TestBTreeRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBTreeRandomized")
+
+	for _, degree := range []int{2, 3, 8} {
+		rng := rand.New(rand.NewSource(int64(degree)))
+		tree := NewBTree[int, int](degree)
+		reference := NewRBTree[int, int]()
+
+		for i := range 5000 {
+			k := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				assert.Equal(reference.Delete(k), tree.Delete(k))
+			} else {
+				tree.Put(k, i)
+				reference.Put(k, i)
+			}
+			if i%250 == 0 {
+				checkBTree(t, tree)
+			}
+		}
+		checkBTree(t, tree)
+
+		var got, want [][2]int
+		for k, v := range tree.All() {
+			got = append(got, [2]int{k, v})
+		}
+		for k, v := range reference.All() {
+			want = append(want, [2]int{k, v})
+		}
+		assert.Equal(want, got)
+
+		got, want = nil, nil
+		for k, v := range tree.Range(100, 200) {
+			got = append(got, [2]int{k, v})
+		}
+		for k, v := range reference.Range(100, 200) {
+			want = append(want, [2]int{k, v})
+		}
+		assert.Equal(want, got)
+	}
+}
+
+func TestBTreeDeleteAbsent(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBTreeDeleteAbsent")
+
+	tree := NewBTree[int, int](2)
+	for k := 1; k <= 4; k++ {
+		tree.Put(k, k)
+	}
+	assert.ShouldBeTrue(tree.Delete(4))
+	assert.ShouldBeFalse(tree.Delete(99))
+	checkBTree(t, tree)
+	assert.ShouldBeTrue(tree.Delete(1))
+	checkBTree(t, tree)
+
+	// A small key space shrinks the root often, and many deletes miss.
+	rng := rand.New(rand.NewSource(1))
+	tree = NewBTree[int, int](2)
+	reference := map[int]int{}
+	for i := range 5000 {
+		k := rng.Intn(60)
+		if rng.Intn(2) == 0 {
+			_, ok := reference[k]
+			delete(reference, k)
+			assert.Equal(ok, tree.Delete(k))
+		} else {
+			tree.Put(k, i)
+			reference[k] = i
+		}
+		checkBTree(t, tree)
+	}
+	assert.Equal(len(reference), tree.Len())
+}
+
+func BenchmarkBTreeGet(b *testing.B) {
+	entries := make([]ituples.Pair[int, int], 1<<20)
+	for i := range entries {
+		entries[i] = ituples.NewPair(i, i)
+	}
+	tree, _ := NewBTreeFromSorted(32, entries)
+
+	b.ResetTimer()
+	for i := range b.N {
+		tree.Get(i & (1<<20 - 1))
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"fmt"
+
+	islice "github.com/idichekop/gods/islices"
+)
+
+// Fenwick is a binary indexed tree over a fixed-length sequence of numbers, which
+// supports adding to a value and computing prefix sums, both in O(log n).  It is lighter
+// than a SegmentTree, but limited to sums.  A Fenwick is not safe for concurrent use.
+type Fenwick[T islice.Number] struct {
+	// tree is 1-based: tree[i] holds the sum of the values in (i - lowbit(i), i].
+	tree []T
+}
+
+// NewFenwick creates a Fenwick of n zeros.  It panics if n is negative.
+func NewFenwick[T islice.Number](n int) *Fenwick[T] {
+	if n < 0 {
+		panic("NewFenwick: negative length")
+	}
+	return &Fenwick[T]{tree: make([]T, n+1)}
+}
+
+// FenwickFrom creates a Fenwick over a copy of values in O(n).
+func FenwickFrom[T islice.Number](values []T) *Fenwick[T] {
+	f := &Fenwick[T]{tree: make([]T, len(values)+1)}
+	copy(f.tree[1:], values)
+	for i := 1; i < len(f.tree); i++ {
+		if parent := i + i&-i; parent < len(f.tree) {
+			f.tree[parent] += f.tree[i]
+		}
+	}
+	return f
+}
+
+// Len returns the number of values.
+func (f *Fenwick[T]) Len() int {
+	return len(f.tree) - 1
+}
+
+// Add adds delta to the value at index.  It panics if index is out of range.
+func (f *Fenwick[T]) Add(index int, delta T) {
+	f.checkIndex(index)
+	for i := index + 1; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+}
+
+// Set sets the value at index.  It panics if index is out of range.
+func (f *Fenwick[T]) Set(index int, value T) {
+	f.Add(index, value-f.Get(index))
+}
+
+// Get returns the value at index.  It panics if index is out of range.
+func (f *Fenwick[T]) Get(index int) T {
+	f.checkIndex(index)
+	return f.RangeSum(index, index+1)
+}
+
+// PrefixSum returns the sum of the values in [0, n).  It panics if n is out of range.
+func (f *Fenwick[T]) PrefixSum(n int) T {
+	if n < 0 || n > f.Len() {
+		panic(fmt.Sprintf("Fenwick.PrefixSum: length %d out of bounds [0, %d]", n, f.Len()))
+	}
+	var sum T
+	for i := n; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the values in [from, to).  It panics if the range is out
+// of bounds.
+func (f *Fenwick[T]) RangeSum(from, to int) T {
+	if from < 0 || to > f.Len() || from > to {
+		panic(fmt.Sprintf("Fenwick.RangeSum: range [%d, %d) out of bounds [0, %d)", from, to, f.Len()))
+	}
+	return f.PrefixSum(to) - f.PrefixSum(from)
+}
+
+func (f *Fenwick[T]) checkIndex(index int) {
+	if index < 0 || index >= f.Len() {
+		panic(fmt.Sprintf("Fenwick: index %d out of bounds [0, %d)", index, f.Len()))
+	}
+}
+
+// RangeFenwick is the range-update variant of Fenwick: it adds a delta to every value of
+// a range, and computes range sums, both in O(log n).  It keeps two Fenwick trees,
+// from which the prefix sum of [0, i) is i*b1(i) - b2(i).  A RangeFenwick is not safe
+// for concurrent use.
+type RangeFenwick[T islice.Number] struct {
+	b1, b2 *Fenwick[T]
+}
+
+// NewRangeFenwick creates a RangeFenwick of n zeros.  It panics if n is negative.
+func NewRangeFenwick[T islice.Number](n int) *RangeFenwick[T] {
+	// One extra slot lets AddRange update the position right after the range
+	// unconditionally.
+	return &RangeFenwick[T]{b1: NewFenwick[T](n + 1), b2: NewFenwick[T](n + 1)}
+}
+
+// Len returns the number of values.
+func (f *RangeFenwick[T]) Len() int {
+	return f.b1.Len() - 1
+}
+
+// AddRange adds delta to every value in [from, to).  It panics if the range is out of
+// bounds.
+func (f *RangeFenwick[T]) AddRange(from, to int, delta T) {
+	if from < 0 || to > f.Len() || from > to {
+		panic(fmt.Sprintf("RangeFenwick.AddRange: range [%d, %d) out of bounds [0, %d)", from, to, f.Len()))
+	}
+	f.b1.Add(from, delta)
+	f.b1.Add(to, -delta)
+	f.b2.Add(from, delta*T(from))
+	f.b2.Add(to, -delta*T(to))
+}
+
+// Get returns the value at index.  It panics if index is out of range.
+func (f *RangeFenwick[T]) Get(index int) T {
+	if index < 0 || index >= f.Len() {
+		panic(fmt.Sprintf("RangeFenwick: index %d out of bounds [0, %d)", index, f.Len()))
+	}
+	return f.b1.PrefixSum(index + 1)
+}
+
+// RangeSum returns the sum of the values in [from, to).  It panics if the range is out
+// of bounds.
+func (f *RangeFenwick[T]) RangeSum(from, to int) T {
+	if from < 0 || to > f.Len() || from > to {
+		panic(fmt.Sprintf("RangeFenwick.RangeSum: range [%d, %d) out of bounds [0, %d)", from, to, f.Len()))
+	}
+	return f.prefixSum(to) - f.prefixSum(from)
+}
+
+// prefixSum returns the sum of the values in [0, n).
+func (f *RangeFenwick[T]) prefixSum(n int) T {
+	return T(n)*f.b1.PrefixSum(n) - f.b2.PrefixSum(n)
+}
@@ -0,0 +1,114 @@
+package itrees
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestFenwick(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFenwick")
+
+	f := FenwickFrom([]int{5, 3, 8, 1, 9, 2})
+	assert.Equal(6, f.Len())
+	assert.Equal(0, f.PrefixSum(0))
+	assert.Equal(16, f.PrefixSum(3))
+	assert.Equal(28, f.PrefixSum(6))
+	assert.Equal(21, f.RangeSum(1, 5))
+	assert.Equal(8, f.Get(2))
+
+	f.Add(3, 4)
+	assert.Equal(5, f.Get(3))
+	f.Set(0, 1)
+	assert.Equal(1, f.Get(0))
+	assert.Equal(28, f.PrefixSum(6))
+
+	g := NewFenwick[float64](3)
+	g.Add(1, 0.5)
+	assert.Equal(0.5, g.RangeSum(0, 3))
+
+	for _, fn := range []func(){
+		func() { f.Add(6, 1) },
+		func() { f.PrefixSum(7) },
+		func() { f.RangeSum(3, 2) },
+		func() { NewFenwick[int](-1) },
+	} {
+		func() {
+			defer func() {
+				assert.IsNotNil(recover())
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestRangeFenwick(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRangeFenwick")
+
+	f := NewRangeFenwick[int](5)
+	assert.Equal(5, f.Len())
+	f.AddRange(1, 4, 2)
+	f.AddRange(0, 5, 1)
+	f.AddRange(3, 5, 10)
+
+	// values are now [1, 3, 3, 13, 11]
+	assert.Equal(1, f.Get(0))
+	assert.Equal(13, f.Get(3))
+	assert.Equal(31, f.RangeSum(0, 5))
+	assert.Equal(19, f.RangeSum(1, 4))
+	assert.Equal(0, f.RangeSum(2, 2))
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	f.AddRange(0, 6, 1)
+}
+
+func TestFenwickRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestFenwickRandomized")
+
+	rng := rand.New(rand.NewSource(2))
+	values := make([]int64, 64)
+	points := FenwickFrom(values)
+	ranges := NewRangeFenwick[int64](len(values))
+
+	sum := func(from, to int) int64 {
+		var s int64
+		for _, v := range values[from:to] {
+			s += v
+		}
+		return s
+	}
+
+	for range 2000 {
+		from := rng.Intn(len(values))
+		to := from + rng.Intn(len(values)-from+1)
+		delta := int64(rng.Intn(21) - 10)
+
+		if rng.Intn(2) == 0 {
+			values[from] += delta
+			points.Add(from, delta)
+			ranges.AddRange(from, from+1, delta)
+		} else {
+			for i := from; i < to; i++ {
+				values[i] += delta
+			}
+			for i := from; i < to; i++ {
+				points.Add(i, delta)
+			}
+			ranges.AddRange(from, to, delta)
+		}
+
+		from = rng.Intn(len(values))
+		to = from + rng.Intn(len(values)-from+1)
+		assert.Equal(sum(from, to), points.RangeSum(from, to))
+		assert.Equal(sum(from, to), ranges.RangeSum(from, to))
+	}
+}
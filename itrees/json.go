@@ -0,0 +1,93 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"errors"
+
+	"github.com/idichekop/gods/internal/ijson"
+)
+
+// MarshalJSON encodes the tree as a JSON array of its values in pre-order, so that
+// UnmarshalJSON rebuilds a tree of the same shape.  A BST is not balanced: the sorted
+// order would degrade it into a list.
+func (t *BST[T]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalArray(t.PreOrder())
+}
+
+// UnmarshalJSON replaces the contents of the tree with the values of a JSON array,
+// inserted in order.  The tree keeps its comparator, so it must have been created by
+// NewBST or NewBSTFunc.
+func (t *BST[T]) UnmarshalJSON(data []byte) error {
+	if t.compare == nil {
+		return errors.New("BST.UnmarshalJSON: tree has no comparator")
+	}
+	values, err := ijson.UnmarshalArray[T](data)
+	if err != nil || values == nil {
+		return err
+	}
+	t.root, t.length = nil, 0
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the tree as a JSON object whose members are in ascending order of
+// keys.  Keys are encoded as encoding/json encodes map keys: they must be strings,
+// integers or implement encoding.TextMarshaler.
+func (t *RBTree[K, V]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalObject(t.All())
+}
+
+// UnmarshalJSON replaces the contents of the tree with the members of a JSON object.  The
+// tree keeps its comparator, so it must have been created by NewRBTree or NewRBTreeFunc.
+func (t *RBTree[K, V]) UnmarshalJSON(data []byte) error {
+	if t.compare == nil {
+		return errors.New("RBTree.UnmarshalJSON: tree has no comparator")
+	}
+	if ijson.IsNull(data) {
+		return nil
+	}
+	t.root = nil
+	return ijson.UnmarshalObject(data, t.Put)
+}
+
+// MarshalJSON encodes the tree as a JSON object whose members are in ascending order of
+// keys.  Keys are encoded as encoding/json encodes map keys.
+func (t *BTree[K, V]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalObject(t.All())
+}
+
+// UnmarshalJSON replaces the contents of the tree with the members of a JSON object.  The
+// tree keeps its degree and comparator, so it must have been created by NewBTree,
+// NewBTreeFunc or NewBTreeFromSorted.
+func (t *BTree[K, V]) UnmarshalJSON(data []byte) error {
+	if t.compare == nil {
+		return errors.New("BTree.UnmarshalJSON: tree has no comparator")
+	}
+	if ijson.IsNull(data) {
+		return nil
+	}
+	t.root, t.length = nil, 0
+	return ijson.UnmarshalObject(data, t.Put)
+}
+
+// MarshalJSON encodes the tree as a JSON object whose members are in lexicographic order
+// of keys.
+func (t *RadixTree[V]) MarshalJSON() ([]byte, error) {
+	return ijson.MarshalObject(t.All())
+}
+
+// UnmarshalJSON replaces the contents of the tree with the members of a JSON object.
+func (t *RadixTree[V]) UnmarshalJSON(data []byte) error {
+	if ijson.IsNull(data) {
+		return nil
+	}
+	*t = RadixTree[V]{}
+	return ijson.UnmarshalObject(data, func(key string, value V) {
+		t.Insert(key, value)
+	})
+}
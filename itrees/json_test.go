@@ -0,0 +1,97 @@
+package itrees
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestBSTJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBSTJSON")
+
+	tree := NewBST(5, 2, 8, 1, 9)
+	data, err := json.Marshal(tree)
+	assert.IsNil(err)
+	assert.Equal("[5,2,1,8,9]", string(data))
+
+	decoded := NewBST(100)
+	assert.IsNil(json.Unmarshal(data, decoded))
+	assert.Equal([]int{5, 2, 1, 8, 9}, slices.Collect(decoded.PreOrder()))
+	assert.Equal(5, decoded.Len())
+
+	var zero BST[int]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
+
+func TestRBTreeJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRBTreeJSON")
+
+	tree := NewRBTree[int, string]()
+	for i := range 20 {
+		tree.Put(i, string(rune('a'+i)))
+	}
+	data, err := json.Marshal(tree)
+	assert.IsNil(err)
+
+	decoded := NewRBTree[int, string]()
+	decoded.Put(-1, "gone")
+	assert.IsNil(json.Unmarshal(data, decoded))
+	assert.IsNil(decoded.Validate())
+	assert.Equal(20, decoded.Len())
+	assert.ShouldBeFalse(decoded.Contains(-1))
+
+	again, _ := json.Marshal(decoded)
+	assert.Equal(string(data), string(again))
+
+	var zero RBTree[int, string]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
+
+func TestBTreeJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestBTreeJSON")
+
+	tree := NewBTree[string, int](2)
+	for i, k := range []string{"m", "c", "x", "a", "q"} {
+		tree.Put(k, i)
+	}
+	data, err := json.Marshal(tree)
+	assert.IsNil(err)
+	assert.Equal(`{"a":3,"c":1,"m":0,"q":4,"x":2}`, string(data))
+
+	decoded := NewBTree[string, int](3)
+	assert.IsNil(json.Unmarshal(data, decoded))
+	checkBTree(t, decoded)
+	assert.Equal(3, decoded.Degree())
+	assert.Equal([]string{"a", "c", "m", "q", "x"}, slices.Collect(decoded.Keys()))
+
+	var zero BTree[string, int]
+	assert.IsNotNil(json.Unmarshal(data, &zero))
+}
+
+func TestRadixTreeJSON(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRadixTreeJSON")
+
+	tree := NewRadixTree[int]()
+	tree.Insert("team", 2)
+	tree.Insert("tea", 1)
+	tree.Insert("apple", 0)
+	data, err := json.Marshal(tree)
+	assert.IsNil(err)
+	assert.Equal(`{"apple":0,"tea":1,"team":2}`, string(data))
+
+	var decoded RadixTree[int]
+	decoded.Insert("old", 9)
+	assert.IsNil(json.Unmarshal(data, &decoded))
+	checkRadix(t, &decoded)
+	assert.Equal([]string{"apple", "tea", "team"}, slices.Collect(decoded.Keys()))
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/iqueues"
+)
+
+// KDPoint is a point of a KDTree and the value attached to it.
+type KDPoint[V any] struct {
+	Coords []float64
+	Value  V
+}
+
+// KDTree is a k-dimensional tree of points, each carrying a value.  It answers
+// nearest-neighbor, k-nearest-neighbor and range queries, by Euclidean distance, in
+// O(log n) on average for well-spread points, instead of a scan over all points.
+// Points are not rebalanced on Insert: build the tree with NewKDTree when the points
+// are known upfront.  A KDTree is not safe for concurrent use.
+type KDTree[V any] struct {
+	root   *kdNode[V]
+	dims   int
+	length int
+}
+
+type kdNode[V any] struct {
+	point KDPoint[V]
+	// axis is the dimension on which the node splits its subtree.
+	axis        int
+	left, right *kdNode[V]
+}
+
+// kdCandidate is a point found by a nearest-neighbor search, and its squared distance to
+// the query.
+type kdCandidate[V any] struct {
+	node  *kdNode[V]
+	dist2 float64
+}
+
+// NewKDTree builds a balanced KDTree of the given number of dimensions from the points,
+// splitting each subtree at its median.  The coordinates are copied.  It panics if dims
+// is not positive, and returns an error if a point has the wrong number of coordinates.
+func NewKDTree[V any](dims int, points []KDPoint[V]) (*KDTree[V], error) {
+	if dims <= 0 {
+		panic("NewKDTree: dims must be positive")
+	}
+	nodes := make([]*kdNode[V], len(points))
+	for i, p := range points {
+		if len(p.Coords) != dims {
+			return nil, fmt.Errorf("NewKDTree: point %d has %d coordinates, want %d", i, len(p.Coords), dims)
+		}
+		nodes[i] = &kdNode[V]{point: KDPoint[V]{Coords: slices.Clone(p.Coords), Value: p.Value}}
+	}
+	return &KDTree[V]{root: buildKD(nodes, 0, dims), dims: dims, length: len(points)}, nil
+}
+
+// Dims returns the number of dimensions.
+func (t *KDTree[V]) Dims() int {
+	return t.dims
+}
+
+// Len returns the number of points.
+func (t *KDTree[V]) Len() int {
+	return t.length
+}
+
+// Insert adds a point with the given value.  The coordinates are copied.  It returns an
+// error if coords has the wrong number of coordinates.
+func (t *KDTree[V]) Insert(coords []float64, value V) error {
+	if len(coords) != t.dims {
+		return fmt.Errorf("KDTree.Insert: %d coordinates, want %d", len(coords), t.dims)
+	}
+	link, axis := &t.root, 0
+	for *link != nil {
+		n := *link
+		if coords[n.axis] < n.point.Coords[n.axis] {
+			link = &n.left
+		} else {
+			link = &n.right
+		}
+		axis = (n.axis + 1) % t.dims
+	}
+	*link = &kdNode[V]{point: KDPoint[V]{Coords: slices.Clone(coords), Value: value}, axis: axis}
+	t.length++
+	return nil
+}
+
+// All returns an iterator over the points, in no particular order.
+func (t *KDTree[V]) All() iter.Seq[KDPoint[V]] {
+	return func(yield func(KDPoint[V]) bool) {
+		var walk func(n *kdNode[V]) bool
+		walk = func(n *kdNode[V]) bool {
+			return n == nil || walk(n.left) && yield(n.point) && walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+// Values returns an iterator over the points, in no particular order.
+func (t *KDTree[V]) Values() iter.Seq[KDPoint[V]] {
+	return t.All()
+}
+
+// Nearest returns the point closest to query, and its distance, or false if the tree is
+// empty.  It panics if query has the wrong number of coordinates.
+func (t *KDTree[V]) Nearest(query []float64) (KDPoint[V], float64, bool) {
+	t.checkDims("Nearest", query)
+	best := kdCandidate[V]{dist2: math.Inf(1)}
+	t.nearest(t.root, query, &best)
+	if best.node == nil {
+		return KDPoint[V]{}, 0, false
+	}
+	return best.node.point, math.Sqrt(best.dist2), true
+}
+
+// KNearest returns the k points closest to query, from the closest to the farthest.
+// It returns fewer points when the tree holds fewer than k.  It panics if query has the
+// wrong number of coordinates.
+func (t *KDTree[V]) KNearest(query []float64, k int) []KDPoint[V] {
+	t.checkDims("KNearest", query)
+	if k <= 0 {
+		return []KDPoint[V]{}
+	}
+	// The farthest candidate sits on top of the heap, ready to be replaced.
+	farthest := iqueues.NewPriorityQueueFunc(icompare.Reversed(func(a, b kdCandidate[V]) int {
+		return cmp.Compare(a.dist2, b.dist2)
+	}))
+	t.kNearest(t.root, query, k, farthest)
+
+	result := make([]KDPoint[V], farthest.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		c, _ := farthest.Pop()
+		result[i] = c.node.point
+	}
+	return result
+}
+
+// InBox returns the points whose coordinates all lie within [lo[d], hi[d]], in no
+// particular order.  It panics if lo or hi has the wrong number of coordinates.
+func (t *KDTree[V]) InBox(lo, hi []float64) []KDPoint[V] {
+	t.checkDims("InBox", lo)
+	t.checkDims("InBox", hi)
+	result := []KDPoint[V]{}
+	var walk func(n *kdNode[V])
+	walk = func(n *kdNode[V]) {
+		if n == nil {
+			return
+		}
+		inside := true
+		for d, c := range n.point.Coords {
+			if c < lo[d] || c > hi[d] {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			result = append(result, n.point)
+		}
+		split := n.point.Coords[n.axis]
+		if lo[n.axis] < split {
+			walk(n.left)
+		}
+		if hi[n.axis] >= split {
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	return result
+}
+
+// InRadius returns the points within distance radius of center, in no particular
+// order.  It panics if center has the wrong number of coordinates.
+func (t *KDTree[V]) InRadius(center []float64, radius float64) []KDPoint[V] {
+	t.checkDims("InRadius", center)
+	result := []KDPoint[V]{}
+	r2 := radius * radius
+	var walk func(n *kdNode[V])
+	walk = func(n *kdNode[V]) {
+		if n == nil {
+			return
+		}
+		if dist2(center, n.point.Coords) <= r2 {
+			result = append(result, n.point)
+		}
+		diff := center[n.axis] - n.point.Coords[n.axis]
+		if diff < 0 || diff*diff <= r2 {
+			walk(n.left)
+		}
+		if diff >= 0 || diff*diff <= r2 {
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	return result
+}
+
+func (t *KDTree[V]) nearest(n *kdNode[V], query []float64, best *kdCandidate[V]) {
+	if n == nil {
+		return
+	}
+	if d := dist2(query, n.point.Coords); d < best.dist2 {
+		*best = kdCandidate[V]{node: n, dist2: d}
+	}
+	near, far, diff := n.sides(query)
+	t.nearest(near, query, best)
+	if diff*diff < best.dist2 {
+		t.nearest(far, query, best)
+	}
+}
+
+func (t *KDTree[V]) kNearest(n *kdNode[V], query []float64, k int, farthest *iqueues.PriorityQueue[kdCandidate[V]]) {
+	if n == nil {
+		return
+	}
+	d := dist2(query, n.point.Coords)
+	if farthest.Len() < k {
+		farthest.Push(kdCandidate[V]{node: n, dist2: d})
+	} else if top, _ := farthest.Peek(); d < top.dist2 {
+		farthest.Pop()
+		farthest.Push(kdCandidate[V]{node: n, dist2: d})
+	}
+
+	near, far, diff := n.sides(query)
+	t.kNearest(near, query, k, farthest)
+	if top, _ := farthest.Peek(); farthest.Len() < k || diff*diff < top.dist2 {
+		t.kNearest(far, query, k, farthest)
+	}
+}
+
+func (t *KDTree[V]) checkDims(method string, coords []float64) {
+	if len(coords) != t.dims {
+		panic(fmt.Sprintf("KDTree.%s: %d coordinates, want %d", method, len(coords), t.dims))
+	}
+}
+
+// sides returns the child of n on the side of query, the other child, and the signed
+// distance from query to the splitting plane of n.
+func (n *kdNode[V]) sides(query []float64) (near, far *kdNode[V], diff float64) {
+	diff = query[n.axis] - n.point.Coords[n.axis]
+	if diff < 0 {
+		return n.left, n.right, diff
+	}
+	return n.right, n.left, diff
+}
+
+// buildKD builds a balanced subtree from nodes, splitting on axis at the median.
+func buildKD[V any](nodes []*kdNode[V], axis, dims int) *kdNode[V] {
+	if len(nodes) == 0 {
+		return nil
+	}
+	slices.SortFunc(nodes, func(a, b *kdNode[V]) int {
+		return cmp.Compare(a.point.Coords[axis], b.point.Coords[axis])
+	})
+	// Points equal to the median on axis go right, as Insert sends them.
+	mid := len(nodes) / 2
+	for mid > 0 && nodes[mid-1].point.Coords[axis] == nodes[mid].point.Coords[axis] {
+		mid--
+	}
+
+	n := nodes[mid]
+	n.axis = axis
+	next := (axis + 1) % dims
+	n.left = buildKD(nodes[:mid], next, dims)
+	n.right = buildKD(nodes[mid+1:], next, dims)
+	return n
+}
+
+// dist2 returns the squared Euclidean distance between a and b.
+func dist2(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
@@ -0,0 +1,135 @@
+package itrees
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestKDTree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestKDTree")
+
+	tree, err := NewKDTree(2, []KDPoint[string]{
+		{Coords: []float64{2, 3}, Value: "a"},
+		{Coords: []float64{5, 4}, Value: "b"},
+		{Coords: []float64{9, 6}, Value: "c"},
+		{Coords: []float64{4, 7}, Value: "d"},
+		{Coords: []float64{8, 1}, Value: "e"},
+		{Coords: []float64{7, 2}, Value: "f"},
+	})
+	assert.IsNil(err)
+	assert.Equal(6, tree.Len())
+	assert.Equal(2, tree.Dims())
+
+	p, dist, ok := tree.Nearest([]float64{9, 2})
+	assert.Equal("e", p.Value)
+	assert.Equal(math.Sqrt(2), dist)
+	assert.ShouldBeTrue(ok)
+
+	values := func(points []KDPoint[string]) []string {
+		var result []string
+		for _, p := range points {
+			result = append(result, p.Value)
+		}
+		return result
+	}
+	assert.Equal([]string{"f", "e", "b"}, values(tree.KNearest([]float64{7.5, 1.5}, 3)))
+	assert.Equal(6, len(tree.KNearest([]float64{0, 0}, 10)))
+	assert.Equal(0, len(tree.KNearest([]float64{0, 0}, 0)))
+
+	inBox := values(tree.InBox([]float64{4, 1}, []float64{8, 4}))
+	slices.Sort(inBox)
+	assert.Equal([]string{"b", "e", "f"}, inBox)
+
+	inRadius := values(tree.InRadius([]float64{5, 5}, 2.5))
+	slices.Sort(inRadius)
+	assert.Equal([]string{"b", "d"}, inRadius)
+
+	assert.IsNil(tree.Insert([]float64{9, 2}, "g"))
+	p, dist, _ = tree.Nearest([]float64{9, 2})
+	assert.Equal("g", p.Value)
+	assert.Equal(0.0, dist)
+	assert.IsNotNil(tree.Insert([]float64{1}, "h"))
+	assert.Equal(7, tree.Len())
+}
+
+func TestKDTreeErrors(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestKDTreeErrors")
+
+	_, err := NewKDTree(3, []KDPoint[int]{{Coords: []float64{1, 2, 3}}, {Coords: []float64{1, 2}}})
+	assert.IsNotNil(err)
+
+	empty, err := NewKDTree[int](3, nil)
+	assert.IsNil(err)
+	_, _, ok := empty.Nearest([]float64{0, 0, 0})
+	assert.ShouldBeFalse(ok)
+	assert.Equal(0, len(empty.InRadius([]float64{0, 0, 0}, 1)))
+
+	defer func() {
+		assert.IsNotNil(recover())
+	}()
+	empty.Nearest([]float64{0, 0})
+}
+
+func TestKDTreeRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestKDTreeRandomized")
+
+	rng := rand.New(rand.NewSource(4))
+	randomPoint := func() []float64 {
+		// a coarse grid makes ties on the splitting planes likely
+		return []float64{float64(rng.Intn(20)), float64(rng.Intn(20)), float64(rng.Intn(20))}
+	}
+
+	points := make([]KDPoint[int], 300)
+	for i := range points {
+		points[i] = KDPoint[int]{Coords: randomPoint(), Value: i}
+	}
+	tree, _ := NewKDTree(3, points[:200])
+	for _, p := range points[200:] {
+		tree.Insert(p.Coords, p.Value)
+	}
+
+	for range 100 {
+		query := randomPoint()
+		byDistance := slices.Clone(points)
+		slices.SortStableFunc(byDistance, func(a, b KDPoint[int]) int {
+			return cmp.Compare(dist2(query, a.Coords), dist2(query, b.Coords))
+		})
+
+		_, dist, _ := tree.Nearest(query)
+		assert.Equal(math.Sqrt(dist2(query, byDistance[0].Coords)), dist)
+
+		nearest := tree.KNearest(query, 5)
+		for i, p := range nearest {
+			assert.Equal(dist2(query, byDistance[i].Coords), dist2(query, p.Coords))
+		}
+
+		want := 0
+		for _, p := range points {
+			if dist2(query, p.Coords) <= 16 {
+				want++
+			}
+		}
+		assert.Equal(want, len(tree.InRadius(query, 4)))
+
+		lo := []float64{query[0] - 3, query[1] - 3, query[2] - 3}
+		hi := []float64{query[0] + 3, query[1] + 3, query[2] + 3}
+		want = 0
+		for _, p := range points {
+			if math.Abs(p.Coords[0]-query[0]) <= 3 && math.Abs(p.Coords[1]-query[1]) <= 3 && math.Abs(p.Coords[2]-query[2]) <= 3 {
+				want++
+			}
+		}
+		assert.Equal(want, len(tree.InBox(lo, hi)))
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// RadixTree is a map from string keys kept in a path-compressed prefix tree: chains of
+// nodes with a single child are merged into one edge labelled with a whole substring.
+// It answers prefix queries such as LongestPrefix, WalkPrefix and WalkPath, which suit
+// route tables and IP-prefix lookups, while using memory proportional to the number of
+// keys rather than to their total length.  The zero RadixTree is empty and ready to
+// use.  A RadixTree is not safe for concurrent use.
+type RadixTree[V any] struct {
+	root   radixNode[V]
+	length int
+}
+
+type radixNode[V any] struct {
+	// label is the part of the key spelled by the edge leading to the node.
+	label    string
+	value    V
+	hasValue bool
+	// children are sorted by the first byte of their label, which is unique.
+	children []*radixNode[V]
+}
+
+// NewRadixTree creates an empty RadixTree.
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+// Len returns the number of keys.
+func (t *RadixTree[V]) Len() int {
+	return t.length
+}
+
+// Get returns the value of key, and whether it was found.
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	n := &t.root
+	for key != "" {
+		child, _ := n.child(key[0])
+		if child == nil || !strings.HasPrefix(key, child.label) {
+			var zero V
+			return zero, false
+		}
+		key, n = key[len(child.label):], child
+	}
+	return n.value, n.hasValue
+}
+
+// Contains reports whether key is in the tree.
+func (t *RadixTree[V]) Contains(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Insert sets the value of key, and reports whether the key is new.
+func (t *RadixTree[V]) Insert(key string, value V) bool {
+	n := &t.root
+	for key != "" {
+		child, i := n.child(key[0])
+		if child == nil {
+			leaf := &radixNode[V]{label: key, value: value, hasValue: true}
+			n.children = slices.Insert(n.children, i, leaf)
+			t.length++
+			return true
+		}
+
+		common := commonPrefixLen(key, child.label)
+		if common < len(child.label) {
+			// Split the edge where key diverges from it.
+			middle := &radixNode[V]{label: child.label[:common], children: []*radixNode[V]{child}}
+			child.label = child.label[common:]
+			n.children[i] = middle
+			child = middle
+		}
+		key, n = key[common:], child
+	}
+
+	isNew := !n.hasValue
+	n.value, n.hasValue = value, true
+	if isNew {
+		t.length++
+	}
+	return isNew
+}
+
+// Delete removes key, and reports whether it was found.  Edges left with a single
+// child are merged back, so the tree stays compressed.
+func (t *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	n := &t.root
+	for key != "" {
+		child, _ := n.child(key[0])
+		if child == nil || !strings.HasPrefix(key, child.label) {
+			return false
+		}
+		key, parent, n = key[len(child.label):], n, child
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.length--
+
+	if parent == nil {
+		return true
+	}
+	switch len(n.children) {
+	case 0:
+		_, i := parent.child(n.label[0])
+		parent.children = slices.Delete(parent.children, i, i+1)
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// LongestPrefix returns the longest key that is a prefix of s, and its value, or false
+// if no key is a prefix of s.
+func (t *RadixTree[V]) LongestPrefix(s string) (string, V, bool) {
+	var (
+		key   string
+		value V
+		found bool
+	)
+	t.WalkPath(s, func(k string, v V) bool {
+		key, value, found = k, v, true
+		return true
+	})
+	return key, value, found
+}
+
+// WalkPath calls fn for each key that is a prefix of path, from the shortest to the
+// longest, until fn returns false.
+func (t *RadixTree[V]) WalkPath(path string, fn func(key string, value V) bool) {
+	n := &t.root
+	consumed := 0
+	for {
+		if n.hasValue && !fn(path[:consumed], n.value) {
+			return
+		}
+		if consumed == len(path) {
+			return
+		}
+		child, _ := n.child(path[consumed])
+		if child == nil || !strings.HasPrefix(path[consumed:], child.label) {
+			return
+		}
+		consumed += len(child.label)
+		n = child
+	}
+}
+
+// WalkPrefix calls fn for each key starting with prefix, in lexical order, until fn
+// returns false.
+func (t *RadixTree[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	n := &t.root
+	consumed := ""
+	rest := prefix
+	for rest != "" {
+		child, _ := n.child(rest[0])
+		if child == nil {
+			return
+		}
+		switch {
+		case strings.HasPrefix(child.label, rest):
+			// The prefix ends inside the edge: every key below child matches.
+			child.walk(consumed+child.label, fn)
+			return
+		case strings.HasPrefix(rest, child.label):
+			consumed += child.label
+			rest = rest[len(child.label):]
+			n = child
+		default:
+			return
+		}
+	}
+	n.walk(consumed, fn)
+}
+
+// All returns an iterator over the keys and values, in lexical order of keys.
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		t.root.walk("", yield)
+	}
+}
+
+// Keys returns an iterator over the keys, in lexical order.
+func (t *RadixTree[V]) Keys() iter.Seq[string] {
+	return icontainer.Keys(t.All())
+}
+
+// Values returns an iterator over the values, in lexical order of keys.
+func (t *RadixTree[V]) Values() iter.Seq[V] {
+	return icontainer.Values(t.All())
+}
+
+// child returns the child of n whose label starts with b, and its position, or nil and
+// the position where it would be inserted.
+func (n *radixNode[V]) child(b byte) (*radixNode[V], int) {
+	i, found := slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.label[0]) - int(b)
+	})
+	if !found {
+		return nil, i
+	}
+	return n.children[i], i
+}
+
+// mergeChild merges n, which holds no value, with its only child.
+func (n *radixNode[V]) mergeChild() {
+	child := n.children[0]
+	n.label += child.label
+	n.value, n.hasValue = child.value, child.hasValue
+	n.children = child.children
+}
+
+// walk calls fn on the entries of the subtree of n, whose key is key, in lexical order,
+// and returns false when fn asked to stop.
+func (n *radixNode[V]) walk(key string, fn func(string, V) bool) bool {
+	if n.hasValue && !fn(key, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(key+child.label, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// commonPrefixLen returns the length of the longest common prefix of a and b.
+func commonPrefixLen(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
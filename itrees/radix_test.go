@@ -0,0 +1,174 @@
+package itrees
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+// checkRadix verifies that the tree is compressed: every node but the root either holds
+// a value or branches, and sibling labels start with distinct, sorted bytes.
+func checkRadix[V any](t *testing.T, tree *RadixTree[V]) {
+	t.Helper()
+
+	var check func(n *radixNode[V], root bool)
+	check = func(n *radixNode[V], root bool) {
+		if !root && n.label == "" {
+			t.Fatalf("empty edge label")
+		}
+		if !root && !n.hasValue && len(n.children) < 2 {
+			t.Fatalf("uncompressed node %q", n.label)
+		}
+		for i, child := range n.children {
+			if i > 0 && n.children[i-1].label[0] >= child.label[0] {
+				t.Fatalf("unsorted children under %q", n.label)
+			}
+			check(child, false)
+		}
+	}
+	check(&tree.root, true)
+}
+
+func collectRadix[V any](walk func(func(string, V) bool)) []string {
+	var keys []string
+	walk(func(k string, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
+}
+
+func TestRadixTree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRadixTree")
+
+	var tree RadixTree[int]
+	_, ok := tree.Get("a")
+	assert.ShouldBeFalse(ok)
+	assert.ShouldBeFalse(tree.Delete("a"))
+
+	for i, k := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rom"} {
+		assert.ShouldBeTrue(tree.Insert(k, i))
+	}
+	assert.ShouldBeFalse(tree.Insert("ruber", 40))
+	checkRadix(t, &tree)
+
+	assert.Equal(7, tree.Len())
+	v, ok := tree.Get("ruber")
+	assert.Equal(40, v)
+	assert.ShouldBeTrue(ok)
+	assert.ShouldBeFalse(tree.Contains("rub"))
+	assert.ShouldBeFalse(tree.Contains("romanes"))
+
+	var keys []string
+	for k := range tree.All() {
+		keys = append(keys, k)
+	}
+	assert.Equal([]string{"rom", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon"}, keys)
+
+	assert.Equal([]string{"rubens", "ruber", "rubicon"}, collectRadix(func(fn func(string, int) bool) {
+		tree.WalkPrefix("rub", fn)
+	}))
+	assert.Equal([]string{"romane", "romanus"}, collectRadix(func(fn func(string, int) bool) {
+		tree.WalkPrefix("roma", fn)
+	}))
+	assert.Equal([]string{"ruber"}, collectRadix(func(fn func(string, int) bool) {
+		tree.WalkPrefix("ruber", fn)
+	}))
+	assert.Equal([]string(nil), collectRadix(func(fn func(string, int) bool) {
+		tree.WalkPrefix("rubx", fn)
+	}))
+	assert.Equal(7, len(collectRadix(func(fn func(string, int) bool) {
+		tree.WalkPrefix("", fn)
+	})))
+
+	assert.Equal([]string{"rom", "romane"}, collectRadix(func(fn func(string, int) bool) {
+		tree.WalkPath("romanesque", fn)
+	}))
+
+	k, v, ok := tree.LongestPrefix("romanesque")
+	assert.Equal("romane", k)
+	assert.Equal(0, v)
+	assert.ShouldBeTrue(ok)
+	_, _, ok = tree.LongestPrefix("ro")
+	assert.ShouldBeFalse(ok)
+
+	assert.ShouldBeFalse(tree.Delete("roman"))
+	assert.ShouldBeTrue(tree.Delete("romane"))
+	assert.ShouldBeTrue(tree.Delete("rubicon"))
+	assert.ShouldBeTrue(tree.Delete("rom"))
+	assert.Equal(4, tree.Len())
+	assert.ShouldBeTrue(tree.Contains("romanus"))
+	checkRadix(t, &tree)
+}
+
+func TestRadixTreeEmptyKey(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRadixTreeEmptyKey")
+
+	tree := NewRadixTree[string]()
+	tree.Insert("", "root")
+	tree.Insert("/api", "api")
+
+	k, v, ok := tree.LongestPrefix("/home")
+	assert.Equal("", k)
+	assert.Equal("root", v)
+	assert.ShouldBeTrue(ok)
+
+	assert.ShouldBeTrue(tree.Delete(""))
+	assert.ShouldBeFalse(tree.Contains(""))
+	assert.Equal(1, tree.Len())
+}
+
+func TestRadixTreeRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRadixTreeRandomized")
+
+	rng := rand.New(rand.NewSource(9))
+	randomKey := func() string {
+		var b strings.Builder
+		for range rng.Intn(6) {
+			b.WriteByte("abc"[rng.Intn(3)])
+		}
+		return b.String()
+	}
+
+	tree := NewRadixTree[int]()
+	reference := map[string]int{}
+	for i := range 3000 {
+		k := randomKey()
+		if rng.Intn(3) == 0 {
+			_, found := reference[k]
+			assert.Equal(found, tree.Delete(k))
+			delete(reference, k)
+		} else {
+			_, found := reference[k]
+			assert.Equal(!found, tree.Insert(k, i))
+			reference[k] = i
+		}
+	}
+	checkRadix(t, tree)
+
+	assert.Equal(len(reference), tree.Len())
+	assert.Equal(reference, maps.Collect(tree.All()))
+
+	keys := slices.Sorted(maps.Keys(reference))
+	for _, prefix := range []string{"", "a", "ab", "cab", "bbbbb"} {
+		var want []string
+		for _, k := range keys {
+			if strings.HasPrefix(k, prefix) {
+				want = append(want, k)
+			}
+		}
+		assert.Equal(want, collectRadix(func(fn func(string, int) bool) {
+			tree.WalkPrefix(prefix, fn)
+		}))
+	}
+}
@@ -0,0 +1,501 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/idichekop/gods/icompare"
+	"github.com/idichekop/gods/icontainer"
+	"golang.org/x/exp/constraints"
+)
+
+// RBTree is an ordered map kept in a red-black tree.  Get, Put, Delete and the order
+// queries (Floor, Ceiling, Rank, Select, ...) run in O(log n); Put and Delete rebalance
+// with at most three rotations, which makes RBTree a good fit for write-heavy workloads.
+// Iterations visit the keys in ascending order.  An RBTree is not safe for concurrent
+// use.
+type RBTree[K any, V any] struct {
+	root    *rbNode[K, V]
+	compare icompare.Comparator[K]
+}
+
+type rbNode[K any, V any] struct {
+	key                 K
+	value               V
+	left, right, parent *rbNode[K, V]
+	red                 bool
+	// size is the number of nodes of the subtree rooted here.
+	size int
+}
+
+// NewRBTree creates an empty RBTree ordered by the natural order of the keys.
+func NewRBTree[K constraints.Ordered, V any]() *RBTree[K, V] {
+	return NewRBTreeFunc[K, V](icompare.Natural[K]())
+}
+
+// NewRBTreeFunc creates an empty RBTree ordered by the given comparator.
+func NewRBTreeFunc[K any, V any](compare icompare.Comparator[K]) *RBTree[K, V] {
+	return &RBTree[K, V]{compare: compare}
+}
+
+// Len returns the number of keys.
+func (t *RBTree[K, V]) Len() int {
+	return t.root.len()
+}
+
+// Get returns the value of key, and whether it was found.
+func (t *RBTree[K, V]) Get(key K) (V, bool) {
+	_, v, ok := t.lookup(key).entry()
+	return v, ok
+}
+
+// Contains reports whether key is in the tree.
+func (t *RBTree[K, V]) Contains(key K) bool {
+	return t.lookup(key) != nil
+}
+
+// Put sets the value of key.
+func (t *RBTree[K, V]) Put(key K, value V) {
+	var parent *rbNode[K, V]
+	c := 0
+	for n := t.root; n != nil; {
+		parent = n
+		c = t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			n.value = value
+			return
+		}
+	}
+
+	z := &rbNode[K, V]{key: key, value: value, parent: parent, red: true, size: 1}
+	switch {
+	case parent == nil:
+		t.root = z
+	case c < 0:
+		parent.left = z
+	default:
+		parent.right = z
+	}
+	for n := parent; n != nil; n = n.parent {
+		n.size++
+	}
+	t.insertFixup(z)
+}
+
+// Delete removes key, and reports whether it was found.
+func (t *RBTree[K, V]) Delete(key K) bool {
+	z := t.lookup(key)
+	if z == nil {
+		return false
+	}
+
+	// x takes the place of the removed node y; xParent is tracked separately since x may
+	// be nil.
+	y, removedRed := z, z.red
+	var x, xParent *rbNode[K, V]
+	if z.left != nil && z.right != nil {
+		y = z.right.min()
+	}
+	// The subtrees above the node leaving its place lose one node.
+	for n := y.parent; n != nil; n = n.parent {
+		n.size--
+	}
+	switch {
+	case z.left == nil:
+		x, xParent = z.right, z.parent
+		t.transplant(z, z.right)
+	case z.right == nil:
+		x, xParent = z.left, z.parent
+		t.transplant(z, z.left)
+	default:
+		removedRed = y.red
+		x = y.right
+		if y.parent == z {
+			xParent = y
+		} else {
+			xParent = y.parent
+			t.transplant(y, y.right)
+			y.right = z.right
+			y.right.parent = y
+		}
+		t.transplant(z, y)
+		y.left = z.left
+		y.left.parent = y
+		y.red = z.red
+		y.size = z.size
+	}
+	if !removedRed {
+		t.deleteFixup(x, xParent)
+	}
+	return true
+}
+
+// Clear removes all the keys.  The tree keeps its comparator.
+func (t *RBTree[K, V]) Clear() {
+	t.root = nil
+}
+
+// Min returns the smallest key and its value, or false if the tree is empty.
+func (t *RBTree[K, V]) Min() (K, V, bool) {
+	return t.root.min().entry()
+}
+
+// Max returns the greatest key and its value, or false if the tree is empty.
+func (t *RBTree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n.entry()
+}
+
+// Floor returns the greatest key less than or equal to key, and its value, or false if
+// there is none.
+func (t *RBTree[K, V]) Floor(key K) (K, V, bool) {
+	var best *rbNode[K, V]
+	for n := t.root; n != nil; {
+		c := t.compare(key, n.key)
+		if c == 0 {
+			return n.entry()
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			best, n = n, n.right
+		}
+	}
+	return best.entry()
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value, or false
+// if there is none.
+func (t *RBTree[K, V]) Ceiling(key K) (K, V, bool) {
+	return t.ceiling(key).entry()
+}
+
+// Rank returns the number of keys strictly less than key.
+func (t *RBTree[K, V]) Rank(key K) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += 1 + n.left.len()
+			n = n.right
+		default:
+			return rank + n.left.len()
+		}
+	}
+	return rank
+}
+
+// Select returns the key of the given rank (the index-th smallest, from 0) and its value,
+// or false if index is out of range.
+func (t *RBTree[K, V]) Select(index int) (K, V, bool) {
+	if index < 0 || index >= t.Len() {
+		return (*rbNode[K, V])(nil).entry()
+	}
+	n := t.root
+	for {
+		left := n.left.len()
+		switch {
+		case index < left:
+			n = n.left
+		case index > left:
+			index -= left + 1
+			n = n.right
+		default:
+			return n.entry()
+		}
+	}
+}
+
+// All returns an iterator over the keys and values, in ascending order of keys.
+func (t *RBTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := t.root.min(); n != nil; n = n.next() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys, in ascending order.
+func (t *RBTree[K, V]) Keys() iter.Seq[K] {
+	return icontainer.Keys(t.All())
+}
+
+// Values returns an iterator over the values, in ascending order of keys.
+func (t *RBTree[K, V]) Values() iter.Seq[V] {
+	return icontainer.Values(t.All())
+}
+
+// Range returns an iterator over the keys in [from, to) and their values, in ascending
+// order.
+func (t *RBTree[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := t.ceiling(from); n != nil && t.compare(n.key, to) < 0; n = n.next() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Validate checks the red-black invariants: the root is black, no red node has a red
+// child, every path from a node to its leaves crosses the same number of black nodes,
+// keys are in order, and parent links and subtree sizes are consistent.  It returns an
+// error describing the first violation found, and is meant for tests.
+func (t *RBTree[K, V]) Validate() error {
+	if t.root.isRed() {
+		return fmt.Errorf("red root %v", t.root.key)
+	}
+	if t.root != nil && t.root.parent != nil {
+		return fmt.Errorf("root %v has a parent", t.root.key)
+	}
+	_, err := t.validate(t.root, nil, nil)
+	return err
+}
+
+// validate checks the subtree of n, whose keys must lie strictly between the keys of the
+// nodes lower and upper (nil for no bound), and returns its black height.
+func (t *RBTree[K, V]) validate(n, lower, upper *rbNode[K, V]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if n.red && (n.left.isRed() || n.right.isRed()) {
+		return 0, fmt.Errorf("red node %v has a red child", n.key)
+	}
+	for _, child := range []*rbNode[K, V]{n.left, n.right} {
+		if child != nil && child.parent != n {
+			return 0, fmt.Errorf("node %v has a wrong parent link", child.key)
+		}
+	}
+	if lower != nil && t.compare(n.key, lower.key) <= 0 || upper != nil && t.compare(n.key, upper.key) >= 0 {
+		return 0, fmt.Errorf("unordered keys at %v", n.key)
+	}
+	if n.size != 1+n.left.len()+n.right.len() {
+		return 0, fmt.Errorf("wrong size at %v", n.key)
+	}
+
+	left, err := t.validate(n.left, lower, n)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.validate(n.right, n, upper)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("unbalanced black height at %v", n.key)
+	}
+	if n.red {
+		return left, nil
+	}
+	return left + 1, nil
+}
+
+// lookup returns the node of key, or nil if there is none.
+func (t *RBTree[K, V]) lookup(key K) *rbNode[K, V] {
+	n := t.ceiling(key)
+	if n != nil && t.compare(n.key, key) == 0 {
+		return n
+	}
+	return nil
+}
+
+// ceiling returns the node of the smallest key greater than or equal to key, or nil if
+// there is none.
+func (t *RBTree[K, V]) ceiling(key K) *rbNode[K, V] {
+	var best *rbNode[K, V]
+	for n := t.root; n != nil; {
+		c := t.compare(key, n.key)
+		if c == 0 {
+			return n
+		}
+		if c > 0 {
+			n = n.right
+		} else {
+			best, n = n, n.left
+		}
+	}
+	return best
+}
+
+// insertFixup restores the invariants after the red node z was inserted.
+func (t *RBTree[K, V]) insertFixup(z *rbNode[K, V]) {
+	for z.parent.isRed() {
+		parent, grandparent := z.parent, z.parent.parent
+		if parent == grandparent.left {
+			uncle := grandparent.right
+			if uncle.isRed() {
+				parent.red, uncle.red, grandparent.red = false, false, true
+				z = grandparent
+				continue
+			}
+			if z == parent.right {
+				z, parent = parent, z
+				t.rotateLeft(z)
+			}
+			parent.red, grandparent.red = false, true
+			t.rotateRight(grandparent)
+		} else {
+			uncle := grandparent.left
+			if uncle.isRed() {
+				parent.red, uncle.red, grandparent.red = false, false, true
+				z = grandparent
+				continue
+			}
+			if z == parent.left {
+				z, parent = parent, z
+				t.rotateRight(z)
+			}
+			parent.red, grandparent.red = false, true
+			t.rotateLeft(grandparent)
+		}
+	}
+	t.root.red = false
+}
+
+// deleteFixup restores the invariants after a black node was removed, leaving x (which
+// may be nil) with one black too few on its paths.
+func (t *RBTree[K, V]) deleteFixup(x, parent *rbNode[K, V]) {
+	for x != t.root && !x.isRed() {
+		if x == parent.left {
+			w := parent.right
+			if w.isRed() {
+				w.red, parent.red = false, true
+				t.rotateLeft(parent)
+				w = parent.right
+			}
+			if !w.left.isRed() && !w.right.isRed() {
+				w.red = true
+				x, parent = parent, parent.parent
+				continue
+			}
+			if !w.right.isRed() {
+				w.left.red, w.red = false, true
+				t.rotateRight(w)
+				w = parent.right
+			}
+			w.red, parent.red, w.right.red = parent.red, false, false
+			t.rotateLeft(parent)
+		} else {
+			w := parent.left
+			if w.isRed() {
+				w.red, parent.red = false, true
+				t.rotateRight(parent)
+				w = parent.left
+			}
+			if !w.left.isRed() && !w.right.isRed() {
+				w.red = true
+				x, parent = parent, parent.parent
+				continue
+			}
+			if !w.left.isRed() {
+				w.right.red, w.red = false, true
+				t.rotateLeft(w)
+				w = parent.left
+			}
+			w.red, parent.red, w.left.red = parent.red, false, false
+			t.rotateRight(parent)
+		}
+		x = t.root
+	}
+	if x != nil {
+		x.red = false
+	}
+}
+
+// transplant replaces the subtree of u by the subtree of v.
+func (t *RBTree[K, V]) transplant(u, v *rbNode[K, V]) {
+	switch {
+	case u.parent == nil:
+		t.root = v
+	case u == u.parent.left:
+		u.parent.left = v
+	default:
+		u.parent.right = v
+	}
+	if v != nil {
+		v.parent = u.parent
+	}
+}
+
+func (t *RBTree[K, V]) rotateLeft(x *rbNode[K, V]) {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	t.transplant(x, y)
+	y.left = x
+	x.parent = y
+	y.size = x.size
+	x.size = 1 + x.left.len() + x.right.len()
+}
+
+func (t *RBTree[K, V]) rotateRight(x *rbNode[K, V]) {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	t.transplant(x, y)
+	y.right = x
+	x.parent = y
+	y.size = x.size
+	x.size = 1 + x.left.len() + x.right.len()
+}
+
+func (n *rbNode[K, V]) isRed() bool {
+	return n != nil && n.red
+}
+
+func (n *rbNode[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// entry returns the key and value of n, or false if n is nil.
+func (n *rbNode[K, V]) entry() (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+// min returns the node of the smallest key of the subtree, or nil if it is empty.
+func (n *rbNode[K, V]) min() *rbNode[K, V] {
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// next returns the in-order successor of n, or nil if n holds the greatest key.
+func (n *rbNode[K, V]) next() *rbNode[K, V] {
+	if n.right != nil {
+		return n.right.min()
+	}
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return n.parent
+}
@@ -0,0 +1,154 @@
+package itrees
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestRBTree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRBTree")
+
+	tree := NewRBTree[int, string]()
+	_, _, ok := tree.Min()
+	assert.ShouldBeFalse(ok)
+	assert.ShouldBeFalse(tree.Delete(1))
+	assert.IsNil(tree.Validate())
+
+	for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+		tree.Put(k, "v"+strings.Repeat("x", k/10))
+	}
+	tree.Put(30, "thirty")
+
+	assert.Equal(7, tree.Len())
+	v, ok := tree.Get(30)
+	assert.Equal("thirty", v)
+	assert.ShouldBeTrue(ok)
+	assert.ShouldBeFalse(tree.Contains(40))
+
+	k, _, _ := tree.Min()
+	assert.Equal(10, k)
+	k, _, _ = tree.Max()
+	assert.Equal(90, k)
+
+	k, _, ok = tree.Floor(45)
+	assert.Equal(30, k)
+	assert.ShouldBeTrue(ok)
+	_, _, ok = tree.Floor(5)
+	assert.ShouldBeFalse(ok)
+	k, _, _ = tree.Ceiling(45)
+	assert.Equal(50, k)
+	_, _, ok = tree.Ceiling(95)
+	assert.ShouldBeFalse(ok)
+
+	var keys []int
+	for k := range tree.Range(20, 80) {
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{20, 30, 50, 70}, keys)
+
+	keys = nil
+	for k := range tree.All() {
+		if k > 30 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal([]int{10, 20, 30}, keys)
+
+	assert.ShouldBeTrue(tree.Delete(50))
+	assert.ShouldBeFalse(tree.Contains(50))
+	assert.Equal(6, tree.Len())
+	assert.IsNil(tree.Validate())
+}
+
+func TestRBTreeValidateReportsViolations(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRBTreeValidateReportsViolations")
+
+	tree := NewRBTree[int, int]()
+	for i := range 10 {
+		tree.Put(i, i)
+	}
+	assert.IsNil(tree.Validate())
+
+	tree.root.red = true
+	assert.IsNotNil(tree.Validate())
+	tree.root.red = false
+
+	tree.root.size++
+	assert.IsNotNil(tree.Validate())
+	tree.root.size--
+
+	// The rightmost node of the left subtree is only ordered against its parent.
+	n := tree.root.left
+	for n.right != nil {
+		n = n.right
+	}
+	key := n.key
+	n.key = 100
+	assert.IsNotNil(tree.Validate())
+	n.key = key
+	assert.IsNil(tree.Validate())
+
+	tree.root.left.key, tree.root.right.key = tree.root.right.key, tree.root.left.key
+	assert.IsNotNil(tree.Validate())
+}
+
+func TestRBTreeRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestRBTreeRandomized")
+
+	rng := rand.New(rand.NewSource(5))
+	tree := NewRBTree[int, int]()
+	reference := map[int]int{}
+
+	for i := range 5000 {
+		k := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			_, found := reference[k]
+			assert.Equal(found, tree.Delete(k))
+			delete(reference, k)
+		} else {
+			tree.Put(k, i)
+			reference[k] = i
+		}
+		if i%250 == 0 {
+			assert.IsNil(tree.Validate())
+		}
+	}
+	assert.IsNil(tree.Validate())
+
+	assert.Equal(len(reference), tree.Len())
+	var keys []int
+	for k, v := range tree.All() {
+		assert.Equal(reference[k], v)
+		keys = append(keys, k)
+	}
+	assert.ShouldBeTrue(slices.IsSorted(keys))
+	assert.Equal(len(reference), len(keys))
+
+	for i, k := range keys {
+		assert.Equal(i, tree.Rank(k))
+		selected, _, _ := tree.Select(i)
+		assert.Equal(k, selected)
+	}
+	_, _, ok := tree.Select(len(keys))
+	assert.ShouldBeFalse(ok)
+
+	for _, k := range keys {
+		tree.Delete(k)
+		if k%50 == 0 {
+			assert.IsNil(tree.Validate())
+		}
+	}
+	assert.Equal(0, tree.Len())
+	assert.IsNil(tree.Validate())
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 idichekop@yahoo.com.br. All rights reserved.
+// This source is licenced, used, and distributed under MIT license
+// See LICENSE file in module root directory
+
+package itrees
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/idichekop/gods/icontainer"
+)
+
+// SegmentTree answers range aggregate queries over a fixed-length sequence of values,
+// such as range sums, minimums or maximums, in O(log n), and supports point updates in
+// O(log n).
+//
+// The aggregate is defined by a combine function and its identity, which must form a
+// monoid: combine must be associative, and combine(identity, v) == combine(v, identity)
+// == v.  Combine need not be commutative; values are always combined left to right.  A
+// SegmentTree is not safe for concurrent use.
+type SegmentTree[T any] struct {
+	// nodes holds the leaves at [n, 2n) and the node i combines its children 2i and 2i+1.
+	nodes    []T
+	n        int
+	combine  func(a, b T) T
+	identity T
+}
+
+// NewSegmentTree builds a SegmentTree over a copy of values in O(n).
+func NewSegmentTree[T any](values []T, combine func(a, b T) T, identity T) *SegmentTree[T] {
+	n := len(values)
+	st := &SegmentTree[T]{nodes: make([]T, 2*n), n: n, combine: combine, identity: identity}
+	copy(st.nodes[n:], values)
+	for i := n - 1; i > 0; i-- {
+		st.nodes[i] = combine(st.nodes[2*i], st.nodes[2*i+1])
+	}
+	return st
+}
+
+// Len returns the number of values.
+func (st *SegmentTree[T]) Len() int {
+	return st.n
+}
+
+// Get returns the value at index.  It panics if index is out of range.
+func (st *SegmentTree[T]) Get(index int) T {
+	st.checkIndex(index)
+	return st.nodes[st.n+index]
+}
+
+// Set sets the value at index, and updates the aggregates that cover it.  It panics if
+// index is out of range.
+func (st *SegmentTree[T]) Set(index int, value T) {
+	st.checkIndex(index)
+	i := st.n + index
+	st.nodes[i] = value
+	for i /= 2; i > 0; i /= 2 {
+		st.nodes[i] = st.combine(st.nodes[2*i], st.nodes[2*i+1])
+	}
+}
+
+// Query returns the aggregate of the values in [from, to), or the identity if the range
+// is empty.  It panics if the range is out of bounds.
+func (st *SegmentTree[T]) Query(from, to int) T {
+	if from < 0 || to > st.n || from > to {
+		panic(fmt.Sprintf("SegmentTree.Query: range [%d, %d) out of bounds [0, %d)", from, to, st.n))
+	}
+	// Aggregates are collected from both ends towards the middle, keeping left and right
+	// separate so that a non-commutative combine sees the values in order.
+	left, right := st.identity, st.identity
+	for lo, hi := from+st.n, to+st.n; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo%2 == 1 {
+			left = st.combine(left, st.nodes[lo])
+			lo++
+		}
+		if hi%2 == 1 {
+			hi--
+			right = st.combine(st.nodes[hi], right)
+		}
+	}
+	return st.combine(left, right)
+}
+
+// All returns an iterator over the index and value of each element.
+func (st *SegmentTree[T]) All() iter.Seq2[int, T] {
+	return slices.All(st.nodes[st.n:])
+}
+
+// Keys returns an iterator over the indexes, in ascending order.
+func (st *SegmentTree[T]) Keys() iter.Seq[int] {
+	return icontainer.Keys(st.All())
+}
+
+// Values returns an iterator over the values, in index order.
+func (st *SegmentTree[T]) Values() iter.Seq[T] {
+	return slices.Values(st.nodes[st.n:])
+}
+
+// ToSlice returns a copy of the values.
+func (st *SegmentTree[T]) ToSlice() []T {
+	return append([]T(nil), st.nodes[st.n:]...)
+}
+
+func (st *SegmentTree[T]) checkIndex(index int) {
+	if index < 0 || index >= st.n {
+		panic(fmt.Sprintf("SegmentTree: index %d out of bounds [0, %d)", index, st.n))
+	}
+}
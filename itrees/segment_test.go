@@ -0,0 +1,113 @@
+package itrees
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestSegmentTree(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSegmentTree")
+
+	values := []int{5, 3, 8, 1, 9, 2}
+	sums := NewSegmentTree(values, func(a, b int) int { return a + b }, 0)
+	mins := NewSegmentTree(values, func(a, b int) int { return min(a, b) }, math.MaxInt)
+
+	assert.Equal(6, sums.Len())
+	assert.Equal(28, sums.Query(0, 6))
+	assert.Equal(21, sums.Query(1, 5))
+	assert.Equal(0, sums.Query(3, 3))
+	assert.Equal(1, mins.Query(0, 6))
+	assert.Equal(2, mins.Query(4, 6))
+
+	sums.Set(3, 10)
+	mins.Set(3, 10)
+	assert.Equal(10, sums.Get(3))
+	assert.Equal(30, sums.Query(1, 5))
+	assert.Equal(3, mins.Query(0, 4))
+
+	values[0] = 100
+	assert.Equal(5, sums.Get(0))
+	assert.Equal([]int{5, 3, 8, 10, 9, 2}, sums.ToSlice())
+	assert.Equal([]int{0, 1, 2, 3, 4, 5}, slices.Collect(sums.Keys()))
+	assert.Equal(sums.ToSlice(), slices.Collect(sums.Values()))
+	for i, v := range sums.All() {
+		assert.Equal(sums.Get(i), v)
+	}
+
+	empty := NewSegmentTree(nil, func(a, b int) int { return a + b }, 0)
+	assert.Equal(0, empty.Query(0, 0))
+}
+
+func TestSegmentTreeNonCommutative(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSegmentTreeNonCommutative")
+
+	concat := func(a, b string) string { return a + b }
+	st := NewSegmentTree([]string{"a", "b", "c", "d", "e", "f", "g"}, concat, "")
+
+	for from := range 8 {
+		for to := from; to <= 7; to++ {
+			want := "abcdefg"[from:to]
+			assert.Equal(want, st.Query(from, to))
+		}
+	}
+	st.Set(2, "X")
+	assert.Equal("bXde", st.Query(1, 5))
+}
+
+func TestSegmentTreePanics(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSegmentTreePanics")
+
+	st := NewSegmentTree([]int{1, 2, 3}, func(a, b int) int { return a + b }, 0)
+	for _, f := range []func(){
+		func() { st.Get(3) },
+		func() { st.Set(-1, 0) },
+		func() { st.Query(2, 1) },
+		func() { st.Query(0, 4) },
+	} {
+		func() {
+			defer func() {
+				assert.IsNotNil(recover())
+			}()
+			f()
+		}()
+	}
+}
+
+func TestSegmentTreeRandomized(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestSegmentTreeRandomized")
+
+	rng := rand.New(rand.NewSource(1))
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = rng.Intn(1000)
+	}
+	st := NewSegmentTree(values, func(a, b int) int { return max(a, b) }, -1)
+
+	for range 1000 {
+		if rng.Intn(2) == 0 {
+			i, v := rng.Intn(len(values)), rng.Intn(1000)
+			values[i] = v
+			st.Set(i, v)
+			continue
+		}
+		from := rng.Intn(len(values))
+		to := from + rng.Intn(len(values)-from) + 1
+		want := -1
+		for _, v := range values[from:to] {
+			want = max(want, v)
+		}
+		assert.Equal(want, st.Query(from, to))
+	}
+}
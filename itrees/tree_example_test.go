@@ -0,0 +1,126 @@
+package itrees
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/idichekop/gods/ituples"
+)
+
+func ExampleBST() {
+	tree := NewBST(8, 3, 10, 1, 6)
+
+	fmt.Println(slices.Collect(tree.InOrder()))
+
+	next, _ := tree.Successor(6)
+	prev, _ := tree.Predecessor(6)
+	fmt.Println(prev, next)
+
+	// Output:
+	// [1 3 6 8 10]
+	// 3 8
+}
+
+func ExampleRBTree() {
+	tree := NewRBTree[string, int]()
+	tree.Put("pear", 3)
+	tree.Put("apple", 7)
+	tree.Put("fig", 1)
+
+	for k, v := range tree.All() {
+		fmt.Println(k, v)
+	}
+	fmt.Println(tree.Validate())
+
+	// Output:
+	// apple 7
+	// fig 1
+	// pear 3
+	// <nil>
+}
+
+func ExampleBTree() {
+	tree, _ := NewBTreeFromSorted(4, []ituples.Pair[int, string]{
+		ituples.NewPair(10, "a"),
+		ituples.NewPair(20, "b"),
+		ituples.NewPair(30, "c"),
+	})
+	tree.Put(25, "x")
+
+	tree.Descend(func(k int, v string) bool {
+		fmt.Println(k, v)
+		return k > 20
+	})
+
+	// Output:
+	// 30 c
+	// 25 x
+	// 20 b
+}
+
+func ExampleRadixTree() {
+	routes := NewRadixTree[string]()
+	routes.Insert("/", "index")
+	routes.Insert("/api/", "api")
+	routes.Insert("/api/users/", "users")
+
+	route, handler, _ := routes.LongestPrefix("/api/users/42")
+	fmt.Println(route, handler)
+
+	routes.WalkPath("/api/orders", func(key, value string) bool {
+		fmt.Println("matched", key)
+		return true
+	})
+
+	// Output:
+	// /api/users/ users
+	// matched /
+	// matched /api/
+}
+
+func ExampleSegmentTree() {
+	latencies := NewSegmentTree([]int{120, 80, 300, 95, 110}, func(a, b int) int { return max(a, b) }, 0)
+
+	fmt.Println(latencies.Query(0, 3))
+	latencies.Set(2, 90)
+	fmt.Println(latencies.Query(0, 3))
+
+	// Output:
+	// 300
+	// 120
+}
+
+func ExampleFenwick() {
+	// requests per hour of the day
+	hits := NewFenwick[int](24)
+	hits.Add(9, 120)
+	hits.Add(10, 80)
+	hits.Add(14, 45)
+
+	fmt.Println(hits.PrefixSum(12))
+	fmt.Println(hits.RangeSum(10, 24))
+
+	// Output:
+	// 200
+	// 125
+}
+
+func ExampleKDTree() {
+	stores, _ := NewKDTree(2, []KDPoint[string]{
+		{Coords: []float64{0, 0}, Value: "downtown"},
+		{Coords: []float64{3, 4}, Value: "harbor"},
+		{Coords: []float64{-6, 1}, Value: "airport"},
+	})
+
+	store, dist, _ := stores.Nearest([]float64{2, 2})
+	fmt.Println(store.Value, dist)
+
+	for _, s := range stores.KNearest([]float64{-1, 0}, 2) {
+		fmt.Println(s.Value)
+	}
+
+	// Output:
+	// harbor 2.23606797749979
+	// downtown
+	// airport
+}
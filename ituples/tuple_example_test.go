@@ -0,0 +1,27 @@
+package ituples
+
+import "fmt"
+
+func ExampleNewPair() {
+	p := NewPair("a", 1)
+
+	name, count := p.Unpack()
+
+	fmt.Println(p)
+	fmt.Println(name, count)
+	fmt.Println(p.Swap())
+
+	// Output:
+	// (a, 1)
+	// a 1
+	// (1, a)
+}
+
+func ExampleNewTriple() {
+	t := NewTriple("a", 1, true)
+
+	fmt.Println(t)
+
+	// Output:
+	// (a, 1, true)
+}
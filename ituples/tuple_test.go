@@ -0,0 +1,44 @@
+package ituples
+
+import (
+	"testing"
+
+	"github.com/idichekop/gods/internal"
+)
+
+func TestPair(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestPair")
+
+	p := NewPair("a", 1)
+	assert.Equal(Pair[string, int]{First: "a", Second: 1}, p)
+
+	first, second := p.Unpack()
+	assert.Equal("a", first)
+	assert.Equal(1, second)
+
+	assert.Equal(Pair[int, string]{First: 1, Second: "a"}, p.Swap())
+	assert.Equal("(a, 1)", p.String())
+
+	// pairs of comparable values are comparable, and can be used as map keys
+	seen := map[Pair[string, int]]bool{p: true}
+	assert.ShouldBeTrue(seen[NewPair("a", 1)])
+	assert.ShouldBeFalse(seen[NewPair("a", 2)])
+}
+
+func TestTriple(t *testing.T) {
+	t.Parallel()
+
+	assert := internal.NewAssert(t, "TestTriple")
+
+	tr := NewTriple("a", 1, true)
+	assert.Equal(Triple[string, int, bool]{First: "a", Second: 1, Third: true}, tr)
+
+	first, second, third := tr.Unpack()
+	assert.Equal("a", first)
+	assert.Equal(1, second)
+	assert.Equal(true, third)
+
+	assert.Equal("(a, 1, true)", tr.String())
+}